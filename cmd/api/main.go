@@ -77,6 +77,12 @@ func mainRun(ctx context.Context) error {
 		WriteTimeout: conf.WriteTimeout,
 	}
 
+	purgeCtx, stopPurge := context.WithCancel(ctx)
+	defer stopPurge()
+	go api.PurgeTrashPeriodically(purgeCtx, factory, conf.TrashPurgeInterval)
+	go api.PurgeIdempotencyKeysPeriodically(purgeCtx, factory, conf.IdempotencyKeyPurgeInterval)
+	go api.PurgeRateLimitBucketsPeriodically(purgeCtx, factory, conf.RateLimitPurgeInterval)
+
 	serveErr := make(chan error)
 	go func() {
 		atel.EventLog(ctx, "Start accepting requests")
@@ -1,38 +1,200 @@
 create table users (
-    id              char(26)     not null primary key,
-    email           varchar(254) not null,
-    hashed_password char(60)     not null,
-    created_at      datetime     not null default current_timestamp,
-    updated_at      datetime     not null default current_timestamp on update current_timestamp,
+    id                  char(26)     not null primary key,
+    email               varchar(254) not null,
+    hashed_password     char(60)     not null,
+    email_verified_at   datetime,
+    pending_email       varchar(254) not null default '',
+    totp_secret         varchar(32)  not null default '',
+    totp_enabled_at     datetime,
+    totp_last_used_step bigint,
+    tokens_valid_after  datetime,
+    language            varchar(8)   not null default '',
+    created_at          datetime     not null default current_timestamp,
+    updated_at          datetime     not null default current_timestamp on update current_timestamp,
     unique (email)
 );
 
+create table refresh_tokens (
+    id         char(26) not null primary key,
+    family_id  char(26) not null,
+    user_id    char(26) not null,
+    token_hash char(64) not null,
+    expires_at datetime not null,
+    used_at    datetime,
+    revoked_at datetime,
+    authenticated_at datetime,
+    created_at datetime not null default current_timestamp,
+    unique (token_hash),
+    index (family_id),
+    foreign key (user_id) references users (id) on delete cascade
+);
+
+create table user_tokens (
+    id         char(26)     not null primary key,
+    user_id    char(26)     not null,
+    purpose    varchar(32)  not null,
+    email      varchar(254) not null,
+    token_hash char(64)     not null,
+    expires_at datetime     not null,
+    used_at    datetime,
+    created_at datetime     not null default current_timestamp,
+    unique (token_hash),
+    index (user_id, purpose),
+    foreign key (user_id) references users (id) on delete cascade,
+    check (purpose in ('password_reset', 'email_verification', 'two_factor_challenge'))
+);
+
+create table recovery_codes (
+    user_id    char(26) not null,
+    code_hash  char(64) not null,
+    used_at    datetime,
+    created_at datetime not null default current_timestamp,
+    primary key (user_id, code_hash),
+    foreign key (user_id) references users (id) on delete cascade
+);
+
+create table personal_access_tokens (
+    id           char(26)     not null primary key,
+    user_id      char(26)     not null,
+    name         varchar(80)  not null,
+    token_hash   char(64)     not null,
+    scopes       varchar(255) not null,
+    expires_at   datetime,
+    last_used_at datetime,
+    created_at   datetime     not null default current_timestamp,
+    unique (token_hash),
+    foreign key (user_id) references users (id) on delete cascade
+);
+
+create table user_identities (
+    id           char(26)     not null primary key,
+    user_id      char(26)     not null,
+    issuer       varchar(255) not null,
+    subject      varchar(255) not null,
+    email        varchar(254) not null,
+    last_used_at datetime,
+    created_at   datetime     not null default current_timestamp,
+    unique (issuer, subject),
+    foreign key (user_id) references users (id) on delete cascade
+);
+
+create table oidc_auth_requests (
+    id            char(26)     not null primary key,
+    state_hash    char(64)     not null,
+    nonce         varchar(64)  not null,
+    code_verifier varchar(128) not null,
+    expires_at    datetime     not null,
+    used_at       datetime,
+    created_at    datetime     not null default current_timestamp,
+    unique (state_hash)
+);
+
+create table sign_in_attempts (
+    subject        varchar(320) not null primary key,
+    failures       int          not null,
+    last_failed_at datetime     not null
+);
+
+create table rate_limit_buckets (
+    `key`       varchar(255) not null primary key,
+    tokens      double       not null,
+    refilled_at datetime(6)  not null,
+    expires_at  datetime(6)  not null,
+    index (expires_at)
+);
+
+create table idempotency_keys (
+    user_id      char(26)     not null,
+    `key`        varchar(255) not null,
+    request_hash char(64)     not null,
+    response     mediumblob,
+    expires_at   datetime     not null,
+    created_at   datetime     not null default current_timestamp,
+    primary key (user_id, `key`),
+    foreign key (user_id) references users (id) on delete cascade,
+    index (expires_at)
+);
+
 create table projects (
     id          char(26)     not null primary key,
     user_id     char(26)     not null,
     name        varchar(80)  not null,
     color       varchar(255) not null,
     is_archived tinyint(1)   not null default 0,
+    position    varchar(64)  character set ascii collate ascii_bin not null default '',
+    version     int unsigned not null default 1,
     created_at  datetime     not null default current_timestamp,
     updated_at  datetime     not null default current_timestamp on update current_timestamp,
+    deleted_at  datetime,
     foreign key (user_id) references users (id) on delete cascade,
+    index (user_id, position),
+    index (user_id, deleted_at),
+    index (deleted_at),
+    fulltext index (name) with parser ngram,
     check (color in ('blue', 'brown', 'default', 'gray', 'green', 'orange', 'pink', 'purple', 'red',
                      'yellow'))
 );
 
+create table project_members (
+    project_id char(26)    not null,
+    user_id    char(26)    not null,
+    role       varchar(16) not null,
+    position   varchar(64) character set ascii collate ascii_bin not null default '',
+    created_at datetime    not null default current_timestamp,
+    updated_at datetime    not null default current_timestamp on update current_timestamp,
+    primary key (project_id, user_id),
+    index (user_id, project_id),
+    index (user_id, position),
+    foreign key (project_id) references projects (id) on delete cascade,
+    foreign key (user_id) references users (id) on delete cascade,
+    check (role in ('editor', 'viewer'))
+);
+
+create table project_invitations (
+    id         char(26)     not null primary key,
+    project_id char(26)     not null,
+    email      varchar(254) not null,
+    role       varchar(16)  not null,
+    invited_by char(26)     not null,
+    created_at datetime     not null default current_timestamp,
+    unique (project_id, email),
+    index (email),
+    foreign key (project_id) references projects (id) on delete cascade,
+    foreign key (invited_by) references users (id) on delete cascade,
+    check (role in ('editor', 'viewer'))
+);
+
 create table tasks (
-    id           char(26)         not null primary key,
-    user_id      char(26)         not null,
-    project_id   char(26)         not null,
-    name         varchar(100)     not null,
-    content      varchar(300)     not null,
-    priority     tinyint unsigned not null,
-    due_on       date,
-    completed_at datetime,
-    created_at   datetime         not null default current_timestamp,
-    updated_at   datetime         not null default current_timestamp on update current_timestamp,
+    id                 char(26)         not null primary key,
+    user_id            char(26)         not null,
+    project_id         char(26)         not null,
+    assignee_id        char(26),
+    name               varchar(100)     not null,
+    content            varchar(300)     not null,
+    priority           tinyint unsigned not null,
+    due_on             date,
+    recurrence         varchar(255),
+    next_occurrence_id char(26),
+    completed_at       datetime,
+    position           varchar(64)      character set ascii collate ascii_bin not null default '',
+    version            int unsigned     not null default 1,
+    created_at         datetime         not null default current_timestamp,
+    updated_at         datetime         not null default current_timestamp on update current_timestamp,
+    deleted_at         datetime,
     foreign key (user_id) references users (id) on delete cascade,
     foreign key (project_id) references projects (id) on delete cascade,
+    foreign key (assignee_id) references users (id) on delete set null,
+    index (user_id, due_on),
+    index (assignee_id, due_on),
+    index (project_id, priority),
+    index (project_id, due_on),
+    index (project_id, created_at),
+    index (project_id, updated_at),
+    index (project_id, name),
+    index (project_id, position),
+    index (user_id, deleted_at),
+    index (deleted_at),
+    fulltext index (name, content) with parser ngram,
     check (priority between 0 and 3)
 );
 
@@ -42,19 +204,43 @@ create table steps (
     task_id      char(26)     not null,
     name         varchar(100) not null,
     completed_at datetime,
+    position     varchar(64)  character set ascii collate ascii_bin not null default '',
+    version      int unsigned not null default 1,
     created_at   datetime     not null default current_timestamp,
     updated_at   datetime     not null default current_timestamp on update current_timestamp,
+    deleted_at   datetime,
     foreign key (user_id) references users (id) on delete cascade,
-    foreign key (task_id) references tasks (id) on delete cascade
+    foreign key (task_id) references tasks (id) on delete cascade,
+    index (task_id, position),
+    index (user_id, deleted_at),
+    index (deleted_at),
+    fulltext index (name) with parser ngram
+);
+
+create table comments (
+    id         char(26)      not null primary key,
+    user_id    char(26),
+    task_id    char(26)      not null,
+    content    varchar(1000) not null,
+    created_at datetime      not null default current_timestamp,
+    updated_at datetime      not null default current_timestamp on update current_timestamp,
+    foreign key (user_id) references users (id) on delete set null,
+    foreign key (task_id) references tasks (id) on delete cascade,
+    index (task_id, created_at)
 );
 
 create table tags (
-    id         char(26)    not null primary key,
-    user_id    char(26)    not null,
-    name       varchar(20) not null,
-    created_at datetime    not null default current_timestamp,
-    updated_at datetime    not null default current_timestamp on update current_timestamp,
-    foreign key (user_id) references users (id) on delete cascade
+    id         char(26)     not null primary key,
+    user_id    char(26)     not null,
+    name       varchar(20)  not null,
+    version    int unsigned not null default 1,
+    created_at datetime     not null default current_timestamp,
+    updated_at datetime     not null default current_timestamp on update current_timestamp,
+    deleted_at datetime,
+    foreign key (user_id) references users (id) on delete cascade,
+    index (user_id, deleted_at),
+    index (deleted_at),
+    fulltext index (name) with parser ngram
 );
 
 create table task_tags (
@@ -62,6 +248,26 @@ create table task_tags (
     tag_id     char(26) not null references tags (id) on delete cascade,
     created_at datetime not null default current_timestamp,
     primary key (task_id, tag_id),
+    index (tag_id, task_id),
     foreign key (task_id) references tasks (id) on delete cascade,
     foreign key (tag_id) references tags (id) on delete cascade
 );
+
+create table history_entries (
+    id          bigint unsigned not null auto_increment primary key,
+    user_id     char(26),
+    entity_type varchar(16)     not null,
+    entity_id   char(26)        not null,
+    project_id  char(26),
+    task_id     char(26),
+    action      varchar(16)     not null,
+    changes     json            not null,
+    created_at  datetime        not null default current_timestamp,
+    foreign key (user_id) references users (id) on delete set null,
+    foreign key (project_id) references projects (id) on delete cascade,
+    foreign key (task_id) references tasks (id) on delete cascade,
+    index (project_id, id),
+    index (task_id, id),
+    check (entity_type in ('project', 'task', 'step', 'tag')),
+    check (action in ('create', 'update', 'delete', 'restore'))
+);
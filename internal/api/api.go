@@ -4,13 +4,19 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"mime"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/minguu42/harmattan/internal/api/apierror"
 	"github.com/minguu42/harmattan/internal/api/handler"
 	"github.com/minguu42/harmattan/internal/api/openapi"
 	"github.com/minguu42/harmattan/internal/api/usecase"
 	"github.com/minguu42/harmattan/internal/atel"
+	"github.com/minguu42/harmattan/internal/domain"
 	"github.com/minguu42/harmattan/internal/lib/errtrace"
 	"github.com/ogen-go/ogen/ogenerrors"
 	"github.com/rs/cors"
@@ -21,18 +27,40 @@ import (
 func NewHandler(f *Factory, revision string, allowedOrigins []string) (http.Handler, error) {
 	h := &handler.Handler{
 		UnimplementedHandler: openapi.UnimplementedHandler{},
-		Authentication:       usecase.Authentication{Auth: f.Auth, DB: f.DB},
-		Monitoring:           usecase.Monitoring{Revision: revision, DB: f.DB},
-		Project:              usecase.Project{DB: f.DB},
-		Step:                 usecase.Step{DB: f.DB},
-		Tag:                  usecase.Tag{DB: f.DB},
-		Task:                 usecase.Task{DB: f.DB},
+		Authentication: usecase.Authentication{
+			Auth:                             f.Auth,
+			DB:                               f.DB,
+			Mailer:                           f.Mailer,
+			SignInGuard:                      f.SignInGuard,
+			OIDC:                             f.OIDC,
+			WebURL:                           f.WebURL,
+			PasswordResetTokenExpiration:     f.PasswordResetTokenExpiration,
+			EmailVerificationTokenExpiration: f.EmailVerificationTokenExpiration,
+			TwoFactorChallengeExpiration:     f.TwoFactorChallengeExpiration,
+			ReauthenticationMaxAge:           f.ReauthenticationMaxAge,
+			PasswordResetResponseTime:        f.PasswordResetResponseTime,
+			OIDCAuthRequestExpiration:        f.OIDCAuthRequestExpiration,
+		},
+		Comment:             usecase.Comment{Cursor: f.Cursor, DB: f.DB},
+		History:             usecase.History{Cursor: f.Cursor, DB: f.DB},
+		Member:              usecase.Member{DB: f.DB},
+		Monitoring:          usecase.Monitoring{Revision: revision, DB: f.DB},
+		PersonalAccessToken: usecase.PersonalAccessToken{DB: f.DB},
+		Project:             usecase.Project{Cursor: f.Cursor, DB: f.DB},
+		Search:              usecase.Search{DB: f.DB},
+		Step:                usecase.Step{DB: f.DB},
+		Tag:                 usecase.Tag{Cursor: f.Cursor, DB: f.DB},
+		Task:                usecase.Task{Cursor: f.Cursor, DB: f.DB},
+		Trash:               usecase.Trash{DB: f.DB, Retention: f.TrashRetention},
 	}
 
 	sh := securityHandler{auth: f.Auth, db: f.DB}
 	middlewares := []openapi.Middleware{
 		attachTraceID(),
 		accessLog(),
+		rateLimit(f.RateLimiter),
+		// 処理中のパニックもエラーとしてキーを削除するため、recovery より外側で実行する
+		idempotency(&usecase.Idempotency{DB: f.DB, Expiration: f.IdempotencyKeyExpiration, Lease: f.IdempotencyKeyLease}),
 		recovery(),
 	}
 	ogenServer, err := openapi.NewServer(h, &sh,
@@ -48,15 +76,14 @@ func NewHandler(f *Factory, revision string, allowedOrigins []string) (http.Hand
 	corsSetting := cors.New(cors.Options{
 		AllowedOrigins: allowedOrigins,
 		AllowedMethods: []string{"GET", "POST", "PATCH", "DELETE", "OPTIONS"},
-		AllowedHeaders: []string{"Authorization", "Content-Type"},
+		AllowedHeaders: []string{"Authorization", "Content-Type", "If-Match", "If-None-Match", "Idempotency-Key"},
+		ExposedHeaders: []string{"ETag", "Retry-After", "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset"},
 	})
-	return setRequestStart(corsSetting.Handler(ogenServer)), nil
+	return setRequestStart(setClientIP(corsSetting.Handler(limitByIP(f.IPRateLimiter, ogenServer)))), nil
 }
 
-func notFound(w http.ResponseWriter, _ *http.Request) {
-	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	w.WriteHeader(http.StatusNotFound)
-	_, _ = w.Write([]byte(`{"code":404,"message":"指定したパスは見つかりません"}`))
+func notFound(w http.ResponseWriter, r *http.Request) {
+	writeError(r.Context(), w, r, apierror.RouteNotFoundError())
 }
 
 func methodNotAllowed(w http.ResponseWriter, r *http.Request, allowed string) {
@@ -68,9 +95,7 @@ func methodNotAllowed(w http.ResponseWriter, r *http.Request, allowed string) {
 	}
 
 	w.Header().Set("Allow", allowed)
-	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	w.WriteHeader(http.StatusMethodNotAllowed)
-	_, _ = w.Write([]byte(`{"code":405,"message":"指定したメソッドは許可されていません"}`))
+	writeError(r.Context(), w, r, apierror.MethodNotAllowedError())
 }
 
 type ErrorResponse struct {
@@ -78,6 +103,24 @@ type ErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// ProblemResponse はRFC 9457で定義されたproblem+json形式のエラーレスポンスである
+type ProblemResponse struct {
+	Type    string                 `json:"type"`
+	Title   string                 `json:"title,omitempty"`
+	Status  int                    `json:"status"`
+	Detail  string                 `json:"detail"`
+	Code    string                 `json:"code"`
+	TraceID string                 `json:"trace_id,omitempty"`
+	Errors  []ProblemResponseError `json:"errors,omitempty"`
+}
+
+type ProblemResponseError struct {
+	Field  string `json:"field,omitempty"`
+	Detail string `json:"detail"`
+}
+
+const problemTypePrefix = "urn:harmattan:error:"
+
 func errorHandler(ctx context.Context, w http.ResponseWriter, r *http.Request, err error) {
 	apiError := apierror.ToError(err)
 
@@ -100,11 +143,103 @@ func errorHandler(ctx context.Context, w http.ResponseWriter, r *http.Request, e
 		})
 	}
 
-	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if d := apiError.RetryAfter(); d > 0 {
+		// Retry-Afterヘッダは秒単位の整数のため、待ち時間より短くならないように切り上げる
+		w.Header().Set("Retry-After", strconv.Itoa(int((d+time.Second-1)/time.Second)))
+	}
+	if limit := apiError.RateLimit(); limit > 0 {
+		w.Header().Set("RateLimit-Limit", strconv.Itoa(limit))
+		w.Header().Set("RateLimit-Remaining", "0")
+		w.Header().Set("RateLimit-Reset", strconv.Itoa(int((apiError.RetryAfter()+time.Second-1)/time.Second)))
+	}
+	writeError(ctx, w, r, apiError)
+}
+
+// writeError はエラーレスポンスを書き込む
+// 既存のクライアントとの互換性のため、Acceptヘッダでproblem+json形式を要求された場合のみproblem+json形式で返す
+func writeError(ctx context.Context, w http.ResponseWriter, r *http.Request, apiError apierror.Error) {
+	lang := negotiateLanguage(ctx, r)
+	if !acceptsProblemJSON(r) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(apiError.Status())
+		bs, _ := json.Marshal(ErrorResponse{
+			Code:    apiError.Status(),
+			Message: apiError.Message(lang),
+		})
+		_, _ = w.Write(bs)
+		return
+	}
+
+	resp := ProblemResponse{
+		Type:    problemTypePrefix + apiError.Code(),
+		Title:   http.StatusText(apiError.Status()),
+		Status:  apiError.Status(),
+		Detail:  apiError.Message(lang),
+		Code:    apiError.Code(),
+		TraceID: atel.TraceID(ctx),
+	}
+	for _, fieldErr := range apiError.FieldErrors() {
+		resp.Errors = append(resp.Errors, ProblemResponseError{
+			Field:  fieldErr.Field,
+			Detail: fieldErr.Message(lang),
+		})
+	}
+	w.Header().Set("Content-Type", "application/problem+json; charset=utf-8")
 	w.WriteHeader(apiError.Status())
-	bs, _ := json.Marshal(ErrorResponse{
-		Code:    apiError.Status(),
-		Message: apiError.Message(),
-	})
+	bs, _ := json.Marshal(resp)
 	_, _ = w.Write(bs)
 }
+
+// acceptsProblemJSON はAcceptヘッダにapplication/problem+jsonが品質値0以外で含まれるかを返す
+func acceptsProblemJSON(r *http.Request) bool {
+	for _, accept := range r.Header.Values("Accept") {
+		for mediaRange := range strings.SplitSeq(accept, ",") {
+			mediaType, params, err := mime.ParseMediaType(mediaRange)
+			if err != nil || mediaType != "application/problem+json" {
+				continue
+			}
+			if q, err := strconv.ParseFloat(params["q"], 64); err == nil && q == 0 {
+				continue
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// negotiateLanguage はエラーメッセージの言語を決める
+// ユーザが言語を設定している場合はその言語を使い、設定していない場合はAccept-Languageヘッダで品質値が最も高い対応言語を使う
+func negotiateLanguage(ctx context.Context, r *http.Request) domain.Language {
+	if u, err := domain.UserFromContext(ctx); err == nil && u.Language != "" {
+		return u.Language
+	}
+
+	lang, maxQ := domain.DefaultLanguage, 0.0
+	for _, accept := range r.Header.Values("Accept-Language") {
+		for languageRange := range strings.SplitSeq(accept, ",") {
+			tag, params, _ := strings.Cut(languageRange, ";")
+			q := 1.0
+			if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+				parsed, err := strconv.ParseFloat(v, 64)
+				if err != nil {
+					continue
+				}
+				q = parsed
+			}
+			if q <= maxQ {
+				continue
+			}
+
+			// en-USのような地域を含む言語タグは言語の部分のみで判定する
+			primary, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+			if primary == "*" {
+				lang, maxQ = domain.DefaultLanguage, q
+				continue
+			}
+			if l := domain.Language(primary); slices.Contains(domain.SupportedLanguages, l) {
+				lang, maxQ = l, q
+			}
+		}
+	}
+	return lang
+}
@@ -0,0 +1,394 @@
+package apierror
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/minguu42/harmattan/internal/domain"
+)
+
+// catalog はエラーコードなどのキーごとの言語別のメッセージである
+// メッセージは fmt.Sprintf の書式であり、Error.args や MessageError.args の値を埋め込む
+// 指定した言語のメッセージがない場合は domain.DefaultLanguage のメッセージを使う
+var catalog = map[string]map[domain.Language]string{
+	// system_error.go
+	"invalid_request": {
+		domain.LanguageJapanese: "リクエストに何らかの間違いがあります",
+		domain.LanguageEnglish:  "The request is invalid",
+	},
+	"invalid_request_details": {
+		domain.LanguageJapanese: "リクエストに以下の問題があります。",
+		domain.LanguageEnglish:  "The request has the following problems.",
+	},
+	"invalid_request_details_item": {
+		domain.LanguageJapanese: "・%s",
+		domain.LanguageEnglish:  "- %s",
+	},
+	"invalid_value": {
+		domain.LanguageJapanese: "指定した値が正しくありません",
+		domain.LanguageEnglish:  "The specified value is invalid",
+	},
+	"unauthenticated": {
+		domain.LanguageJapanese: "ユーザの認証に失敗しました",
+		domain.LanguageEnglish:  "Failed to authenticate the user",
+	},
+	"route_not_found": {
+		domain.LanguageJapanese: "指定したパスは見つかりません",
+		domain.LanguageEnglish:  "The specified path was not found",
+	},
+	"method_not_allowed": {
+		domain.LanguageJapanese: "指定したメソッドは許可されていません",
+		domain.LanguageEnglish:  "The specified method is not allowed",
+	},
+	"client_disconnected": {
+		domain.LanguageJapanese: "クライアントから接続が切断されました",
+		domain.LanguageEnglish:  "The client closed the connection",
+	},
+	"internal_error": {
+		domain.LanguageJapanese: "サーバ側で何らかのエラーが発生しました。時間を置いてから再度お試しください",
+		domain.LanguageEnglish:  "An error occurred on the server. Please try again later",
+	},
+	"not_implemented": {
+		domain.LanguageJapanese: "この機能はまだ実装されていません",
+		domain.LanguageEnglish:  "This feature is not implemented yet",
+	},
+	"deadline_exceeded": {
+		domain.LanguageJapanese: "リクエストは規定時間内に処理されませんでした",
+		domain.LanguageEnglish:  "The request was not processed within the time limit",
+	},
+
+	// user_error.go
+	"invalid_email_or_password": {
+		domain.LanguageJapanese: "メールアドレスかパスワードに誤りがあります",
+		domain.LanguageEnglish:  "The email address or password is incorrect",
+	},
+	"incorrect_password": {
+		domain.LanguageJapanese: "現在のパスワードが正しくありません",
+		domain.LanguageEnglish:  "The current password is incorrect",
+	},
+	"duplicate_user_email": {
+		domain.LanguageJapanese: "そのメールアドレスは既に使用されています",
+		domain.LanguageEnglish:  "The email address is already in use",
+	},
+	"too_many_sign_in_attempts": {
+		domain.LanguageJapanese: "サインインの失敗が続いたため、一時的にサインインを制限しています。しばらく時間を置いてから再度お試しください",
+		domain.LanguageEnglish:  "Sign-in is temporarily restricted due to repeated failures. Please try again later",
+	},
+	"too_many_requests": {
+		domain.LanguageJapanese: "リクエストが多すぎます。しばらく時間を置いてから再度お試しください",
+		domain.LanguageEnglish:  "Too many requests. Please try again later",
+	},
+	"oidc_not_configured": {
+		domain.LanguageJapanese: "外部のIDプロバイダによるサインインは有効になっていません",
+		domain.LanguageEnglish:  "Sign-in with an external identity provider is not enabled",
+	},
+	"invalid_oidc_state": {
+		domain.LanguageJapanese: "サインインの要求が無効か有効期限が切れています。再度サインインをお試しください",
+		domain.LanguageEnglish:  "The sign-in request is invalid or has expired. Please sign in again",
+	},
+	"oidc_authentication_failed": {
+		domain.LanguageJapanese: "IDプロバイダでの認証に失敗しました。再度サインインをお試しください",
+		domain.LanguageEnglish:  "Authentication with the identity provider failed. Please sign in again",
+	},
+	"oidc_email_required": {
+		domain.LanguageJapanese: "IDプロバイダからメールアドレスを取得できませんでした。IDプロバイダの設定を確認してください",
+		domain.LanguageEnglish:  "Could not get an email address from the identity provider. Please check the identity provider settings",
+	},
+	"oidc_email_conflict": {
+		domain.LanguageJapanese: "そのメールアドレスは既に使用されています。パスワードでサインインしてください",
+		domain.LanguageEnglish:  "The email address is already in use. Please sign in with your password",
+	},
+	"invalid_refresh_token": {
+		domain.LanguageJapanese: "リフレッシュトークンが無効です。再度サインインしてください",
+		domain.LanguageEnglish:  "The refresh token is invalid. Please sign in again",
+	},
+	"invalid_password_reset_token": {
+		domain.LanguageJapanese: "パスワード再設定用のURLが無効か有効期限が切れています。再度パスワードの再設定をお試しください",
+		domain.LanguageEnglish:  "The password reset URL is invalid or has expired. Please request a password reset again",
+	},
+	"invalid_email_verification_token": {
+		domain.LanguageJapanese: "メールアドレス確認用のURLが無効か有効期限が切れています",
+		domain.LanguageEnglish:  "The email verification URL is invalid or has expired",
+	},
+	"email_not_verified": {
+		domain.LanguageJapanese: "メールアドレスの確認が完了していません。確認用のURLからメールアドレスを確認してから再度お試しください",
+		domain.LanguageEnglish:  "Your email address has not been verified. Please verify it from the verification URL and try again",
+	},
+	"reauthentication_required": {
+		domain.LanguageJapanese: "この操作を行うには、再度サインインしてからお試しください",
+		domain.LanguageEnglish:  "Please sign in again before performing this operation",
+	},
+	"invalid_two_factor_challenge": {
+		domain.LanguageJapanese: "2段階認証に失敗しました。再度サインインしてください",
+		domain.LanguageEnglish:  "Two-factor authentication failed. Please sign in again",
+	},
+	"invalid_totp_code": {
+		domain.LanguageJapanese: "認証コードが正しくありません。認証アプリに表示されている認証コードを入力してください",
+		domain.LanguageEnglish:  "The authentication code is incorrect. Please enter the code shown in your authenticator app",
+	},
+	"two_factor_not_enrolled": {
+		domain.LanguageJapanese: "2段階認証の登録を開始してから認証コードを確認してください",
+		domain.LanguageEnglish:  "Please start two-factor authentication enrollment before confirming the authentication code",
+	},
+	"two_factor_already_enabled": {
+		domain.LanguageJapanese: "2段階認証は既に有効です",
+		domain.LanguageEnglish:  "Two-factor authentication is already enabled",
+	},
+	"insufficient_scope": {
+		domain.LanguageJapanese: "パーソナルアクセストークンにこの操作を行うスコープが付与されていません",
+		domain.LanguageEnglish:  "The personal access token does not have the scope required for this operation",
+	},
+	"personal_access_token_not_found": {
+		domain.LanguageJapanese: "指定したパーソナルアクセストークンは見つかりません",
+		domain.LanguageEnglish:  "The specified personal access token was not found",
+	},
+	"too_many_personal_access_tokens": {
+		domain.LanguageJapanese: "作成できるパーソナルアクセストークンは%d件までです。不要なトークンを削除してから再度お試しください",
+		domain.LanguageEnglish:  "You can create up to %d personal access tokens. Please delete unused tokens and try again",
+	},
+	"project_not_found": {
+		domain.LanguageJapanese: "指定したプロジェクトは見つかりません",
+		domain.LanguageEnglish:  "The specified project was not found",
+	},
+	"too_many_projects": {
+		domain.LanguageJapanese: "作成できるプロジェクトは%d件までです。不要なプロジェクトを削除してから再度お試しください",
+		domain.LanguageEnglish:  "You can create up to %d projects. Please delete unused projects and try again",
+	},
+	"too_many_members": {
+		domain.LanguageJapanese: "1つのプロジェクトに参加できるメンバーは招待中のものも含めて%d人までです",
+		domain.LanguageEnglish:  "A project can have up to %d members, including pending invitations",
+	},
+	"too_many_tasks": {
+		domain.LanguageJapanese: "1つのプロジェクトに作成できるタスクは%d件までです。不要なタスクを削除してから再度お試しください",
+		domain.LanguageEnglish:  "A project can have up to %d tasks. Please delete unused tasks and try again",
+	},
+	"too_many_steps": {
+		domain.LanguageJapanese: "1つのタスクに作成できるステップは%d件までです。不要なステップを削除してから再度お試しください",
+		domain.LanguageEnglish:  "A task can have up to %d steps. Please delete unused steps and try again",
+	},
+	"too_many_comments": {
+		domain.LanguageJapanese: "1つのタスクに作成できるコメントは%d件までです。不要なコメントを削除してから再度お試しください",
+		domain.LanguageEnglish:  "A task can have up to %d comments. Please delete unused comments and try again",
+	},
+	"too_many_tags": {
+		domain.LanguageJapanese: "作成できるタグは%d件までです。不要なタグを削除してから再度お試しください",
+		domain.LanguageEnglish:  "You can create up to %d tags. Please delete unused tags and try again",
+	},
+	"task_not_found": {
+		domain.LanguageJapanese: "指定したタスクは見つかりません",
+		domain.LanguageEnglish:  "The specified task was not found",
+	},
+	"step_not_found": {
+		domain.LanguageJapanese: "指定したステップは見つかりません",
+		domain.LanguageEnglish:  "The specified step was not found",
+	},
+	"tag_not_found": {
+		domain.LanguageJapanese: "指定したタグは見つかりません",
+		domain.LanguageEnglish:  "The specified tag was not found",
+	},
+	"comment_not_found": {
+		domain.LanguageJapanese: "指定したコメントは見つかりません",
+		domain.LanguageEnglish:  "The specified comment was not found",
+	},
+	"trash_item_not_found": {
+		domain.LanguageJapanese: "指定したゴミ箱の項目は見つかりません",
+		domain.LanguageEnglish:  "The specified trash item was not found",
+	},
+	"member_not_found": {
+		domain.LanguageJapanese: "指定したメンバーは見つかりません",
+		domain.LanguageEnglish:  "The specified member was not found",
+	},
+	"invitation_not_found": {
+		domain.LanguageJapanese: "指定した招待は見つかりません",
+		domain.LanguageEnglish:  "The specified invitation was not found",
+	},
+	"already_project_member": {
+		domain.LanguageJapanese: "指定したユーザは既にプロジェクトのメンバーです",
+		domain.LanguageEnglish:  "The specified user is already a member of the project",
+	},
+	"duplicate_invitation": {
+		domain.LanguageJapanese: "指定したメールアドレスは既に招待されています",
+		domain.LanguageEnglish:  "The specified email address has already been invited",
+	},
+	"owner_unchangeable": {
+		domain.LanguageJapanese: "プロジェクトのオーナーのロールの変更とプロジェクトからの削除はできません",
+		domain.LanguageEnglish:  "The project owner's role cannot be changed and the owner cannot be removed from the project",
+	},
+	"assignee_not_allowed": {
+		domain.LanguageJapanese: "担当者にはプロジェクトのオーナーかメンバーのみ指定できます",
+		domain.LanguageEnglish:  "Only the project owner or members can be assigned",
+	},
+	"permission_denied": {
+		domain.LanguageJapanese: "この操作を行う権限がありません",
+		domain.LanguageEnglish:  "You do not have permission to perform this operation",
+	},
+	"invalid_cursor": {
+		domain.LanguageJapanese: "カーソルが正しくありません。一覧の最初から取得し直してください",
+		domain.LanguageEnglish:  "The cursor is invalid. Please fetch the list again from the beginning",
+	},
+	"precondition_failed": {
+		domain.LanguageJapanese: "対象は他の操作によって更新されています。最新の内容を取得してから再度お試しください",
+		domain.LanguageEnglish:  "The resource has been updated by another operation. Please fetch the latest version and try again",
+	},
+	"concurrent_update": {
+		domain.LanguageJapanese: "対象が同時に更新されました。最新の内容を取得してから再度お試しください",
+		domain.LanguageEnglish:  "The resource was updated concurrently. Please fetch the latest version and try again",
+	},
+	"idempotency_key_reused": {
+		domain.LanguageJapanese: "指定したIdempotency-Keyは異なる内容のリクエストで既に使われています",
+		domain.LanguageEnglish:  "The specified Idempotency-Key has already been used for a different request",
+	},
+	"idempotency_key_in_progress": {
+		domain.LanguageJapanese: "指定したIdempotency-Keyのリクエストは処理中です。しばらくしてから再度お試しください",
+		domain.LanguageEnglish:  "A request with the specified Idempotency-Key is still being processed. Please try again later",
+	},
+
+	// ハンドラの入力値の検証
+	"email_character": {
+		domain.LanguageJapanese: "メールアドレスにはASCII文字のみ使用できます",
+		domain.LanguageEnglish:  "The email address can contain only ASCII characters",
+	},
+	"email_format": {
+		domain.LanguageJapanese: "メールアドレスの形式が正しくありません",
+		domain.LanguageEnglish:  "The email address format is invalid",
+	},
+	"email_length": {
+		domain.LanguageJapanese: "メールアドレスは3文字以上254文字以下で指定できます",
+		domain.LanguageEnglish:  "The email address must be between 3 and 254 characters",
+	},
+	"password_character": {
+		domain.LanguageJapanese: "パスワードは英数字と一部の記号のみ使用できます",
+		domain.LanguageEnglish:  "The password can contain only letters, digits and some symbols",
+	},
+	"password_length": {
+		domain.LanguageJapanese: "パスワードは12文字以上64文字以下で指定できます",
+		domain.LanguageEnglish:  "The password must be between 12 and 64 characters",
+	},
+	"password_missing_uppercase": {
+		domain.LanguageJapanese: "パスワードには少なくとも1つの大文字が必要です",
+		domain.LanguageEnglish:  "The password must contain at least one uppercase letter",
+	},
+	"password_missing_lowercase": {
+		domain.LanguageJapanese: "パスワードには少なくとも1つの小文字が必要です",
+		domain.LanguageEnglish:  "The password must contain at least one lowercase letter",
+	},
+	"password_missing_digit": {
+		domain.LanguageJapanese: "パスワードには少なくとも1つの数字が必要です",
+		domain.LanguageEnglish:  "The password must contain at least one digit",
+	},
+	"password_missing_symbol": {
+		domain.LanguageJapanese: "パスワードには少なくとも1つの記号が必要です",
+		domain.LanguageEnglish:  "The password must contain at least one symbol",
+	},
+	"comment_content_length": {
+		domain.LanguageJapanese: "コメントは1文字以上1000文字以下で指定できます",
+		domain.LanguageEnglish:  "The comment must be between 1 and 1000 characters",
+	},
+	"cursor_with_offset": {
+		domain.LanguageJapanese: "cursorとoffsetは同時に指定できません",
+		domain.LanguageEnglish:  "cursor and offset cannot be specified together",
+	},
+	"invalid_time_zone": {
+		domain.LanguageJapanese: "timeZoneには有効なIANAタイムゾーン名を指定してください",
+		domain.LanguageEnglish:  "timeZone must be a valid IANA time zone name",
+	},
+	"move_anchor_count": {
+		domain.LanguageJapanese: "before_idとafter_idはいずれか1つのみを指定できます",
+		domain.LanguageEnglish:  "Specify exactly one of before_id and after_id",
+	},
+	"move_anchor_self": {
+		domain.LanguageJapanese: "移動の基準に移動する対象自身は指定できません",
+		domain.LanguageEnglish:  "The item being moved cannot be used as its own anchor",
+	},
+	"personal_access_token_name_length": {
+		domain.LanguageJapanese: "トークン名は1文字以上80文字以下で指定できます",
+		domain.LanguageEnglish:  "The token name must be between 1 and 80 characters",
+	},
+	"personal_access_token_scopes_empty": {
+		domain.LanguageJapanese: "スコープは1つ以上指定してください",
+		domain.LanguageEnglish:  "Specify at least one scope",
+	},
+	"personal_access_token_scopes_duplicate": {
+		domain.LanguageJapanese: "同じスコープを重複して指定することはできません",
+		domain.LanguageEnglish:  "The same scope cannot be specified more than once",
+	},
+	"personal_access_token_expires_in_days": {
+		domain.LanguageJapanese: "有効期間は1日以上365日以下で指定できます",
+		domain.LanguageEnglish:  "The expiration must be between 1 and 365 days",
+	},
+	"project_name_length": {
+		domain.LanguageJapanese: "プロジェクト名は1文字以上80文字以下で指定できます",
+		domain.LanguageEnglish:  "The project name must be between 1 and 80 characters",
+	},
+	"search_query_length": {
+		domain.LanguageJapanese: "検索キーワードは空白以外の文字を含む100文字以下で指定できます",
+		domain.LanguageEnglish:  "The search query must contain a non-whitespace character and be at most 100 characters",
+	},
+	"step_name_length": {
+		domain.LanguageJapanese: "ステップ名は1文字以上100文字以下で指定できます",
+		domain.LanguageEnglish:  "The step name must be between 1 and 100 characters",
+	},
+	"tag_name_length": {
+		domain.LanguageJapanese: "タグ名は1文字以上20文字以下で指定できます",
+		domain.LanguageEnglish:  "The tag name must be between 1 and 20 characters",
+	},
+	"task_name_length": {
+		domain.LanguageJapanese: "タスク名は1文字以上100文字以下で指定できます",
+		domain.LanguageEnglish:  "The task name must be between 1 and 100 characters",
+	},
+	"task_recurrence_format": {
+		domain.LanguageJapanese: "繰り返しルールの形式が正しくありません",
+		domain.LanguageEnglish:  "The recurrence rule format is invalid",
+	},
+	"task_priority_range": {
+		domain.LanguageJapanese: "優先度の下限は上限以下で指定できます",
+		domain.LanguageEnglish:  "The minimum priority must be less than or equal to the maximum priority",
+	},
+	"task_due_range": {
+		domain.LanguageJapanese: "期日の開始日は終了日以前で指定できます",
+		domain.LanguageEnglish:  "The due date range start must be on or before its end",
+	},
+}
+
+// localize は key のメッセージを lang の言語で返す
+func localize(lang domain.Language, key string, args ...any) string {
+	messages := catalog[key]
+	format, ok := messages[lang]
+	if !ok {
+		format = messages[domain.DefaultLanguage]
+	}
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// localizeError は err のメッセージを lang の言語で返す
+// メッセージカタログにない err のメッセージはそのまま返す
+func localizeError(lang domain.Language, err error) string {
+	if msgErr, ok := errors.AsType[*MessageError](err); ok {
+		return msgErr.Localize(lang)
+	}
+	return err.Error()
+}
+
+// MessageError はメッセージカタログのメッセージをエラーメッセージとするエラーである
+// 入力値の検証エラーのように、クライアントにそのまま返すメッセージを言語ごとに切り替えるために使う
+type MessageError struct {
+	key  string
+	args []any
+}
+
+// NewMessageError はメッセージカタログの key のメッセージを持つエラーを返す
+func NewMessageError(key string, args ...any) *MessageError {
+	return &MessageError{key: key, args: args}
+}
+
+func (e *MessageError) Error() string {
+	return e.Localize(domain.DefaultLanguage)
+}
+
+// Localize はエラーメッセージを lang の言語で返す
+func (e *MessageError) Localize(lang domain.Language) string {
+	return localize(lang, e.key, e.args...)
+}
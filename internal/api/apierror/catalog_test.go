@@ -0,0 +1,70 @@
+package apierror
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalog(t *testing.T) {
+	verb := regexp.MustCompile(`%[a-z]`)
+	for key, messages := range catalog {
+		for _, lang := range domain.SupportedLanguages {
+			message, ok := messages[lang]
+			if !assert.True(t, ok, "%s has no %s message", key, lang) {
+				continue
+			}
+			// 言語によって埋め込む値の数が変わらないようにする
+			assert.Equal(t, verb.FindAllString(messages[domain.DefaultLanguage], -1), verb.FindAllString(message, -1), "%s has mismatched verbs in %s", key, lang)
+		}
+	}
+}
+
+func TestError_Message(t *testing.T) {
+	errFirst := NewMessageError("project_name_length")
+	errSecond := NewMessageError("invalid_value")
+	tests := []struct {
+		name string
+		err  Error
+		lang domain.Language
+		want string
+	}{
+		{
+			name: "args",
+			err:  TooManyTasksError(),
+			lang: domain.LanguageEnglish,
+			want: "A project can have up to 1000 tasks. Please delete unused tasks and try again",
+		},
+		{
+			name: "unsupported language falls back to default",
+			err:  TaskNotFoundError(),
+			lang: "fr",
+			want: "指定したタスクは見つかりません",
+		},
+		{
+			name: "single field error",
+			err:  DomainValidationError(WithField("name", []error{errFirst})),
+			lang: domain.LanguageEnglish,
+			want: "The project name must be between 1 and 80 characters",
+		},
+		{
+			name: "multiple field errors",
+			err:  DomainValidationError([]error{errFirst, errSecond}),
+			lang: domain.LanguageJapanese,
+			want: "リクエストに以下の問題があります。\n・プロジェクト名は1文字以上80文字以下で指定できます\n・指定した値が正しくありません",
+		},
+		{
+			name: "no field errors",
+			err:  DomainValidationError(nil),
+			lang: domain.LanguageEnglish,
+			want: "The request is invalid",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.err.Message(tt.lang))
+		})
+	}
+}
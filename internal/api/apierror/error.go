@@ -3,30 +3,73 @@ package apierror
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
 
 	ogenhttp "github.com/ogen-go/ogen/http"
 	"github.com/ogen-go/ogen/ogenerrors"
 )
 
 type Error struct {
-	err     error
-	status  int
-	message string
+	err    error
+	status int
+	// code はエラーの種類を表す安定した識別子であり、クライアントがステータスコードだけでは区別できないエラーを判別するために使う
+	code string
+	// args はメッセージカタログの code のメッセージに埋め込む値である
+	args []any
+	// fieldErrors はリクエストのフィールドごとのエラーであり、problem+json形式のレスポンスで返す
+	fieldErrors []FieldError
+	// listsFieldErrors が true の場合はメッセージにフィールドごとのエラーを列挙する
+	listsFieldErrors bool
+	// retryAfter は再試行できるまでの時間であり、0でない場合はRetry-Afterヘッダで返す
+	retryAfter time.Duration
+	// rateLimit はリクエスト数の上限であり、0でない場合はRateLimit-*ヘッダで返す
+	rateLimit int
 }
 
 func (e Error) Error() string {
 	if e.err != nil {
 		return e.err.Error()
 	}
-	return e.message
+	return e.Message(domain.DefaultLanguage)
 }
 
 func (e Error) Status() int {
 	return e.status
 }
 
-func (e Error) Message() string {
-	return e.message
+func (e Error) Code() string {
+	return e.code
+}
+
+// Message はクライアントに返すメッセージを lang の言語で返す
+func (e Error) Message(lang domain.Language) string {
+	if !e.listsFieldErrors || len(e.fieldErrors) == 0 {
+		return localize(lang, e.code, e.args...)
+	}
+	if len(e.fieldErrors) == 1 {
+		return localizeError(lang, e.fieldErrors[0].Err)
+	}
+
+	messages := make([]string, 0, len(e.fieldErrors))
+	for _, fieldErr := range e.fieldErrors {
+		messages = append(messages, localize(lang, "invalid_request_details_item", localizeError(lang, fieldErr.Err)))
+	}
+	return localize(lang, "invalid_request_details") + "\n" + strings.Join(messages, "\n")
+}
+
+func (e Error) FieldErrors() []FieldError {
+	return e.fieldErrors
+}
+
+func (e Error) RetryAfter() time.Duration {
+	return e.retryAfter
+}
+
+func (e Error) RateLimit() int {
+	return e.rateLimit
 }
 
 func ToError(err error) Error {
@@ -51,3 +94,32 @@ func ToError(err error) Error {
 		return UnknownError(err)
 	}
 }
+
+// FieldError はリクエストの特定のフィールドの値に対するエラーである
+type FieldError struct {
+	// Field はエラーの原因となったフィールドやパラメータの名前であり、特定のフィールドに対応しないエラーの場合は空文字列である
+	Field string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Message はエラーメッセージを lang の言語で返す
+func (e *FieldError) Message(lang domain.Language) string {
+	return localizeError(lang, e.Err)
+}
+
+// WithField は errs の各エラーを field のフィールドに対するエラーにする
+func WithField(field string, errs []error) []error {
+	fieldErrs := make([]error, 0, len(errs))
+	for _, err := range errs {
+		fieldErrs = append(fieldErrs, &FieldError{Field: field, Err: err})
+	}
+	return fieldErrs
+}
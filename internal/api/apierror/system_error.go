@@ -1,25 +1,52 @@
 package apierror
 
+import (
+	"errors"
+
+	"github.com/ogen-go/ogen/ogenerrors"
+	"github.com/ogen-go/ogen/validate"
+)
+
+// errInvalidValue はOpenAPIのスキーマによる検証に失敗したフィールドのエラーであり、内部の詳細なエラーはクライアントに返さない
+var errInvalidValue = NewMessageError("invalid_value")
+
 func ValidationError(err error) Error {
-	return Error{err: err, status: 400, message: "リクエストに何らかの間違いがあります"}
+	var fieldErrs []FieldError
+	if paramErr, ok := errors.AsType[*ogenerrors.DecodeParamError](err); ok {
+		fieldErrs = append(fieldErrs, FieldError{Field: paramErr.Name, Err: errInvalidValue})
+	}
+	if validateErr, ok := errors.AsType[*validate.Error](err); ok {
+		for _, f := range validateErr.Fields {
+			fieldErrs = append(fieldErrs, FieldError{Field: f.Name, Err: errInvalidValue})
+		}
+	}
+	return Error{err: err, status: 400, code: "invalid_request", fieldErrors: fieldErrs}
 }
 
 func AuthorizationError() Error {
-	return Error{status: 401, message: "ユーザの認証に失敗しました"}
+	return Error{status: 401, code: "unauthenticated"}
+}
+
+func RouteNotFoundError() Error {
+	return Error{status: 404, code: "route_not_found"}
+}
+
+func MethodNotAllowedError() Error {
+	return Error{status: 405, code: "method_not_allowed"}
 }
 
 func ClientDisconnectedError() Error {
-	return Error{status: 499, message: "クライアントから接続が切断されました"}
+	return Error{status: 499, code: "client_disconnected"}
 }
 
 func UnknownError(err error) Error {
-	return Error{err: err, status: 500, message: "サーバ側で何らかのエラーが発生しました。時間を置いてから再度お試しください"}
+	return Error{err: err, status: 500, code: "internal_error"}
 }
 
 func NotImplementedError() Error {
-	return Error{status: 501, message: "この機能はまだ実装されていません"}
+	return Error{status: 501, code: "not_implemented"}
 }
 
 func DeadlineExceededError() Error {
-	return Error{status: 504, message: "リクエストは規定時間内に処理されませんでした"}
+	return Error{status: 504, code: "deadline_exceeded"}
 }
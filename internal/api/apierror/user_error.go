@@ -2,66 +2,203 @@ package apierror
 
 import (
 	"errors"
-	"fmt"
-	"strings"
+	"time"
 
 	"github.com/minguu42/harmattan/internal/domain"
 )
 
 func DomainValidationError(errs []error) Error {
-	var message string
-	switch len(errs) {
-	case 0:
-		message = "リクエストに何らかの間違いがあります"
-	case 1:
-		message = errs[0].Error()
-	default:
-		messages := make([]string, 0, len(errs))
-		for _, err := range errs {
-			messages = append(messages, fmt.Sprintf("・%s", err.Error()))
+	fieldErrs := make([]FieldError, 0, len(errs))
+	for _, err := range errs {
+		if fieldErr, ok := errors.AsType[*FieldError](err); ok {
+			fieldErrs = append(fieldErrs, *fieldErr)
+			continue
 		}
-		message = "リクエストに以下の問題があります。\n"
-		message += strings.Join(messages, "\n")
+		fieldErrs = append(fieldErrs, FieldError{Err: err})
 	}
-	return Error{err: errors.Join(errs...), status: 400, message: message}
+	return Error{err: errors.Join(errs...), status: 400, code: "invalid_request", fieldErrors: fieldErrs, listsFieldErrors: true}
 }
 
 func InvalidEmailOrPasswordError() Error {
-	return Error{status: 400, message: "メールアドレスかパスワードに誤りがあります"}
+	return Error{status: 400, code: "invalid_email_or_password"}
+}
+
+func IncorrectPasswordError() Error {
+	return Error{status: 400, code: "incorrect_password"}
 }
 
 func DuplicateUserEmailError() Error {
-	return Error{status: 409, message: "そのメールアドレスは既に使用されています"}
+	return Error{status: 409, code: "duplicate_user_email"}
+}
+
+func TooManySignInAttemptsError(retryAfter time.Duration) Error {
+	return Error{status: 429, code: "too_many_sign_in_attempts", retryAfter: retryAfter}
+}
+
+func TooManyRequestsError(limit int, retryAfter time.Duration) Error {
+	return Error{status: 429, code: "too_many_requests", retryAfter: retryAfter, rateLimit: limit}
+}
+
+func OIDCNotConfiguredError() Error {
+	return Error{status: 404, code: "oidc_not_configured"}
+}
+
+func InvalidOIDCStateError() Error {
+	return Error{status: 400, code: "invalid_oidc_state"}
+}
+
+func OIDCAuthenticationFailedError() Error {
+	return Error{status: 401, code: "oidc_authentication_failed"}
+}
+
+func OIDCEmailRequiredError() Error {
+	return Error{status: 400, code: "oidc_email_required"}
+}
+
+func OIDCEmailConflictError() Error {
+	return Error{status: 409, code: "oidc_email_conflict"}
+}
+
+func InvalidRefreshTokenError() Error {
+	return Error{status: 401, code: "invalid_refresh_token"}
+}
+
+func InvalidPasswordResetTokenError() Error {
+	return Error{status: 400, code: "invalid_password_reset_token"}
+}
+
+func InvalidEmailVerificationTokenError() Error {
+	return Error{status: 400, code: "invalid_email_verification_token"}
+}
+
+func EmailNotVerifiedError() Error {
+	return Error{status: 403, code: "email_not_verified"}
+}
+
+func ReauthenticationRequiredError() Error {
+	return Error{status: 403, code: "reauthentication_required"}
+}
+
+func InvalidTwoFactorChallengeError() Error {
+	return Error{status: 401, code: "invalid_two_factor_challenge"}
+}
+
+func InvalidTOTPCodeError() Error {
+	return Error{status: 400, code: "invalid_totp_code"}
+}
+
+func TwoFactorNotEnrolledError() Error {
+	return Error{status: 400, code: "two_factor_not_enrolled"}
+}
+
+func TwoFactorAlreadyEnabledError() Error {
+	return Error{status: 409, code: "two_factor_already_enabled"}
+}
+
+func InsufficientScopeError() Error {
+	return Error{status: 403, code: "insufficient_scope"}
+}
+
+func PersonalAccessTokenNotFoundError() Error {
+	return Error{status: 404, code: "personal_access_token_not_found"}
+}
+
+func TooManyPersonalAccessTokensError() Error {
+	return Error{status: 409, code: "too_many_personal_access_tokens", args: []any{domain.MaxPersonalAccessTokensPerUser}}
 }
 
 func ProjectNotFoundError() Error {
-	return Error{status: 404, message: "指定したプロジェクトは見つかりません"}
+	return Error{status: 404, code: "project_not_found"}
 }
 
 func TooManyProjectsError() Error {
-	return Error{status: 409, message: fmt.Sprintf("作成できるプロジェクトは%d件までです。不要なプロジェクトを削除してから再度お試しください", domain.MaxProjectsPerUser)}
+	return Error{status: 409, code: "too_many_projects", args: []any{domain.MaxProjectsPerUser}}
+}
+
+func TooManyMembersError() Error {
+	return Error{status: 409, code: "too_many_members", args: []any{domain.MaxMembersPerProject}}
 }
 
 func TooManyTasksError() Error {
-	return Error{status: 409, message: fmt.Sprintf("1つのプロジェクトに作成できるタスクは%d件までです。不要なタスクを削除してから再度お試しください", domain.MaxTasksPerProject)}
+	return Error{status: 409, code: "too_many_tasks", args: []any{domain.MaxTasksPerProject}}
 }
 
 func TooManyStepsError() Error {
-	return Error{status: 409, message: fmt.Sprintf("1つのタスクに作成できるステップは%d件までです。不要なステップを削除してから再度お試しください", domain.MaxStepsPerTask)}
+	return Error{status: 409, code: "too_many_steps", args: []any{domain.MaxStepsPerTask}}
+}
+
+func TooManyCommentsError() Error {
+	return Error{status: 409, code: "too_many_comments", args: []any{domain.MaxCommentsPerTask}}
 }
 
 func TooManyTagsError() Error {
-	return Error{status: 409, message: fmt.Sprintf("作成できるタグは%d件までです。不要なタグを削除してから再度お試しください", domain.MaxTagsPerUser)}
+	return Error{status: 409, code: "too_many_tags", args: []any{domain.MaxTagsPerUser}}
 }
 
 func TaskNotFoundError() Error {
-	return Error{status: 404, message: "指定したタスクは見つかりません"}
+	return Error{status: 404, code: "task_not_found"}
 }
 
 func StepNotFoundError() Error {
-	return Error{status: 404, message: "指定したステップは見つかりません"}
+	return Error{status: 404, code: "step_not_found"}
 }
 
 func TagNotFoundError() Error {
-	return Error{status: 404, message: "指定したタグは見つかりません"}
+	return Error{status: 404, code: "tag_not_found"}
+}
+
+func CommentNotFoundError() Error {
+	return Error{status: 404, code: "comment_not_found"}
+}
+
+func TrashItemNotFoundError() Error {
+	return Error{status: 404, code: "trash_item_not_found"}
+}
+
+func MemberNotFoundError() Error {
+	return Error{status: 404, code: "member_not_found"}
+}
+
+func InvitationNotFoundError() Error {
+	return Error{status: 404, code: "invitation_not_found"}
+}
+
+func AlreadyProjectMemberError() Error {
+	return Error{status: 409, code: "already_project_member"}
+}
+
+func DuplicateInvitationError() Error {
+	return Error{status: 409, code: "duplicate_invitation"}
+}
+
+func OwnerUnchangeableError() Error {
+	return Error{status: 400, code: "owner_unchangeable"}
+}
+
+func AssigneeNotAllowedError() Error {
+	return Error{status: 400, code: "assignee_not_allowed"}
+}
+
+func PermissionDeniedError() Error {
+	return Error{status: 403, code: "permission_denied"}
+}
+
+func InvalidCursorError() Error {
+	return Error{status: 400, code: "invalid_cursor"}
+}
+
+func PreconditionFailedError() Error {
+	return Error{status: 412, code: "precondition_failed"}
+}
+
+func ConcurrentUpdateError() Error {
+	return Error{status: 409, code: "concurrent_update"}
+}
+
+func IdempotencyKeyReusedError() Error {
+	return Error{status: 422, code: "idempotency_key_reused"}
+}
+
+func IdempotencyKeyInProgressError() Error {
+	return Error{status: 409, code: "idempotency_key_in_progress"}
 }
@@ -12,8 +12,70 @@ type Config struct {
 	StopTimeout    time.Duration `env:"API_STOP_TIMEOUT" default:"25s"`
 	AllowedOrigins []string      `env:"API_ALLOWED_ORIGINS,required"`
 
-	IDTokenSecret     string        `env:"ID_TOKEN_SECRET,required"`
-	IDTokenExpiration time.Duration `env:"ID_TOKEN_EXPIRATION" default:"1h"`
+	// IDTokenKeys の各要素は"<kid>:<base64エンコードしたDER形式の鍵>"の形式であり、秘密鍵は署名と検証に、公開鍵は検証のみに使う
+	IDTokenKeys            []string      `env:"ID_TOKEN_KEYS,required"`
+	IDTokenActiveKeyID     string        `env:"ID_TOKEN_ACTIVE_KEY_ID,required"`
+	IDTokenExpiration      time.Duration `env:"ID_TOKEN_EXPIRATION" default:"1h"`
+	RefreshTokenExpiration time.Duration `env:"REFRESH_TOKEN_EXPIRATION" default:"720h"`
+	// IDTokenSecret は非対称鍵に移行する前にIDトークンの署名に使っていた共通鍵であり、発行済みのIDトークンの検証のみに使う
+	// 移行前のIDトークンの有効期限が切れた後の次のリリースで削除する
+	IDTokenSecret string `env:"ID_TOKEN_SECRET"`
+
+	PasswordResetTokenExpiration     time.Duration `env:"PASSWORD_RESET_TOKEN_EXPIRATION" default:"1h"`
+	EmailVerificationTokenExpiration time.Duration `env:"EMAIL_VERIFICATION_TOKEN_EXPIRATION" default:"24h"`
+	TwoFactorChallengeExpiration     time.Duration `env:"TWO_FACTOR_CHALLENGE_EXPIRATION" default:"5m"`
+	// ReauthenticationMaxAge はパスワードを設定していないユーザが退会などをするときに、直近のサインインとみなす期間である
+	ReauthenticationMaxAge time.Duration `env:"REAUTHENTICATION_MAX_AGE" default:"10m"`
+	// PasswordResetResponseTime はパスワード再設定の申請のレスポンスを遅らせる時間であり、メールアドレスが登録されているかを応答時間から推測されないようにする
+	PasswordResetResponseTime time.Duration `env:"PASSWORD_RESET_RESPONSE_TIME" default:"500ms"`
+
+	// SignInAttemptStore はサインインの失敗の記録の保存先であり、APIサーバを複数台で動かす場合は"mysql"を指定する
+	SignInAttemptStore string `env:"SIGN_IN_ATTEMPT_STORE" default:"mysql"` // "mysql" | "memory"
+
+	// RateLimit はオペレーションごとのリクエスト数の上限であり、"<回数>/<期間>"の形式で指定する
+	// 認証が必要なオペレーションはユーザごと、認証不要のオペレーションはIPアドレスごとに制限する
+	RateLimit string `env:"RATE_LIMIT" default:"300/1m"`
+	// RateLimitOperations の各要素は"<OperationID>=<回数>/<期間>"の形式であり、指定したオペレーションでは RateLimit の代わりに使う
+	RateLimitOperations []string `env:"RATE_LIMIT_OPERATIONS" default:"SignUp=10/1h,SignIn=30/1m,SignInWithTwoFactor=30/1m,RequestPasswordReset=5/1h,RefreshToken=60/1m"`
+	// IPRateLimit は認証より前に適用するIPアドレスごとのすべてのオペレーションを合わせたリクエスト数の上限である
+	IPRateLimit string `env:"IP_RATE_LIMIT" default:"600/1m"`
+	// RateLimitStore はトークンバケットの保存先であり、"memory"の場合の上限はAPIサーバごとの値になる
+	RateLimitStore         string        `env:"RATE_LIMIT_STORE" default:"memory"` // "mysql" | "memory"
+	RateLimitPurgeInterval time.Duration `env:"RATE_LIMIT_PURGE_INTERVAL" default:"1h"`
+
+	// OIDCIssuer が空の場合は、外部のIDプロバイダによるサインインを無効にする
+	OIDCIssuer   string `env:"OIDC_ISSUER"`
+	OIDCClientID string `env:"OIDC_CLIENT_ID"`
+	// OIDCClientSecret が空の場合は、パブリッククライアントとしてPKCEのみでIDプロバイダに認証する
+	OIDCClientSecret string `env:"OIDC_CLIENT_SECRET"`
+	// OIDCRedirectURL はIDプロバイダに登録したリダイレクトURIであり、Webアプリケーションのコールバックのページを指定する
+	OIDCRedirectURL           string        `env:"OIDC_REDIRECT_URL"`
+	OIDCAuthRequestExpiration time.Duration `env:"OIDC_AUTH_REQUEST_EXPIRATION" default:"10m"`
+
+	CursorSecret string `env:"CURSOR_SECRET,required"`
+
+	// WebURL はメールに記載するリンクのベースURLであり、WebアプリケーションのURLを指定する
+	WebURL       string `env:"WEB_URL,required"`
+	MailDriver   string `env:"MAIL_DRIVER" default:"smtp"` // "smtp" | "file" | "memory"
+	MailFrom     string `env:"MAIL_FROM"`
+	MailDir      string `env:"MAIL_DIR"`
+	SMTPHost     string `env:"SMTP_HOST"`
+	SMTPPort     int    `env:"SMTP_PORT" default:"587"`
+	SMTPUser     string `env:"SMTP_USER"`
+	SMTPPassword string `env:"SMTP_PASSWORD"`
+	// SMTPTimeout は1通のメールの送信にかける時間の上限である
+	SMTPTimeout time.Duration `env:"SMTP_TIMEOUT" default:"10s"`
+	// SMTPQueueSize はバックグラウンドで送信を待てるメールの数の上限である
+	SMTPQueueSize int `env:"SMTP_QUEUE_SIZE" default:"100"`
+
+	TrashRetention     time.Duration `env:"TRASH_RETENTION" default:"720h"`
+	TrashPurgeInterval time.Duration `env:"TRASH_PURGE_INTERVAL" default:"1h"`
+
+	// IdempotencyKeyExpiration はIdempotency-Keyヘッダで指定したキーとレスポンスを保持する期間であり、クライアントが再送する期間より長くする
+	IdempotencyKeyExpiration    time.Duration `env:"IDEMPOTENCY_KEY_EXPIRATION" default:"24h"`
+	IdempotencyKeyPurgeInterval time.Duration `env:"IDEMPOTENCY_KEY_PURGE_INTERVAL" default:"1h"`
+	// IdempotencyKeyLease は処理中のキーを保持する期間であり、リクエストの処理にかかる時間より長くする
+	IdempotencyKeyLease time.Duration `env:"IDEMPOTENCY_KEY_LEASE" default:"1m"`
 
 	DBHost            string        `env:"DB_HOST,required"`
 	DBPort            int           `env:"DB_PORT,required"`
@@ -3,22 +3,60 @@ package api
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/minguu42/harmattan/internal/atel"
 	"github.com/minguu42/harmattan/internal/auth"
+	"github.com/minguu42/harmattan/internal/cursor"
 	"github.com/minguu42/harmattan/internal/database"
 	"github.com/minguu42/harmattan/internal/lib/errtrace"
+	"github.com/minguu42/harmattan/internal/lockout"
+	"github.com/minguu42/harmattan/internal/mail"
+	"github.com/minguu42/harmattan/internal/oidc"
+	"github.com/minguu42/harmattan/internal/ratelimit"
 	"go.opentelemetry.io/otel/sdk/trace"
 )
 
 type Factory struct {
-	Auth                   *auth.Authenticator
-	DB                     *database.Client
-	ShutdownTracerProvider func() error
+	Auth                             *auth.Authenticator
+	Cursor                           *cursor.Codec
+	DB                               *database.Client
+	Mailer                           mail.Mailer
+	SignInGuard                      *lockout.Guard
+	RateLimiter                      *ratelimit.Limiter
+	IPRateLimiter                    *ratelimit.Limiter
+	OIDC                             *oidc.Provider
+	OIDCAuthRequestExpiration        time.Duration
+	WebURL                           string
+	PasswordResetTokenExpiration     time.Duration
+	EmailVerificationTokenExpiration time.Duration
+	TwoFactorChallengeExpiration     time.Duration
+	ReauthenticationMaxAge           time.Duration
+	PasswordResetResponseTime        time.Duration
+	TrashRetention                   time.Duration
+	IdempotencyKeyExpiration         time.Duration
+	IdempotencyKeyLease              time.Duration
+	ShutdownTracerProvider           func() error
 }
 
 func NewFactory(ctx context.Context, conf *Config) (*Factory, error) {
-	authn, err := auth.NewAuthenticator(conf.IDTokenSecret, conf.IDTokenExpiration)
+	// パスワード再設定の申請はレスポンスを遅らせるため、書き込みのタイムアウトまでに応答できるようにする
+	if conf.WriteTimeout > 0 && conf.PasswordResetResponseTime >= conf.WriteTimeout {
+		return nil, errtrace.Wrap(fmt.Errorf("password reset response time %s must be shorter than write timeout %s", conf.PasswordResetResponseTime, conf.WriteTimeout))
+	}
+
+	keys, err := auth.ParseKeys(conf.IDTokenKeys)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	authn, err := auth.NewAuthenticator(keys, conf.IDTokenActiveKeyID, conf.IDTokenSecret, conf.IDTokenExpiration, conf.RefreshTokenExpiration)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	cursorCodec, err := cursor.NewCodec(conf.CursorSecret)
 	if err != nil {
 		return nil, errtrace.Wrap(err)
 	}
@@ -39,6 +77,79 @@ func NewFactory(ctx context.Context, conf *Config) (*Factory, error) {
 		return nil, errtrace.Wrap(err)
 	}
 
+	var mailer mail.Mailer
+	switch conf.MailDriver {
+	case "smtp":
+		smtpMailer, err := mail.NewSMTPMailer(&mail.SMTPConfig{
+			Host:     conf.SMTPHost,
+			Port:     conf.SMTPPort,
+			Username: conf.SMTPUser,
+			Password: conf.SMTPPassword,
+			From:     conf.MailFrom,
+			Timeout:  conf.SMTPTimeout,
+		})
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		// SMTPサーバの応答を待たずにレスポンスを返すため、バックグラウンドで送信する
+		mailer = mail.NewAsyncMailer(smtpMailer, conf.SMTPQueueSize)
+	case "file":
+		mailer, err = mail.NewFileMailer(conf.MailDir, conf.MailFrom)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+	case "memory":
+		mailer = mail.NewMemoryMailer()
+	default:
+		return nil, errtrace.Wrap(fmt.Errorf("unknown mail driver: %q", conf.MailDriver))
+	}
+
+	var signInAttemptStore lockout.Store
+	switch conf.SignInAttemptStore {
+	case "mysql":
+		signInAttemptStore = db
+	case "memory":
+		signInAttemptStore = lockout.NewMemoryStore()
+	default:
+		return nil, errtrace.Wrap(fmt.Errorf("unknown sign-in attempt store: %q", conf.SignInAttemptStore))
+	}
+
+	defaultRateLimit, err := ratelimit.ParseLimit(conf.RateLimit)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	operationRateLimits, err := ratelimit.ParseOperationLimits(conf.RateLimitOperations)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	ipRateLimit, err := ratelimit.ParseLimit(conf.IPRateLimit)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	var rateLimitStore ratelimit.Store
+	switch conf.RateLimitStore {
+	case "mysql":
+		rateLimitStore = db
+	case "memory":
+		rateLimitStore = ratelimit.NewMemoryStore()
+	default:
+		return nil, errtrace.Wrap(fmt.Errorf("unknown rate limit store: %q", conf.RateLimitStore))
+	}
+
+	var oidcProvider *oidc.Provider
+	if conf.OIDCIssuer != "" {
+		oidcProvider, err = oidc.NewProvider(ctx, &oidc.Config{
+			Issuer:       conf.OIDCIssuer,
+			ClientID:     conf.OIDCClientID,
+			ClientSecret: conf.OIDCClientSecret,
+			RedirectURL:  conf.OIDCRedirectURL,
+			HTTPClient:   &http.Client{Timeout: 10 * time.Second},
+		})
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+	}
+
 	var exporter trace.SpanExporter
 	switch conf.TraceExporter {
 	case "otlp":
@@ -57,13 +168,32 @@ func NewFactory(ctx context.Context, conf *Config) (*Factory, error) {
 		return nil, errtrace.Wrap(err)
 	}
 	return &Factory{
-		Auth:                   authn,
-		DB:                     db,
-		ShutdownTracerProvider: shutdown,
+		Auth:                             authn,
+		Cursor:                           cursorCodec,
+		DB:                               db,
+		Mailer:                           mailer,
+		SignInGuard:                      lockout.NewGuard(signInAttemptStore, lockout.EmailPolicy, lockout.IPPolicy),
+		RateLimiter:                      ratelimit.NewLimiter(rateLimitStore, defaultRateLimit, operationRateLimits),
+		IPRateLimiter:                    ratelimit.NewLimiter(rateLimitStore, ipRateLimit, nil),
+		OIDC:                             oidcProvider,
+		OIDCAuthRequestExpiration:        conf.OIDCAuthRequestExpiration,
+		WebURL:                           conf.WebURL,
+		PasswordResetTokenExpiration:     conf.PasswordResetTokenExpiration,
+		EmailVerificationTokenExpiration: conf.EmailVerificationTokenExpiration,
+		TwoFactorChallengeExpiration:     conf.TwoFactorChallengeExpiration,
+		ReauthenticationMaxAge:           conf.ReauthenticationMaxAge,
+		PasswordResetResponseTime:        conf.PasswordResetResponseTime,
+		TrashRetention:                   conf.TrashRetention,
+		IdempotencyKeyExpiration:         conf.IdempotencyKeyExpiration,
+		IdempotencyKeyLease:              conf.IdempotencyKeyLease,
+		ShutdownTracerProvider:           shutdown,
 	}, nil
 }
 
 func (f *Factory) Close() error {
+	if m, ok := f.Mailer.(*mail.AsyncMailer); ok {
+		m.Close()
+	}
 	dbErr := f.DB.Close()
 	traceErr := f.ShutdownTracerProvider()
 	return errtrace.Wrap(errors.Join(dbErr, traceErr))
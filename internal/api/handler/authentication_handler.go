@@ -2,7 +2,6 @@ package handler
 
 import (
 	"context"
-	"errors"
 	"net/mail"
 	"regexp"
 	"strings"
@@ -11,13 +10,15 @@ import (
 	"github.com/minguu42/harmattan/internal/api/apierror"
 	"github.com/minguu42/harmattan/internal/api/openapi"
 	"github.com/minguu42/harmattan/internal/api/usecase"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/clientip"
 	"github.com/minguu42/harmattan/internal/lib/errtrace"
 )
 
 func (h *Handler) SignUp(ctx context.Context, req *openapi.SignUpReq) (*openapi.SignUpOK, error) {
 	var errs []error
-	errs = append(errs, validateEmail(req.Email)...)
-	errs = append(errs, validatePassword(req.Password)...)
+	errs = append(errs, apierror.WithField("email", validateEmail(req.Email))...)
+	errs = append(errs, apierror.WithField("password", validatePassword(req.Password))...)
 	if len(errs) > 0 {
 		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
 	}
@@ -29,37 +30,229 @@ func (h *Handler) SignUp(ctx context.Context, req *openapi.SignUpReq) (*openapi.
 	if err != nil {
 		return nil, errtrace.Wrap(err)
 	}
-	return &openapi.SignUpOK{IDToken: out.IDToken}, nil
+	return &openapi.SignUpOK{IDToken: out.IDToken, RefreshToken: out.RefreshToken}, nil
 }
 
 func (h *Handler) SignIn(ctx context.Context, req *openapi.SignInReq) (*openapi.SignInOK, error) {
 	var errs []error
-	errs = append(errs, validateEmail(req.Email)...)
-	errs = append(errs, validatePassword(req.Password)...)
+	errs = append(errs, apierror.WithField("email", validateEmail(req.Email))...)
+	errs = append(errs, apierror.WithField("password", validatePassword(req.Password))...)
 	if len(errs) > 0 {
 		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
 	}
 
 	out, err := h.Authentication.SignIn(ctx, &usecase.SignInInput{
-		Email:    req.Email,
+		Email:     req.Email,
+		Password:  req.Password,
+		IPAddress: clientip.FromContext(ctx),
+	})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &openapi.SignInOK{
+		IDToken:        openapi.OptString{Value: out.IDToken, Set: out.IDToken != ""},
+		RefreshToken:   openapi.OptString{Value: out.RefreshToken, Set: out.RefreshToken != ""},
+		ChallengeToken: openapi.OptString{Value: out.ChallengeToken, Set: out.ChallengeToken != ""},
+	}, nil
+}
+
+func (h *Handler) SignInWithTwoFactor(ctx context.Context, req *openapi.SignInWithTwoFactorReq) (*openapi.SignInWithTwoFactorOK, error) {
+	out, err := h.Authentication.SignInWithTwoFactor(ctx, &usecase.SignInWithTwoFactorInput{
+		ChallengeToken: req.ChallengeToken,
+		Code:           req.Code,
+	})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &openapi.SignInWithTwoFactorOK{IDToken: out.IDToken, RefreshToken: out.RefreshToken}, nil
+}
+
+func (h *Handler) StartOIDCSignIn(ctx context.Context) (*openapi.StartOIDCSignInOK, error) {
+	out, err := h.Authentication.StartOIDCSignIn(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &openapi.StartOIDCSignInOK{AuthorizationURL: out.AuthorizationURL, State: out.State}, nil
+}
+
+func (h *Handler) SignInWithOIDC(ctx context.Context, req *openapi.SignInWithOIDCReq) (*openapi.SignInWithOIDCOK, error) {
+	out, err := h.Authentication.SignInWithOIDC(ctx, &usecase.SignInWithOIDCInput{
+		State: req.State,
+		Code:  req.Code,
+	})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &openapi.SignInWithOIDCOK{
+		IDToken:        openapi.OptString{Value: out.IDToken, Set: out.IDToken != ""},
+		RefreshToken:   openapi.OptString{Value: out.RefreshToken, Set: out.RefreshToken != ""},
+		ChallengeToken: openapi.OptString{Value: out.ChallengeToken, Set: out.ChallengeToken != ""},
+	}, nil
+}
+
+func (h *Handler) RefreshToken(ctx context.Context, req *openapi.RefreshTokenReq) (*openapi.RefreshTokenOK, error) {
+	out, err := h.Authentication.RefreshToken(ctx, &usecase.RefreshTokenInput{RefreshToken: req.RefreshToken})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &openapi.RefreshTokenOK{IDToken: out.IDToken, RefreshToken: out.RefreshToken}, nil
+}
+
+func (h *Handler) SignOut(ctx context.Context, req *openapi.SignOutReq) error {
+	if err := h.Authentication.SignOut(ctx, &usecase.SignOutInput{RefreshToken: req.RefreshToken}); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func (h *Handler) RequestPasswordReset(ctx context.Context, req *openapi.RequestPasswordResetReq) error {
+	if errs := apierror.WithField("email", validateEmail(req.Email)); len(errs) > 0 {
+		return errtrace.Wrap(apierror.DomainValidationError(errs))
+	}
+
+	if err := h.Authentication.RequestPasswordReset(ctx, &usecase.RequestPasswordResetInput{Email: req.Email}); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func (h *Handler) ConfirmPasswordReset(ctx context.Context, req *openapi.ConfirmPasswordResetReq) error {
+	if errs := apierror.WithField("password", validatePassword(req.Password)); len(errs) > 0 {
+		return errtrace.Wrap(apierror.DomainValidationError(errs))
+	}
+
+	if err := h.Authentication.ConfirmPasswordReset(ctx, &usecase.ConfirmPasswordResetInput{
+		Token:    req.Token,
 		Password: req.Password,
+	}); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func (h *Handler) VerifyEmail(ctx context.Context, req *openapi.VerifyEmailReq) error {
+	if err := h.Authentication.VerifyEmail(ctx, &usecase.VerifyEmailInput{Token: req.Token}); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func (h *Handler) GetMe(ctx context.Context) (*openapi.User, error) {
+	out, err := h.Authentication.GetMe(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return convertUser(out.User), nil
+}
+
+func (h *Handler) UpdateEmail(ctx context.Context, req *openapi.UpdateEmailReq) (*openapi.User, error) {
+	if errs := apierror.WithField("email", validateEmail(req.Email)); len(errs) > 0 {
+		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
+	}
+
+	out, err := h.Authentication.UpdateEmail(ctx, &usecase.UpdateEmailInput{
+		Email:    req.Email,
+		Password: req.Password.Value,
 	})
 	if err != nil {
 		return nil, errtrace.Wrap(err)
 	}
-	return &openapi.SignInOK{IDToken: out.IDToken}, nil
+	return convertUser(out.User), nil
+}
+
+func (h *Handler) UpdateLanguage(ctx context.Context, req *openapi.UpdateLanguageReq) (*openapi.User, error) {
+	var lang domain.Language
+	if v, ok := req.Language.Get(); ok {
+		lang = domain.Language(v)
+	}
+
+	out, err := h.Authentication.UpdateLanguage(ctx, &usecase.UpdateLanguageInput{Language: lang})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return convertUser(out.User), nil
+}
+
+func (h *Handler) ChangePassword(ctx context.Context, req *openapi.ChangePasswordReq) (*openapi.ChangePasswordOK, error) {
+	if errs := apierror.WithField("new_password", validatePassword(req.NewPassword)); len(errs) > 0 {
+		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
+	}
+
+	out, err := h.Authentication.ChangePassword(ctx, &usecase.ChangePasswordInput{
+		CurrentPassword: req.CurrentPassword.Value,
+		NewPassword:     req.NewPassword,
+	})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &openapi.ChangePasswordOK{IDToken: out.IDToken, RefreshToken: out.RefreshToken}, nil
+}
+
+func (h *Handler) DeleteAccount(ctx context.Context, req *openapi.DeleteAccountReq) error {
+	if err := h.Authentication.DeleteAccount(ctx, &usecase.DeleteAccountInput{Password: req.Password.Value}); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func (h *Handler) EnrollTOTP(ctx context.Context) (*openapi.EnrollTOTPOK, error) {
+	out, err := h.Authentication.EnrollTOTP(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &openapi.EnrollTOTPOK{Secret: out.Secret, OtpauthURI: out.URI}, nil
+}
+
+func (h *Handler) ConfirmTOTP(ctx context.Context, req *openapi.ConfirmTOTPReq) (*openapi.ConfirmTOTPOK, error) {
+	out, err := h.Authentication.ConfirmTOTP(ctx, &usecase.ConfirmTOTPInput{Code: req.Code})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &openapi.ConfirmTOTPOK{RecoveryCodes: out.RecoveryCodes}, nil
+}
+
+func (h *Handler) GetJWKS(ctx context.Context) (*openapi.GetJWKSOK, error) {
+	out := h.Authentication.GetJWKS(ctx)
+
+	keys := make([]openapi.Jwk, 0, len(out.Keys))
+	for _, k := range out.Keys {
+		keys = append(keys, openapi.Jwk{
+			Kty: k.KeyType,
+			Kid: k.KeyID,
+			Alg: k.Algorithm,
+			Use: k.Use,
+			Crv: openapi.OptString{Value: k.Curve, Set: k.Curve != ""},
+			X:   openapi.OptString{Value: k.X, Set: k.X != ""},
+			N:   openapi.OptString{Value: k.N, Set: k.N != ""},
+			E:   openapi.OptString{Value: k.E, Set: k.E != ""},
+		})
+	}
+	return &openapi.GetJWKSOK{Keys: keys}, nil
+}
+
+func convertUser(u *domain.User) *openapi.User {
+	return &openapi.User{
+		ID:               string(u.ID),
+		Email:            u.Email,
+		EmailVerifiedAt:  convertOptDateTime(u.EmailVerifiedAt),
+		PendingEmail:     openapi.OptString{Value: u.PendingEmail, Set: u.PendingEmail != ""},
+		TwoFactorEnabled: u.TwoFactorEnabled(),
+		Language:         openapi.OptUserLanguage{Value: openapi.UserLanguage(u.Language), Set: u.Language != ""},
+		CreatedAt:        u.CreatedAt,
+		UpdatedAt:        u.UpdatedAt,
+	}
 }
 
 var (
-	ErrEmailCharacter           = errors.New("メールアドレスにはASCII文字のみ使用できます")
-	ErrEmailFormat              = errors.New("メールアドレスの形式が正しくありません")
-	ErrEmailLength              = errors.New("メールアドレスは3文字以上254文字以下で指定できます")
-	ErrPasswordCharacter        = errors.New("パスワードは英数字と一部の記号のみ使用できます")
-	ErrPasswordLength           = errors.New("パスワードは12文字以上64文字以下で指定できます")
-	ErrPasswordMissingUppercase = errors.New("パスワードには少なくとも1つの大文字が必要です")
-	ErrPasswordMissingLowercase = errors.New("パスワードには少なくとも1つの小文字が必要です")
-	ErrPasswordMissingDigit     = errors.New("パスワードには少なくとも1つの数字が必要です")
-	ErrPasswordMissingSymbol    = errors.New("パスワードには少なくとも1つの記号が必要です")
+	ErrEmailCharacter           = apierror.NewMessageError("email_character")
+	ErrEmailFormat              = apierror.NewMessageError("email_format")
+	ErrEmailLength              = apierror.NewMessageError("email_length")
+	ErrPasswordCharacter        = apierror.NewMessageError("password_character")
+	ErrPasswordLength           = apierror.NewMessageError("password_length")
+	ErrPasswordMissingUppercase = apierror.NewMessageError("password_missing_uppercase")
+	ErrPasswordMissingLowercase = apierror.NewMessageError("password_missing_lowercase")
+	ErrPasswordMissingDigit     = apierror.NewMessageError("password_missing_digit")
+	ErrPasswordMissingSymbol    = apierror.NewMessageError("password_missing_symbol")
 )
 
 func validateEmail(email string) []error {
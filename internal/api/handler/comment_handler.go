@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"github.com/minguu42/harmattan/internal/api/apierror"
+	"github.com/minguu42/harmattan/internal/api/openapi"
+	"github.com/minguu42/harmattan/internal/api/usecase"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+func (h *Handler) CreateComment(ctx context.Context, req *openapi.CreateCommentReq, params openapi.CreateCommentParams) (*openapi.Comment, error) {
+	var errs []error
+	errs = append(errs, apierror.WithField("content", validateCommentContent(req.Content))...)
+	if len(errs) > 0 {
+		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
+	}
+
+	out, err := h.Comment.CreateComment(ctx, &usecase.CreateCommentInput{
+		TaskID:  domain.TaskID(params.TaskID),
+		Content: req.Content,
+	})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return convertComment(out.Comment), nil
+}
+
+func (h *Handler) ListComments(ctx context.Context, params openapi.ListCommentsParams) (*openapi.ListCommentsOK, error) {
+	if errs := validatePagination(params.Offset.Value, params.Cursor.Value); len(errs) > 0 {
+		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
+	}
+
+	out, err := h.Comment.ListComments(ctx, &usecase.ListCommentsInput{
+		TaskID: domain.TaskID(params.TaskID),
+		Limit:  params.Limit.Value,
+		Offset: params.Offset.Value,
+		Cursor: params.Cursor.Value,
+	})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &openapi.ListCommentsOK{
+		Comments:   convertComments(out.Comments),
+		HasNext:    out.HasNext,
+		NextCursor: openapi.OptString{Value: out.NextCursor, Set: out.HasNext},
+	}, nil
+}
+
+func (h *Handler) UpdateComment(ctx context.Context, req *openapi.UpdateCommentReq, params openapi.UpdateCommentParams) (*openapi.Comment, error) {
+	var errs []error
+	if content, ok := req.Content.Get(); ok {
+		errs = append(errs, apierror.WithField("content", validateCommentContent(content))...)
+	}
+	if len(errs) > 0 {
+		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
+	}
+
+	out, err := h.Comment.UpdateComment(ctx, &usecase.UpdateCommentInput{
+		TaskID:  domain.TaskID(params.TaskID),
+		ID:      domain.CommentID(params.CommentID),
+		Content: usecase.Option[string]{V: req.Content.Value, Valid: req.Content.Set},
+	})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return convertComment(out.Comment), nil
+}
+
+func (h *Handler) DeleteComment(ctx context.Context, params openapi.DeleteCommentParams) error {
+	if err := h.Comment.DeleteComment(ctx, &usecase.DeleteCommentInput{
+		TaskID: domain.TaskID(params.TaskID),
+		ID:     domain.CommentID(params.CommentID),
+	}); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+var ErrCommentContentLength = apierror.NewMessageError("comment_content_length")
+
+func validateCommentContent(content string) []error {
+	var errs []error
+	if utf8.RuneCountInString(content) < 1 || 1000 < utf8.RuneCountInString(content) {
+		errs = append(errs, ErrCommentContentLength)
+	}
+	return errs
+}
+
+func convertComment(c *domain.Comment) *openapi.Comment {
+	return &openapi.Comment{
+		ID:        string(c.ID),
+		TaskID:    string(c.TaskID),
+		Content:   c.Content,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+func convertComments(comments domain.Comments) []openapi.Comment {
+	cs := make([]openapi.Comment, 0, len(comments))
+	for _, c := range comments {
+		cs = append(cs, *convertComment(&c))
+	}
+	return cs
+}
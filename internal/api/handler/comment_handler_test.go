@@ -0,0 +1,32 @@
+package handler_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/minguu42/harmattan/internal/api/handler"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCommentContent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		content string
+		want    []error
+	}{
+		{name: "empty", content: "", want: []error{handler.ErrCommentContentLength}},
+		{name: "min_length_boundary", content: "a"},
+		{name: "max_length_boundary", content: strings.Repeat("a", 1000)},
+		{name: "max_length_boundary_multibyte", content: strings.Repeat("あ", 1000)},
+		{name: "above_max_length", content: strings.Repeat("a", 1001), want: []error{handler.ErrCommentContentLength}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.ElementsMatch(t, tt.want, handler.ValidateCommentContent(tt.content))
+		})
+	}
+}
@@ -1,14 +1,25 @@
 package handler
 
 var (
-	ConvertOptDate      = convertOptDate
-	ConvertOptDateTime  = convertOptDateTime
-	ValidateEmail       = validateEmail
-	ValidatePassword    = validatePassword
-	ValidateProjectName = validateProjectName
-	ValidateTaskName    = validateTaskName
-	ValidateStepName    = validateStepName
-	ValidateTagName     = validateTagName
+	ConvertOptDate                           = convertOptDate
+	ConvertOptDateTime                       = convertOptDateTime
+	ConvertOptString                         = convertOptString[string]
+	LoadTimeZone                             = loadTimeZone
+	ValidateCommentContent                   = validateCommentContent
+	ValidateEmail                            = validateEmail
+	ValidateMove                             = validateMove
+	ValidatePagination                       = validatePagination
+	ValidatePassword                         = validatePassword
+	ValidatePersonalAccessTokenName          = validatePersonalAccessTokenName
+	ValidatePersonalAccessTokenScopes        = validatePersonalAccessTokenScopes
+	ValidatePersonalAccessTokenExpiresInDays = validatePersonalAccessTokenExpiresInDays
+	ValidateProjectName                      = validateProjectName
+	ValidateSearchQuery                      = validateSearchQuery
+	ValidateTaskName                         = validateTaskName
+	ValidateTaskRecurrence                   = validateTaskRecurrence
+	ValidateTaskFilter                       = validateTaskFilter
+	ValidateStepName                         = validateStepName
+	ValidateTagName                          = validateTagName
 )
 
 func Ternary[T any](condition bool, trueVal, falseVal T) T {
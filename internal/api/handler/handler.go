@@ -3,19 +3,27 @@ package handler
 import (
 	"time"
 
+	"github.com/minguu42/harmattan/internal/api/apierror"
 	"github.com/minguu42/harmattan/internal/api/openapi"
 	"github.com/minguu42/harmattan/internal/api/usecase"
+	"github.com/minguu42/harmattan/internal/lib/etag"
 	"github.com/minguu42/harmattan/internal/lib/plain"
 )
 
 type Handler struct {
 	openapi.UnimplementedHandler
-	Authentication usecase.Authentication
-	Monitoring     usecase.Monitoring
-	Project        usecase.Project
-	Step           usecase.Step
-	Tag            usecase.Tag
-	Task           usecase.Task
+	Authentication      usecase.Authentication
+	Comment             usecase.Comment
+	History             usecase.History
+	Member              usecase.Member
+	Monitoring          usecase.Monitoring
+	PersonalAccessToken usecase.PersonalAccessToken
+	Project             usecase.Project
+	Search              usecase.Search
+	Step                usecase.Step
+	Tag                 usecase.Tag
+	Task                usecase.Task
+	Trash               usecase.Trash
 }
 
 type ErrorResponse struct {
@@ -23,6 +31,55 @@ type ErrorResponse struct {
 	Message string `json:"message"`
 }
 
+var ErrCursorWithOffset = apierror.NewMessageError("cursor_with_offset")
+
+// validatePagination はページネーションのパラメータの組み合わせを検証する
+func validatePagination(offset int, cursor string) []error {
+	var errs []error
+	if cursor != "" && offset != 0 {
+		errs = append(errs, ErrCursorWithOffset)
+	}
+	return errs
+}
+
+var ErrInvalidTimeZone = apierror.NewMessageError("invalid_time_zone")
+
+// loadTimeZone は IANA タイムゾーン名からロケーションを返し、名前が空の場合はサーバのロケーションを返す
+func loadTimeZone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.Local, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, ErrInvalidTimeZone
+	}
+	return loc, nil
+}
+
+var (
+	ErrMoveAnchorCount = apierror.NewMessageError("move_anchor_count")
+	ErrMoveAnchorSelf  = apierror.NewMessageError("move_anchor_self")
+)
+
+// validateMove は並び替えの基準となる要素の指定を検証する
+// beforeID と afterID は指定されていない場合に空文字列である
+func validateMove(id, beforeID, afterID string) []error {
+	var errs []error
+	if (beforeID == "") == (afterID == "") {
+		errs = append(errs, ErrMoveAnchorCount)
+	}
+	if beforeID == id || afterID == id {
+		errs = append(errs, ErrMoveAnchorSelf)
+	}
+	return errs
+}
+
+// notModified は If-None-Match ヘッダ ifNoneMatch が現在のエンティティタグ current と一致し、304を返せるかを判定する
+func notModified(ifNoneMatch openapi.OptString, current string) bool {
+	v, ok := ifNoneMatch.Get()
+	return ok && etag.MatchWeak(v, current)
+}
+
 func ternary[T any](condition bool, trueVal, falseVal T) T {
 	if condition {
 		return trueVal
@@ -44,6 +101,13 @@ func convertOptDateTime(t *time.Time) openapi.OptDateTime {
 	return openapi.OptDateTime{}
 }
 
+func convertOptString[T ~string](s *T) openapi.OptString {
+	if s != nil {
+		return openapi.OptString{Value: string(*s), Set: true}
+	}
+	return openapi.OptString{}
+}
+
 func convertSlice[T ~string](s []string) []T {
 	r := make([]T, 0, len(s))
 	for _, e := range s {
@@ -10,6 +10,85 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func TestValidatePagination(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		offset int
+		cursor string
+		want   []error
+	}{
+		{name: "offset_only", offset: 10},
+		{name: "cursor_only", cursor: "xxx"},
+		{name: "cursor_with_zero_offset", offset: 0, cursor: "xxx"},
+		{name: "cursor_with_offset", offset: 10, cursor: "xxx", want: []error{handler.ErrCursorWithOffset}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.ElementsMatch(t, tt.want, handler.ValidatePagination(tt.offset, tt.cursor))
+		})
+	}
+}
+
+func TestLoadTimeZone(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		tz       string
+		wantName string
+		wantErr  error
+	}{
+		{name: "empty", tz: "", wantName: time.Local.String()},
+		{name: "utc", tz: "UTC", wantName: "UTC"},
+		{name: "iana_name", tz: "America/Los_Angeles", wantName: "America/Los_Angeles"},
+		{name: "unknown_name", tz: "Mars/Olympus_Mons", wantErr: handler.ErrInvalidTimeZone},
+		{name: "utc_offset", tz: "+09:00", wantErr: handler.ErrInvalidTimeZone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := handler.LoadTimeZone(tt.tz)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantName, got.String())
+		})
+	}
+}
+
+func TestValidateMove(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		id       string
+		beforeID string
+		afterID  string
+		want     []error
+	}{
+		{name: "before_only", id: "task01", beforeID: "task02"},
+		{name: "after_only", id: "task01", afterID: "task02"},
+		{name: "neither", id: "task01", want: []error{handler.ErrMoveAnchorCount}},
+		{name: "both", id: "task01", beforeID: "task02", afterID: "task03", want: []error{handler.ErrMoveAnchorCount}},
+		{name: "before_self", id: "task01", beforeID: "task01", want: []error{handler.ErrMoveAnchorSelf}},
+		{name: "after_self", id: "task01", afterID: "task01", want: []error{handler.ErrMoveAnchorSelf}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.ElementsMatch(t, tt.want, handler.ValidateMove(tt.id, tt.beforeID, tt.afterID))
+		})
+	}
+}
+
 func TestTernary(t *testing.T) {
 	t.Parallel()
 
@@ -71,3 +150,19 @@ func TestConvertOptDate(t *testing.T) {
 		assert.Equal(t, tt.want, got)
 	}
 }
+
+func TestConvertOptString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s    *string
+		want openapi.OptString
+	}{
+		{s: nil, want: openapi.OptString{}},
+		{s: new("FREQ=DAILY"), want: openapi.OptString{Value: "FREQ=DAILY", Set: true}},
+	}
+	for _, tt := range tests {
+		got := handler.ConvertOptString(tt.s)
+		assert.Equal(t, tt.want, got)
+	}
+}
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-faster/jx"
+	"github.com/minguu42/harmattan/internal/api/apierror"
+	"github.com/minguu42/harmattan/internal/api/openapi"
+	"github.com/minguu42/harmattan/internal/api/usecase"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+func (h *Handler) ListProjectHistory(ctx context.Context, params openapi.ListProjectHistoryParams) (*openapi.ListProjectHistoryOK, error) {
+	if errs := validatePagination(params.Offset.Value, params.Cursor.Value); len(errs) > 0 {
+		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
+	}
+
+	out, err := h.History.ListProjectHistory(ctx, &usecase.ListProjectHistoryInput{
+		ProjectID: domain.ProjectID(params.ProjectID),
+		Limit:     params.Limit.Value,
+		Offset:    params.Offset.Value,
+		Cursor:    params.Cursor.Value,
+	})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	entries, err := convertHistoryEntries(out.Entries)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &openapi.ListProjectHistoryOK{
+		Entries:    entries,
+		HasNext:    out.HasNext,
+		NextCursor: openapi.OptString{Value: out.NextCursor, Set: out.HasNext},
+	}, nil
+}
+
+func (h *Handler) ListTaskHistory(ctx context.Context, params openapi.ListTaskHistoryParams) (*openapi.ListTaskHistoryOK, error) {
+	if errs := validatePagination(params.Offset.Value, params.Cursor.Value); len(errs) > 0 {
+		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
+	}
+
+	out, err := h.History.ListTaskHistory(ctx, &usecase.ListTaskHistoryInput{
+		TaskID: domain.TaskID(params.TaskID),
+		Limit:  params.Limit.Value,
+		Offset: params.Offset.Value,
+		Cursor: params.Cursor.Value,
+	})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	entries, err := convertHistoryEntries(out.Entries)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &openapi.ListTaskHistoryOK{
+		Entries:    entries,
+		HasNext:    out.HasNext,
+		NextCursor: openapi.OptString{Value: out.NextCursor, Set: out.HasNext},
+	}, nil
+}
+
+func convertHistoryEntry(e *domain.HistoryEntry) (*openapi.HistoryEntry, error) {
+	changes := make([]openapi.FieldChange, 0, len(e.Changes))
+	for _, c := range e.Changes {
+		before, err := json.Marshal(c.Before)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		after, err := json.Marshal(c.After)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		changes = append(changes, openapi.FieldChange{Field: c.Field, Before: jx.Raw(before), After: jx.Raw(after)})
+	}
+	return &openapi.HistoryEntry{
+		ID:         int64(e.ID),
+		UserID:     convertOptString(e.UserID),
+		EntityType: openapi.HistoryEntryEntityType(e.EntityType),
+		EntityID:   e.EntityID,
+		Action:     openapi.HistoryEntryAction(e.Action),
+		Changes:    changes,
+		CreatedAt:  e.CreatedAt,
+	}, nil
+}
+
+func convertHistoryEntries(entries domain.HistoryEntries) ([]openapi.HistoryEntry, error) {
+	es := make([]openapi.HistoryEntry, 0, len(entries))
+	for _, e := range entries {
+		converted, err := convertHistoryEntry(&e)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		es = append(es, *converted)
+	}
+	return es, nil
+}
@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/minguu42/harmattan/internal/api/apierror"
+	"github.com/minguu42/harmattan/internal/api/openapi"
+	"github.com/minguu42/harmattan/internal/api/usecase"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+func (h *Handler) ListProjectMembers(ctx context.Context, params openapi.ListProjectMembersParams) (*openapi.ListProjectMembersOK, error) {
+	out, err := h.Member.ListProjectMembers(ctx, &usecase.ListProjectMembersInput{
+		ProjectID: domain.ProjectID(params.ProjectID),
+	})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &openapi.ListProjectMembersOK{
+		Members:     convertProjectMembers(out.Members),
+		Invitations: convertProjectInvitations(out.Invitations),
+	}, nil
+}
+
+func (h *Handler) InviteProjectMember(ctx context.Context, req *openapi.InviteProjectMemberReq, params openapi.InviteProjectMemberParams) (*openapi.ProjectInvitation, error) {
+	var errs []error
+	errs = append(errs, apierror.WithField("email", validateEmail(req.Email))...)
+	if len(errs) > 0 {
+		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
+	}
+
+	out, err := h.Member.InviteProjectMember(ctx, &usecase.InviteProjectMemberInput{
+		ProjectID: domain.ProjectID(params.ProjectID),
+		Email:     req.Email,
+		Role:      domain.ProjectRole(req.Role),
+	})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return convertProjectInvitation(out.Invitation), nil
+}
+
+func (h *Handler) UpdateProjectMember(ctx context.Context, req *openapi.UpdateProjectMemberReq, params openapi.UpdateProjectMemberParams) (*openapi.ProjectMember, error) {
+	out, err := h.Member.UpdateProjectMember(ctx, &usecase.UpdateProjectMemberInput{
+		ProjectID: domain.ProjectID(params.ProjectID),
+		UserID:    domain.UserID(params.UserID),
+		Role:      domain.ProjectRole(req.Role),
+	})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return convertProjectMember(out.Member), nil
+}
+
+func (h *Handler) DeleteProjectMember(ctx context.Context, params openapi.DeleteProjectMemberParams) error {
+	if err := h.Member.DeleteProjectMember(ctx, &usecase.DeleteProjectMemberInput{
+		ProjectID: domain.ProjectID(params.ProjectID),
+		UserID:    domain.UserID(params.UserID),
+	}); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func (h *Handler) ListInvitations(ctx context.Context) (*openapi.ListInvitationsOK, error) {
+	out, err := h.Member.ListInvitations(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &openapi.ListInvitationsOK{Invitations: convertProjectInvitations(out.Invitations)}, nil
+}
+
+func (h *Handler) AcceptInvitation(ctx context.Context, params openapi.AcceptInvitationParams) (*openapi.ProjectMember, error) {
+	out, err := h.Member.AcceptInvitation(ctx, &usecase.AcceptInvitationInput{
+		ID: domain.ProjectInvitationID(params.InvitationID),
+	})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return convertProjectMember(out.Member), nil
+}
+
+func (h *Handler) DeleteInvitation(ctx context.Context, params openapi.DeleteInvitationParams) error {
+	if err := h.Member.DeleteInvitation(ctx, &usecase.DeleteInvitationInput{
+		ID: domain.ProjectInvitationID(params.InvitationID),
+	}); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func convertProjectMember(m *domain.ProjectMember) *openapi.ProjectMember {
+	return &openapi.ProjectMember{
+		UserID:    string(m.UserID),
+		Email:     m.Email,
+		Role:      openapi.ProjectMemberRole(m.Role),
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+func convertProjectMembers(members domain.ProjectMembers) []openapi.ProjectMember {
+	ms := make([]openapi.ProjectMember, 0, len(members))
+	for _, m := range members {
+		ms = append(ms, *convertProjectMember(&m))
+	}
+	return ms
+}
+
+func convertProjectInvitation(i *domain.ProjectInvitation) *openapi.ProjectInvitation {
+	return &openapi.ProjectInvitation{
+		ID:          string(i.ID),
+		ProjectID:   string(i.ProjectID),
+		ProjectName: i.ProjectName,
+		Email:       i.Email,
+		Role:        openapi.ProjectInvitationRole(i.Role),
+		CreatedAt:   i.CreatedAt,
+	}
+}
+
+func convertProjectInvitations(invitations domain.ProjectInvitations) []openapi.ProjectInvitation {
+	is := make([]openapi.ProjectInvitation, 0, len(invitations))
+	for _, i := range invitations {
+		is = append(is, *convertProjectInvitation(&i))
+	}
+	return is
+}
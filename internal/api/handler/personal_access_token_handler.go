@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"context"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	"github.com/minguu42/harmattan/internal/api/apierror"
+	"github.com/minguu42/harmattan/internal/api/openapi"
+	"github.com/minguu42/harmattan/internal/api/usecase"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+func (h *Handler) CreatePersonalAccessToken(ctx context.Context, req *openapi.CreatePersonalAccessTokenReq) (*openapi.CreatePersonalAccessTokenOK, error) {
+	scopes := make([]domain.Scope, 0, len(req.Scopes))
+	for _, s := range req.Scopes {
+		scopes = append(scopes, domain.Scope(s))
+	}
+
+	var errs []error
+	errs = append(errs, apierror.WithField("name", validatePersonalAccessTokenName(req.Name))...)
+	errs = append(errs, apierror.WithField("scopes", validatePersonalAccessTokenScopes(scopes))...)
+	if days, ok := req.ExpiresInDays.Get(); ok {
+		errs = append(errs, apierror.WithField("expires_in_days", validatePersonalAccessTokenExpiresInDays(days))...)
+	}
+	if len(errs) > 0 {
+		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
+	}
+
+	out, err := h.PersonalAccessToken.CreatePersonalAccessToken(ctx, &usecase.CreatePersonalAccessTokenInput{
+		Name:      req.Name,
+		Scopes:    scopes,
+		ExpiresIn: time.Duration(req.ExpiresInDays.Value) * 24 * time.Hour,
+	})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &openapi.CreatePersonalAccessTokenOK{
+		Token:               out.Token,
+		PersonalAccessToken: *convertPersonalAccessToken(out.PersonalAccessToken),
+	}, nil
+}
+
+func (h *Handler) ListPersonalAccessTokens(ctx context.Context) (*openapi.ListPersonalAccessTokensOK, error) {
+	out, err := h.PersonalAccessToken.ListPersonalAccessTokens(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	ts := make([]openapi.PersonalAccessToken, 0, len(out.PersonalAccessTokens))
+	for _, t := range out.PersonalAccessTokens {
+		ts = append(ts, *convertPersonalAccessToken(&t))
+	}
+	return &openapi.ListPersonalAccessTokensOK{PersonalAccessTokens: ts}, nil
+}
+
+func (h *Handler) DeletePersonalAccessToken(ctx context.Context, params openapi.DeletePersonalAccessTokenParams) error {
+	if err := h.PersonalAccessToken.DeletePersonalAccessToken(ctx, &usecase.DeletePersonalAccessTokenInput{
+		ID: domain.PersonalAccessTokenID(params.TokenID),
+	}); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+var (
+	ErrPersonalAccessTokenNameLength      = apierror.NewMessageError("personal_access_token_name_length")
+	ErrPersonalAccessTokenScopesEmpty     = apierror.NewMessageError("personal_access_token_scopes_empty")
+	ErrPersonalAccessTokenScopesDuplicate = apierror.NewMessageError("personal_access_token_scopes_duplicate")
+	ErrPersonalAccessTokenExpiresInDays   = apierror.NewMessageError("personal_access_token_expires_in_days")
+)
+
+func validatePersonalAccessTokenName(name string) []error {
+	var errs []error
+	if utf8.RuneCountInString(name) < 1 || 80 < utf8.RuneCountInString(name) {
+		errs = append(errs, ErrPersonalAccessTokenNameLength)
+	}
+	return errs
+}
+
+func validatePersonalAccessTokenScopes(scopes []domain.Scope) []error {
+	var errs []error
+	if len(scopes) == 0 {
+		errs = append(errs, ErrPersonalAccessTokenScopesEmpty)
+	}
+	if len(slices.Compact(slices.Sorted(slices.Values(scopes)))) != len(scopes) {
+		errs = append(errs, ErrPersonalAccessTokenScopesDuplicate)
+	}
+	return errs
+}
+
+func validatePersonalAccessTokenExpiresInDays(days int) []error {
+	var errs []error
+	if days < 1 || 365 < days {
+		errs = append(errs, ErrPersonalAccessTokenExpiresInDays)
+	}
+	return errs
+}
+
+func convertPersonalAccessToken(t *domain.PersonalAccessToken) *openapi.PersonalAccessToken {
+	scopes := make([]openapi.PersonalAccessTokenScopesItem, 0, len(t.Scopes))
+	for _, s := range t.Scopes {
+		scopes = append(scopes, openapi.PersonalAccessTokenScopesItem(s))
+	}
+	return &openapi.PersonalAccessToken{
+		ID:         string(t.ID),
+		Name:       t.Name,
+		Scopes:     scopes,
+		ExpiresAt:  convertOptDateTime(t.ExpiresAt),
+		LastUsedAt: convertOptDateTime(t.LastUsedAt),
+		CreatedAt:  t.CreatedAt,
+	}
+}
@@ -0,0 +1,77 @@
+package handler_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/minguu42/harmattan/internal/api/handler"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePersonalAccessTokenName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		tokenName string
+		want      []error
+	}{
+		{name: "empty", tokenName: "", want: []error{handler.ErrPersonalAccessTokenNameLength}},
+		{name: "min_length_boundary", tokenName: "a"},
+		{name: "max_length_boundary", tokenName: strings.Repeat("a", 80)},
+		{name: "max_length_boundary_multibyte", tokenName: strings.Repeat("あ", 80)},
+		{name: "above_max_length", tokenName: strings.Repeat("a", 81), want: []error{handler.ErrPersonalAccessTokenNameLength}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.ElementsMatch(t, tt.want, handler.ValidatePersonalAccessTokenName(tt.tokenName))
+		})
+	}
+}
+
+func TestValidatePersonalAccessTokenScopes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		scopes []domain.Scope
+		want   []error
+	}{
+		{name: "empty", scopes: []domain.Scope{}, want: []error{handler.ErrPersonalAccessTokenScopesEmpty}},
+		{name: "single", scopes: []domain.Scope{domain.ScopeTasksRead}},
+		{name: "multiple", scopes: []domain.Scope{domain.ScopeTasksWrite, domain.ScopeProjectsRead}},
+		{name: "duplicate", scopes: []domain.Scope{domain.ScopeTasksRead, domain.ScopeTagsRead, domain.ScopeTasksRead}, want: []error{handler.ErrPersonalAccessTokenScopesDuplicate}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.ElementsMatch(t, tt.want, handler.ValidatePersonalAccessTokenScopes(tt.scopes))
+		})
+	}
+}
+
+func TestValidatePersonalAccessTokenExpiresInDays(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		days int
+		want []error
+	}{
+		{name: "zero", days: 0, want: []error{handler.ErrPersonalAccessTokenExpiresInDays}},
+		{name: "min_boundary", days: 1},
+		{name: "max_boundary", days: 365},
+		{name: "above_max", days: 366, want: []error{handler.ErrPersonalAccessTokenExpiresInDays}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.ElementsMatch(t, tt.want, handler.ValidatePersonalAccessTokenExpiresInDays(tt.days))
+		})
+	}
+}
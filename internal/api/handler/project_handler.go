@@ -2,7 +2,6 @@ package handler
 
 import (
 	"context"
-	"errors"
 	"unicode/utf8"
 
 	"github.com/minguu42/harmattan/internal/api/apierror"
@@ -12,9 +11,9 @@ import (
 	"github.com/minguu42/harmattan/internal/lib/errtrace"
 )
 
-func (h *Handler) CreateProject(ctx context.Context, req *openapi.CreateProjectReq) (*openapi.Project, error) {
+func (h *Handler) CreateProject(ctx context.Context, req *openapi.CreateProjectReq, _ openapi.CreateProjectParams) (*openapi.Project, error) {
 	var errs []error
-	errs = append(errs, validateProjectName(req.Name)...)
+	errs = append(errs, apierror.WithField("name", validateProjectName(req.Name))...)
 	if len(errs) > 0 {
 		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
 	}
@@ -30,33 +29,44 @@ func (h *Handler) CreateProject(ctx context.Context, req *openapi.CreateProjectR
 }
 
 func (h *Handler) ListProjects(ctx context.Context, params openapi.ListProjectsParams) (*openapi.ListProjectsOK, error) {
+	if errs := validatePagination(params.Offset.Value, params.Cursor.Value); len(errs) > 0 {
+		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
+	}
+
 	out, err := h.Project.ListProjects(ctx, &usecase.ListProjectsInput{
 		Limit:  params.Limit.Value,
 		Offset: params.Offset.Value,
+		Cursor: params.Cursor.Value,
 	})
 	if err != nil {
 		return nil, errtrace.Wrap(err)
 	}
 	return &openapi.ListProjectsOK{
-		Projects: convertProjects(out.Projects),
-		HasNext:  out.HasNext,
+		Projects:   convertProjects(out.Projects),
+		HasNext:    out.HasNext,
+		NextCursor: openapi.OptString{Value: out.NextCursor, Set: out.HasNext},
 	}, nil
 }
 
-func (h *Handler) GetProject(ctx context.Context, params openapi.GetProjectParams) (*openapi.Project, error) {
+func (h *Handler) GetProject(ctx context.Context, params openapi.GetProjectParams) (openapi.GetProjectRes, error) {
 	out, err := h.Project.GetProject(ctx, &usecase.GetProjectInput{
 		ID: domain.ProjectID(params.ProjectID),
 	})
 	if err != nil {
 		return nil, errtrace.Wrap(err)
 	}
-	return convertProject(out.Project), nil
+
+	tag := out.Project.ETag()
+	if notModified(params.IfNoneMatch, tag) {
+		return &openapi.GetProjectNotModified{ETag: tag}, nil
+	}
+	return &openapi.ProjectHeaders{ETag: tag, Response: *convertProject(out.Project)}, nil
 }
 
-func (h *Handler) UpdateProject(ctx context.Context, req *openapi.UpdateProjectReq, params openapi.UpdateProjectParams) (*openapi.Project, error) {
+func (h *Handler) UpdateProject(ctx context.Context, req *openapi.UpdateProjectReq, params openapi.UpdateProjectParams) (*openapi.ProjectHeaders, error) {
 	var errs []error
 	if name, ok := req.Name.Get(); ok {
-		errs = append(errs, validateProjectName(name)...)
+		errs = append(errs, apierror.WithField("name", validateProjectName(name))...)
 	}
 	if len(errs) > 0 {
 		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
@@ -67,6 +77,24 @@ func (h *Handler) UpdateProject(ctx context.Context, req *openapi.UpdateProjectR
 		Name:       usecase.Option[string]{V: req.Name.Value, Valid: req.Name.Set},
 		Color:      usecase.Option[domain.ProjectColor]{V: domain.ProjectColor(req.Color.Value), Valid: req.Color.Set},
 		IsArchived: usecase.Option[bool]{V: req.IsArchived.Value, Valid: req.IsArchived.Set},
+		IfMatch:    params.IfMatch.Value,
+	})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &openapi.ProjectHeaders{ETag: out.Project.ETag(), Response: *convertProject(out.Project)}, nil
+}
+
+func (h *Handler) MoveProject(ctx context.Context, req *openapi.MoveProjectReq, params openapi.MoveProjectParams) (*openapi.Project, error) {
+	if errs := validateMove(params.ProjectID, req.BeforeID.Value, req.AfterID.Value); len(errs) > 0 {
+		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
+	}
+
+	out, err := h.Project.MoveProject(ctx, &usecase.MoveProjectInput{
+		ID:       domain.ProjectID(params.ProjectID),
+		BeforeID: domain.ProjectID(req.BeforeID.Value),
+		AfterID:  domain.ProjectID(req.AfterID.Value),
+		IfMatch:  params.IfMatch.Value,
 	})
 	if err != nil {
 		return nil, errtrace.Wrap(err)
@@ -75,13 +103,16 @@ func (h *Handler) UpdateProject(ctx context.Context, req *openapi.UpdateProjectR
 }
 
 func (h *Handler) DeleteProject(ctx context.Context, params openapi.DeleteProjectParams) error {
-	if err := h.Project.DeleteProject(ctx, &usecase.DeleteProjectInput{ID: domain.ProjectID(params.ProjectID)}); err != nil {
+	if err := h.Project.DeleteProject(ctx, &usecase.DeleteProjectInput{
+		ID:      domain.ProjectID(params.ProjectID),
+		IfMatch: params.IfMatch.Value,
+	}); err != nil {
 		return errtrace.Wrap(err)
 	}
 	return nil
 }
 
-var ErrProjectNameLength = errors.New("プロジェクト名は1文字以上80文字以下で指定できます")
+var ErrProjectNameLength = apierror.NewMessageError("project_name_length")
 
 func validateProjectName(name string) []error {
 	var errs []error
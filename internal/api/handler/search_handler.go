@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/minguu42/harmattan/internal/api/apierror"
+	"github.com/minguu42/harmattan/internal/api/openapi"
+	"github.com/minguu42/harmattan/internal/api/usecase"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+func (h *Handler) ListSearchResults(ctx context.Context, params openapi.ListSearchResultsParams) (*openapi.ListSearchResultsOK, error) {
+	var errs []error
+	errs = append(errs, apierror.WithField("q", validateSearchQuery(params.Q))...)
+	if len(errs) > 0 {
+		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
+	}
+
+	out, err := h.Search.Search(ctx, &usecase.SearchInput{
+		Query:         params.Q,
+		Limit:         params.Limit.Value,
+		Offset:        params.Offset.Value,
+		ShowCompleted: params.ShowCompleted.Value,
+		ProjectID:     ternary(params.ProjectID.Set, new(domain.ProjectID(params.ProjectID.Value)), nil),
+		TagIDs:        convertSlice[domain.TagID](params.TagIDs),
+		MatchAllTags:  params.TagMatch.Value == openapi.ListSearchResultsTagMatchAll,
+	})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &openapi.ListSearchResultsOK{
+		Results: convertSearchResults(out.Results, out.Terms),
+		HasNext: out.HasNext,
+	}, nil
+}
+
+var ErrSearchQueryLength = apierror.NewMessageError("search_query_length")
+
+func validateSearchQuery(q string) []error {
+	var errs []error
+	if strings.TrimSpace(q) == "" || domain.MaxSearchQueryLength < utf8.RuneCountInString(q) {
+		errs = append(errs, ErrSearchQueryLength)
+	}
+	return errs
+}
+
+func convertSearchResult(r *domain.SearchResult, terms []string) *openapi.SearchResult {
+	return &openapi.SearchResult{
+		Type:      openapi.SearchResultType(r.Type),
+		ID:        r.ID,
+		ProjectID: openapi.OptString{Value: string(r.ProjectID), Set: r.ProjectID != ""},
+		TaskID:    openapi.OptString{Value: string(r.TaskID), Set: r.TaskID != ""},
+		Name:      r.Name,
+		Snippet:   r.Snippet(terms),
+	}
+}
+
+func convertSearchResults(results domain.SearchResults, terms []string) []openapi.SearchResult {
+	rs := make([]openapi.SearchResult, 0, len(results))
+	for _, r := range results {
+		rs = append(rs, *convertSearchResult(&r, terms))
+	}
+	return rs
+}
@@ -0,0 +1,33 @@
+package handler_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/minguu42/harmattan/internal/api/handler"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSearchQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		q    string
+		want []error
+	}{
+		{name: "empty", q: "", want: []error{handler.ErrSearchQueryLength}},
+		{name: "blank", q: " 　", want: []error{handler.ErrSearchQueryLength}},
+		{name: "min_length_boundary", q: "a"},
+		{name: "max_length_boundary", q: strings.Repeat("a", 100)},
+		{name: "max_length_boundary_multibyte", q: strings.Repeat("あ", 100)},
+		{name: "above_max_length", q: strings.Repeat("a", 101), want: []error{handler.ErrSearchQueryLength}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.ElementsMatch(t, tt.want, handler.ValidateSearchQuery(tt.q))
+		})
+	}
+}
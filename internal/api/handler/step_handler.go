@@ -2,7 +2,6 @@ package handler
 
 import (
 	"context"
-	"errors"
 	"time"
 	"unicode/utf8"
 
@@ -15,7 +14,7 @@ import (
 
 func (h *Handler) CreateStep(ctx context.Context, req *openapi.CreateStepReq, params openapi.CreateStepParams) (*openapi.Step, error) {
 	var errs []error
-	errs = append(errs, validateStepName(req.Name)...)
+	errs = append(errs, apierror.WithField("name", validateStepName(req.Name))...)
 	if len(errs) > 0 {
 		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
 	}
@@ -30,10 +29,10 @@ func (h *Handler) CreateStep(ctx context.Context, req *openapi.CreateStepReq, pa
 	return convertStep(out.Step), nil
 }
 
-func (h *Handler) UpdateStep(ctx context.Context, req *openapi.UpdateStepReq, params openapi.UpdateStepParams) (*openapi.Step, error) {
+func (h *Handler) UpdateStep(ctx context.Context, req *openapi.UpdateStepReq, params openapi.UpdateStepParams) (*openapi.StepHeaders, error) {
 	var errs []error
 	if name, ok := req.Name.Get(); ok {
-		errs = append(errs, validateStepName(name)...)
+		errs = append(errs, apierror.WithField("name", validateStepName(name))...)
 	}
 	if len(errs) > 0 {
 		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
@@ -43,6 +42,24 @@ func (h *Handler) UpdateStep(ctx context.Context, req *openapi.UpdateStepReq, pa
 		ID:          domain.StepID(params.StepID),
 		Name:        usecase.Option[string]{V: req.Name.Value, Valid: req.Name.Set},
 		CompletedAt: usecase.Option[*time.Time]{V: ternary(req.CompletedAt.Null, nil, &req.CompletedAt.Value), Valid: req.CompletedAt.Set},
+		IfMatch:     params.IfMatch.Value,
+	})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &openapi.StepHeaders{ETag: out.Step.ETag(), Response: *convertStep(out.Step)}, nil
+}
+
+func (h *Handler) MoveStep(ctx context.Context, req *openapi.MoveStepReq, params openapi.MoveStepParams) (*openapi.Step, error) {
+	if errs := validateMove(params.StepID, req.BeforeID.Value, req.AfterID.Value); len(errs) > 0 {
+		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
+	}
+
+	out, err := h.Step.MoveStep(ctx, &usecase.MoveStepInput{
+		ID:       domain.StepID(params.StepID),
+		BeforeID: domain.StepID(req.BeforeID.Value),
+		AfterID:  domain.StepID(req.AfterID.Value),
+		IfMatch:  params.IfMatch.Value,
 	})
 	if err != nil {
 		return nil, errtrace.Wrap(err)
@@ -51,13 +68,16 @@ func (h *Handler) UpdateStep(ctx context.Context, req *openapi.UpdateStepReq, pa
 }
 
 func (h *Handler) DeleteStep(ctx context.Context, params openapi.DeleteStepParams) error {
-	if err := h.Step.DeleteStep(ctx, &usecase.DeleteStepInput{ID: domain.StepID(params.StepID)}); err != nil {
+	if err := h.Step.DeleteStep(ctx, &usecase.DeleteStepInput{
+		ID:      domain.StepID(params.StepID),
+		IfMatch: params.IfMatch.Value,
+	}); err != nil {
 		return errtrace.Wrap(err)
 	}
 	return nil
 }
 
-var ErrStepNameLength = errors.New("ステップ名は1文字以上100文字以下で指定できます")
+var ErrStepNameLength = apierror.NewMessageError("step_name_length")
 
 func validateStepName(name string) []error {
 	var errs []error
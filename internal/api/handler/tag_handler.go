@@ -2,7 +2,6 @@ package handler
 
 import (
 	"context"
-	"errors"
 	"unicode/utf8"
 
 	"github.com/minguu42/harmattan/internal/api/apierror"
@@ -12,9 +11,9 @@ import (
 	"github.com/minguu42/harmattan/internal/lib/errtrace"
 )
 
-func (h *Handler) CreateTag(ctx context.Context, req *openapi.CreateTagReq) (*openapi.Tag, error) {
+func (h *Handler) CreateTag(ctx context.Context, req *openapi.CreateTagReq, _ openapi.CreateTagParams) (*openapi.Tag, error) {
 	var errs []error
-	errs = append(errs, validateTagName(req.Name)...)
+	errs = append(errs, apierror.WithField("name", validateTagName(req.Name))...)
 	if len(errs) > 0 {
 		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
 	}
@@ -27,56 +26,71 @@ func (h *Handler) CreateTag(ctx context.Context, req *openapi.CreateTagReq) (*op
 }
 
 func (h *Handler) ListTags(ctx context.Context, params openapi.ListTagsParams) (*openapi.ListTagsOK, error) {
+	if errs := validatePagination(params.Offset.Value, params.Cursor.Value); len(errs) > 0 {
+		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
+	}
+
 	out, err := h.Tag.ListTags(ctx, &usecase.ListTagsInput{
 		Limit:  params.Limit.Value,
 		Offset: params.Offset.Value,
+		Cursor: params.Cursor.Value,
 	})
 	if err != nil {
 		return nil, errtrace.Wrap(err)
 	}
 	return &openapi.ListTagsOK{
-		Tags:    convertTags(out.Tags),
-		HasNext: out.HasNext,
+		Tags:       convertTags(out.Tags),
+		HasNext:    out.HasNext,
+		NextCursor: openapi.OptString{Value: out.NextCursor, Set: out.HasNext},
 	}, nil
 }
 
-func (h *Handler) UpdateTag(ctx context.Context, req *openapi.UpdateTagReq, params openapi.UpdateTagParams) (*openapi.Tag, error) {
+func (h *Handler) UpdateTag(ctx context.Context, req *openapi.UpdateTagReq, params openapi.UpdateTagParams) (*openapi.TagHeaders, error) {
 	var errs []error
 	if name, ok := req.Name.Get(); ok {
-		errs = validateTagName(name)
+		errs = apierror.WithField("name", validateTagName(name))
 	}
 	if len(errs) > 0 {
 		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
 	}
 
 	out, err := h.Tag.UpdateTag(ctx, &usecase.UpdateTagInput{
-		ID:   domain.TagID(params.TagID),
-		Name: usecase.Option[string]{V: req.Name.Value, Valid: req.Name.Set},
+		ID:      domain.TagID(params.TagID),
+		Name:    usecase.Option[string]{V: req.Name.Value, Valid: req.Name.Set},
+		IfMatch: params.IfMatch.Value,
 	})
 	if err != nil {
 		return nil, errtrace.Wrap(err)
 	}
-	return convertTag(out.Tag), nil
+	return &openapi.TagHeaders{ETag: out.Tag.ETag(), Response: *convertTag(out.Tag)}, nil
 }
 
-func (h *Handler) GetTag(ctx context.Context, params openapi.GetTagParams) (*openapi.Tag, error) {
+func (h *Handler) GetTag(ctx context.Context, params openapi.GetTagParams) (openapi.GetTagRes, error) {
 	out, err := h.Tag.GetTag(ctx, &usecase.GetTagInput{
 		ID: domain.TagID(params.TagID),
 	})
 	if err != nil {
 		return nil, errtrace.Wrap(err)
 	}
-	return convertTag(out.Tag), nil
+
+	tag := out.Tag.ETag()
+	if notModified(params.IfNoneMatch, tag) {
+		return &openapi.GetTagNotModified{ETag: tag}, nil
+	}
+	return &openapi.TagHeaders{ETag: tag, Response: *convertTag(out.Tag)}, nil
 }
 
 func (h *Handler) DeleteTag(ctx context.Context, params openapi.DeleteTagParams) error {
-	if err := h.Tag.DeleteTag(ctx, &usecase.DeleteTagInput{ID: domain.TagID(params.TagID)}); err != nil {
+	if err := h.Tag.DeleteTag(ctx, &usecase.DeleteTagInput{
+		ID:      domain.TagID(params.TagID),
+		IfMatch: params.IfMatch.Value,
+	}); err != nil {
 		return errtrace.Wrap(err)
 	}
 	return nil
 }
 
-var ErrTagNameLength = errors.New("タグ名は1文字以上20文字以下で指定できます")
+var ErrTagNameLength = apierror.NewMessageError("tag_name_length")
 
 func validateTagName(name string) []error {
 	var errs []error
@@ -2,7 +2,6 @@ package handler
 
 import (
 	"context"
-	"errors"
 	"time"
 	"unicode/utf8"
 
@@ -16,15 +15,23 @@ import (
 
 func (h *Handler) CreateTask(ctx context.Context, req *openapi.CreateTaskReq, params openapi.CreateTaskParams) (*openapi.Task, error) {
 	var errs []error
-	errs = append(errs, validateTaskName(req.Name)...)
+	errs = append(errs, apierror.WithField("name", validateTaskName(req.Name))...)
+	var recurrence *domain.RecurrenceRule
+	if s, ok := req.Recurrence.Get(); ok {
+		rule, ruleErrs := validateTaskRecurrence(s)
+		errs = append(errs, apierror.WithField("recurrence", ruleErrs)...)
+		recurrence = &rule
+	}
 	if len(errs) > 0 {
 		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
 	}
 
 	out, err := h.Task.CreateTask(ctx, &usecase.CreateTaskInput{
-		ProjectID: domain.ProjectID(params.ProjectID),
-		Name:      req.Name,
-		Priority:  req.Priority.Value,
+		ProjectID:  domain.ProjectID(params.ProjectID),
+		AssigneeID: ternary(req.AssigneeID.Set, new(domain.UserID(req.AssigneeID.Value)), nil),
+		Name:       req.Name,
+		Priority:   req.Priority.Value,
+		Recurrence: recurrence,
 	})
 	if err != nil {
 		return nil, errtrace.Wrap(err)
@@ -33,33 +40,166 @@ func (h *Handler) CreateTask(ctx context.Context, req *openapi.CreateTaskReq, pa
 }
 
 func (h *Handler) ListTasks(ctx context.Context, params openapi.ListTasksParams) (*openapi.ListTasksOK, error) {
+	minPriority := ternary(params.MinPriority.Set, &params.MinPriority.Value, nil)
+	maxPriority := ternary(params.MaxPriority.Set, &params.MaxPriority.Value, nil)
+	dueFrom := ternary(params.DueFrom.Set, new(plain.DateOf(params.DueFrom.Value)), nil)
+	dueTo := ternary(params.DueTo.Set, new(plain.DateOf(params.DueTo.Value)), nil)
+	var errs []error
+	errs = append(errs, validatePagination(params.Offset.Value, params.Cursor.Value)...)
+	errs = append(errs, validateTaskFilter(minPriority, maxPriority, dueFrom, dueTo)...)
+	if len(errs) > 0 {
+		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
+	}
+
 	out, err := h.Task.ListTasks(ctx, &usecase.ListTasksInput{
 		ProjectID:     domain.ProjectID(params.ProjectID),
 		Limit:         params.Limit.Value,
 		Offset:        params.Offset.Value,
+		Cursor:        params.Cursor.Value,
 		ShowCompleted: params.ShowCompleted.Value,
+		MinPriority:   minPriority,
+		MaxPriority:   maxPriority,
+		TagIDs:        convertSlice[domain.TagID](params.TagIDs),
+		MatchAllTags:  params.TagMatch.Value == openapi.ListTasksTagMatchAll,
+		AssigneeID:    ternary(params.AssigneeID.Set, new(domain.UserID(params.AssigneeID.Value)), nil),
+		DueFrom:       dueFrom,
+		DueTo:         dueTo,
+		HasDueDate:    ternary(params.HasDueDate.Set, &params.HasDueDate.Value, nil),
+		SortKey:       domain.TaskSortKey(params.Sort.Value),
+		Descending:    params.Order.Value == openapi.ListTasksOrderDesc,
 	})
 	if err != nil {
 		return nil, errtrace.Wrap(err)
 	}
 	return &openapi.ListTasksOK{
-		Tasks:   convertTasks(out.Tasks, out.Tags),
-		HasNext: out.HasNext,
+		Tasks:      convertTasks(out.Tasks, out.Tags),
+		HasNext:    out.HasNext,
+		NextCursor: openapi.OptString{Value: out.NextCursor, Set: out.HasNext},
+	}, nil
+}
+
+func (h *Handler) ListAssignedTasks(ctx context.Context, params openapi.ListAssignedTasksParams) (*openapi.ListAssignedTasksOK, error) {
+	if errs := validatePagination(params.Offset.Value, params.Cursor.Value); len(errs) > 0 {
+		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
+	}
+
+	out, err := h.Task.ListAssignedTasks(ctx, &usecase.ListAssignedTasksInput{
+		Limit:  params.Limit.Value,
+		Offset: params.Offset.Value,
+		Cursor: params.Cursor.Value,
+	})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &openapi.ListAssignedTasksOK{
+		Tasks:      convertTasks(out.Tasks, out.Tags),
+		HasNext:    out.HasNext,
+		NextCursor: openapi.OptString{Value: out.NextCursor, Set: out.HasNext},
+	}, nil
+}
+
+func (h *Handler) ListTodayTasks(ctx context.Context, params openapi.ListTodayTasksParams) (*openapi.ListTodayTasksOK, error) {
+	errs := validatePagination(params.Offset.Value, params.Cursor.Value)
+	loc, err := loadTimeZone(params.TimeZone.Value)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
+	}
+
+	out, err := h.Task.ListTodayTasks(ctx, &usecase.ListTodayTasksInput{
+		Limit:    params.Limit.Value,
+		Offset:   params.Offset.Value,
+		Cursor:   params.Cursor.Value,
+		Location: loc,
+	})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &openapi.ListTodayTasksOK{
+		Tasks:      convertTasks(out.Tasks, out.Tags),
+		HasNext:    out.HasNext,
+		NextCursor: openapi.OptString{Value: out.NextCursor, Set: out.HasNext},
+	}, nil
+}
+
+func (h *Handler) ListUpcomingTasks(ctx context.Context, params openapi.ListUpcomingTasksParams) (*openapi.ListUpcomingTasksOK, error) {
+	errs := validatePagination(params.Offset.Value, params.Cursor.Value)
+	loc, err := loadTimeZone(params.TimeZone.Value)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
+	}
+
+	out, err := h.Task.ListUpcomingTasks(ctx, &usecase.ListUpcomingTasksInput{
+		Limit:    params.Limit.Value,
+		Offset:   params.Offset.Value,
+		Cursor:   params.Cursor.Value,
+		Days:     params.Days.Value,
+		Location: loc,
+	})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &openapi.ListUpcomingTasksOK{
+		Tasks:      convertTasks(out.Tasks, out.Tags),
+		HasNext:    out.HasNext,
+		NextCursor: openapi.OptString{Value: out.NextCursor, Set: out.HasNext},
+	}, nil
+}
+
+func (h *Handler) ListOverdueTasks(ctx context.Context, params openapi.ListOverdueTasksParams) (*openapi.ListOverdueTasksOK, error) {
+	errs := validatePagination(params.Offset.Value, params.Cursor.Value)
+	loc, err := loadTimeZone(params.TimeZone.Value)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
+	}
+
+	out, err := h.Task.ListOverdueTasks(ctx, &usecase.ListOverdueTasksInput{
+		Limit:    params.Limit.Value,
+		Offset:   params.Offset.Value,
+		Cursor:   params.Cursor.Value,
+		Location: loc,
+	})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &openapi.ListOverdueTasksOK{
+		Tasks:      convertTasks(out.Tasks, out.Tags),
+		HasNext:    out.HasNext,
+		NextCursor: openapi.OptString{Value: out.NextCursor, Set: out.HasNext},
 	}, nil
 }
 
-func (h *Handler) GetTask(ctx context.Context, params openapi.GetTaskParams) (*openapi.Task, error) {
+func (h *Handler) GetTask(ctx context.Context, params openapi.GetTaskParams) (openapi.GetTaskRes, error) {
 	out, err := h.Task.GetTask(ctx, &usecase.GetTaskInput{ID: domain.TaskID(params.TaskID)})
 	if err != nil {
 		return nil, errtrace.Wrap(err)
 	}
-	return convertTask(out.Task, out.Tags), nil
+
+	tag := out.Task.ETag(out.Tags)
+	if notModified(params.IfNoneMatch, tag) {
+		return &openapi.GetTaskNotModified{ETag: tag}, nil
+	}
+	return &openapi.TaskHeaders{ETag: tag, Response: *convertTask(out.Task, out.Tags)}, nil
 }
 
-func (h *Handler) UpdateTask(ctx context.Context, req *openapi.UpdateTaskReq, params openapi.UpdateTaskParams) (*openapi.Task, error) {
+func (h *Handler) UpdateTask(ctx context.Context, req *openapi.UpdateTaskReq, params openapi.UpdateTaskParams) (*openapi.TaskHeaders, error) {
 	var errs []error
 	if name, ok := req.Name.Get(); ok {
-		errs = append(errs, validateTaskName(name)...)
+		errs = append(errs, apierror.WithField("name", validateTaskName(name))...)
+	}
+	var recurrence *domain.RecurrenceRule
+	if s, ok := req.Recurrence.Get(); ok {
+		rule, ruleErrs := validateTaskRecurrence(s)
+		errs = append(errs, apierror.WithField("recurrence", ruleErrs)...)
+		recurrence = &rule
 	}
 	if len(errs) > 0 {
 		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
@@ -67,12 +207,33 @@ func (h *Handler) UpdateTask(ctx context.Context, req *openapi.UpdateTaskReq, pa
 
 	out, err := h.Task.UpdateTask(ctx, &usecase.UpdateTaskInput{
 		ID:          domain.TaskID(params.TaskID),
+		ProjectID:   usecase.Option[domain.ProjectID]{V: domain.ProjectID(req.ProjectID.Value), Valid: req.ProjectID.Set},
+		AssigneeID:  usecase.Option[*domain.UserID]{V: ternary(req.AssigneeID.Null, nil, new(domain.UserID(req.AssigneeID.Value))), Valid: req.AssigneeID.Set},
 		Name:        usecase.Option[string]{V: req.Name.Value, Valid: req.Name.Set},
 		TagIDs:      usecase.Option[[]domain.TagID]{V: convertSlice[domain.TagID](req.TagIds), Valid: req.TagIds != nil},
 		Content:     usecase.Option[string]{V: req.Content.Value, Valid: req.Content.Set},
 		Priority:    usecase.Option[int]{V: req.Priority.Value, Valid: req.Priority.Set},
 		DueOn:       usecase.Option[*plain.Date]{V: ternary(req.DueOn.Null, nil, new(plain.DateOf(req.DueOn.Value))), Valid: req.DueOn.Set},
+		Recurrence:  usecase.Option[*domain.RecurrenceRule]{V: recurrence, Valid: req.Recurrence.Set},
 		CompletedAt: usecase.Option[*time.Time]{V: ternary(req.CompletedAt.Null, nil, &req.CompletedAt.Value), Valid: req.CompletedAt.Set},
+		IfMatch:     params.IfMatch.Value,
+	})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &openapi.TaskHeaders{ETag: out.Task.ETag(out.Tags), Response: *convertTask(out.Task, out.Tags)}, nil
+}
+
+func (h *Handler) MoveTask(ctx context.Context, req *openapi.MoveTaskReq, params openapi.MoveTaskParams) (*openapi.Task, error) {
+	if errs := validateMove(params.TaskID, req.BeforeID.Value, req.AfterID.Value); len(errs) > 0 {
+		return nil, errtrace.Wrap(apierror.DomainValidationError(errs))
+	}
+
+	out, err := h.Task.MoveTask(ctx, &usecase.MoveTaskInput{
+		ID:       domain.TaskID(params.TaskID),
+		BeforeID: domain.TaskID(req.BeforeID.Value),
+		AfterID:  domain.TaskID(req.AfterID.Value),
+		IfMatch:  params.IfMatch.Value,
 	})
 	if err != nil {
 		return nil, errtrace.Wrap(err)
@@ -81,13 +242,21 @@ func (h *Handler) UpdateTask(ctx context.Context, req *openapi.UpdateTaskReq, pa
 }
 
 func (h *Handler) DeleteTask(ctx context.Context, params openapi.DeleteTaskParams) error {
-	if err := h.Task.DeleteTask(ctx, &usecase.DeleteTaskInput{ID: domain.TaskID(params.TaskID)}); err != nil {
+	if err := h.Task.DeleteTask(ctx, &usecase.DeleteTaskInput{
+		ID:      domain.TaskID(params.TaskID),
+		IfMatch: params.IfMatch.Value,
+	}); err != nil {
 		return errtrace.Wrap(err)
 	}
 	return nil
 }
 
-var ErrTaskNameLength = errors.New("タスク名は1文字以上100文字以下で指定できます")
+var (
+	ErrTaskNameLength       = apierror.NewMessageError("task_name_length")
+	ErrTaskRecurrenceFormat = apierror.NewMessageError("task_recurrence_format")
+	ErrTaskPriorityRange    = apierror.NewMessageError("task_priority_range")
+	ErrTaskDueRange         = apierror.NewMessageError("task_due_range")
+)
 
 func validateTaskName(name string) []error {
 	var errs []error
@@ -97,19 +266,45 @@ func validateTaskName(name string) []error {
 	return errs
 }
 
+// validateTaskRecurrence は繰り返しルールを検証し、正規化したルールを返す
+func validateTaskRecurrence(rule string) (domain.RecurrenceRule, []error) {
+	var errs []error
+	normalized, err := domain.ParseRecurrenceRule(rule)
+	if err != nil {
+		errs = append(errs, ErrTaskRecurrenceFormat)
+	}
+	return normalized, errs
+}
+
+// validateTaskFilter はタスク一覧の絞り込み条件の範囲を検証する
+func validateTaskFilter(minPriority, maxPriority *int, dueFrom, dueTo *plain.Date) []error {
+	var errs []error
+	if minPriority != nil && maxPriority != nil && *maxPriority < *minPriority {
+		errs = append(errs, ErrTaskPriorityRange)
+	}
+	if dueFrom != nil && dueTo != nil && dueTo.Before(*dueFrom) {
+		errs = append(errs, ErrTaskDueRange)
+	}
+	return errs
+}
+
 func convertTask(task *domain.Task, tags domain.Tags) *openapi.Task {
 	return &openapi.Task{
-		ID:          string(task.ID),
-		ProjectID:   string(task.ProjectID),
-		Name:        task.Name,
-		Content:     task.Content,
-		Priority:    task.Priority,
-		DueOn:       convertOptDate(task.DueOn),
-		CompletedAt: convertOptDateTime(task.CompletedAt),
-		CreatedAt:   task.CreatedAt,
-		UpdatedAt:   task.UpdatedAt,
-		Steps:       convertSteps(task.Steps),
-		Tags:        convertTags(tags),
+		ID:               string(task.ID),
+		ProjectID:        string(task.ProjectID),
+		AssigneeID:       convertOptString(task.AssigneeID),
+		Name:             task.Name,
+		Content:          task.Content,
+		Priority:         task.Priority,
+		DueOn:            convertOptDate(task.DueOn),
+		Recurrence:       convertOptString(task.Recurrence),
+		NextOccurrenceID: convertOptString(task.NextOccurrenceID),
+		CompletedAt:      convertOptDateTime(task.CompletedAt),
+		CreatedAt:        task.CreatedAt,
+		UpdatedAt:        task.UpdatedAt,
+		Steps:            convertSteps(task.Steps),
+		Tags:             convertTags(tags),
+		CommentCount:     task.CommentCount,
 	}
 }
 
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/minguu42/harmattan/internal/api/handler"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/plain"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -30,3 +32,61 @@ func TestValidateTaskName(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateTaskRecurrence(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		rule       string
+		wantRule   domain.RecurrenceRule
+		wantErrors []error
+	}{
+		{name: "daily", rule: "FREQ=DAILY", wantRule: "FREQ=DAILY"},
+		{name: "normalize", rule: "freq=weekly;byday=th,mo;interval=1", wantRule: "FREQ=WEEKLY;BYDAY=MO,TH"},
+		{name: "empty", rule: "", wantErrors: []error{handler.ErrTaskRecurrenceFormat}},
+		{name: "unsupported_frequency", rule: "FREQ=YEARLY", wantErrors: []error{handler.ErrTaskRecurrenceFormat}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rule, errs := handler.ValidateTaskRecurrence(tt.rule)
+			assert.Equal(t, tt.wantRule, rule)
+			assert.ElementsMatch(t, tt.wantErrors, errs)
+		})
+	}
+}
+
+func TestValidateTaskFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		minPriority *int
+		maxPriority *int
+		dueFrom     *plain.Date
+		dueTo       *plain.Date
+		want        []error
+	}{
+		{name: "no_filter"},
+		{name: "same_priority", minPriority: new(2), maxPriority: new(2)},
+		{name: "only_min_priority", minPriority: new(3)},
+		{name: "reversed_priority", minPriority: new(2), maxPriority: new(1), want: []error{handler.ErrTaskPriorityRange}},
+		{name: "same_due_on", dueFrom: new(plain.NewDate(2025, 1, 1)), dueTo: new(plain.NewDate(2025, 1, 1))},
+		{name: "reversed_due_on", dueFrom: new(plain.NewDate(2025, 1, 2)), dueTo: new(plain.NewDate(2025, 1, 1)), want: []error{handler.ErrTaskDueRange}},
+		{
+			name:        "multiple_errors",
+			minPriority: new(3), maxPriority: new(0),
+			dueFrom: new(plain.NewDate(2025, 2, 1)), dueTo: new(plain.NewDate(2025, 1, 1)),
+			want: []error{handler.ErrTaskPriorityRange, handler.ErrTaskDueRange},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.ElementsMatch(t, tt.want, handler.ValidateTaskFilter(tt.minPriority, tt.maxPriority, tt.dueFrom, tt.dueTo))
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/minguu42/harmattan/internal/api/openapi"
+	"github.com/minguu42/harmattan/internal/api/usecase"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+func (h *Handler) ListTrash(ctx context.Context, params openapi.ListTrashParams) (*openapi.ListTrashOK, error) {
+	out, err := h.Trash.ListTrash(ctx, &usecase.ListTrashInput{
+		Limit:  params.Limit.Value,
+		Offset: params.Offset.Value,
+	})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &openapi.ListTrashOK{
+		Items:   convertTrashItems(out.Items),
+		HasNext: out.HasNext,
+	}, nil
+}
+
+func (h *Handler) RestoreTrashItem(ctx context.Context, params openapi.RestoreTrashItemParams) (*openapi.TrashItem, error) {
+	out, err := h.Trash.RestoreTrashItem(ctx, &usecase.RestoreTrashItemInput{ID: params.ItemID})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return convertTrashItem(out.Item), nil
+}
+
+func convertTrashItem(i *domain.TrashItem) *openapi.TrashItem {
+	return &openapi.TrashItem{
+		ID:        i.ID,
+		Type:      openapi.TrashItemType(i.Type),
+		ParentID:  openapi.OptString{Value: i.ParentID, Set: i.ParentID != ""},
+		Name:      i.Name,
+		DeletedAt: i.DeletedAt,
+	}
+}
+
+func convertTrashItems(items domain.TrashItems) []openapi.TrashItem {
+	is := make([]openapi.TrashItem, 0, len(items))
+	for _, i := range items {
+		is = append(is, *convertTrashItem(&i))
+	}
+	return is
+}
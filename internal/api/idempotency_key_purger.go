@@ -0,0 +1,28 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/api/usecase"
+	"github.com/minguu42/harmattan/internal/atel"
+)
+
+// PurgeIdempotencyKeysPeriodically は interval ごとに有効期限を過ぎたIdempotency-Keyのキーを削除する
+// ctx がキャンセルされるまで処理を続ける
+func PurgeIdempotencyKeysPeriodically(ctx context.Context, f *Factory, interval time.Duration) {
+	uc := usecase.Idempotency{DB: f.DB, Expiration: f.IdempotencyKeyExpiration}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := uc.PurgeIdempotencyKeys(ctx); err != nil {
+				atel.ErrorLog(ctx, "Failed to purge idempotency keys", err)
+			}
+		}
+	}
+}
@@ -2,16 +2,25 @@ package api
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"reflect"
 	"runtime"
 	"time"
 
 	"github.com/minguu42/harmattan/internal/api/apierror"
+	"github.com/minguu42/harmattan/internal/api/openapi"
+	"github.com/minguu42/harmattan/internal/api/usecase"
 	"github.com/minguu42/harmattan/internal/atel"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/clientip"
 	"github.com/minguu42/harmattan/internal/lib/clock"
 	"github.com/minguu42/harmattan/internal/lib/errtrace"
+	"github.com/minguu42/harmattan/internal/ratelimit"
 	"github.com/ogen-go/ogen/middleware"
 )
 
@@ -26,6 +35,33 @@ func setRequestStart(next http.Handler) http.Handler {
 	})
 }
 
+// setClientIP はリクエスト元のIPアドレスをコンテキストに付与する
+func setClientIP(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(clientip.WithIP(r.Context(), clientip.FromRequest(r))))
+	})
+}
+
+// limitByIP は認証でデータベースを使う前に、IPアドレスごとにすべてのオペレーションを合わせたリクエスト数を制限する
+func limitByIP(l *ratelimit.Limiter, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/health" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := r.Context()
+		res, err := l.Allow(ctx, "*", "ip:"+clientip.FromContext(ctx))
+		if err != nil {
+			atel.ErrorLog(ctx, "Failed to check rate limit", err)
+		} else if !res.Allowed {
+			errorHandler(ctx, w, r, apierror.TooManyRequestsError(res.Limit, res.RetryAfter))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
 // attachTraceID は認証不要のエンドポイント用にトレースIDをロガーに付与する
 // 認証が必要なエンドポイントではセキュリティハンドラで先に付与しているが、重複しても影響はない
 func attachTraceID() middleware.Middleware {
@@ -103,3 +139,82 @@ func recovery() middleware.Middleware {
 		return
 	}
 }
+
+// rateLimit は認証済みのリクエストはユーザごと、それ以外はIPアドレスごとにリクエスト数を制限する
+func rateLimit(l *ratelimit.Limiter) middleware.Middleware {
+	return func(req middleware.Request, next middleware.Next) (middleware.Response, error) {
+		if req.OperationID == "CheckHealth" {
+			return next(req)
+		}
+
+		subject := "ip:" + clientip.FromContext(req.Context)
+		if user, err := domain.UserFromContext(req.Context); err == nil {
+			subject = "user:" + string(user.ID)
+		}
+		r, err := l.Allow(req.Context, req.OperationID, subject)
+		if err != nil {
+			atel.ErrorLog(req.Context, "Failed to check rate limit", err)
+			return next(req)
+		}
+		if !r.Allowed {
+			return middleware.Response{}, errtrace.Wrap(apierror.TooManyRequestsError(r.Limit, r.RetryAfter))
+		}
+		return next(req)
+	}
+}
+
+// idempotency は同じ Idempotency-Key ヘッダで再送されたリクエストに最初のレスポンスを返す
+func idempotency(uc *usecase.Idempotency) middleware.Middleware {
+	handlerType := reflect.TypeFor[openapi.Handler]()
+	return func(req middleware.Request, next middleware.Next) (middleware.Response, error) {
+		param, ok := req.Params.Header("Idempotency-Key")
+		if !ok {
+			return next(req)
+		}
+		key, ok := param.(openapi.OptString)
+		if !ok || !key.Set {
+			return next(req)
+		}
+		method, ok := handlerType.MethodByName(req.OperationID)
+		if !ok || method.Type.NumOut() != 2 {
+			return next(req)
+		}
+		responseType := method.Type.Out(0)
+
+		h := sha256.New()
+		_, _ = fmt.Fprintf(h, "%s %s\n", req.Raw.Method, req.Raw.URL.RequestURI())
+		_, _ = h.Write(req.RawBody)
+		out, err := uc.ReserveIdempotencyKey(req.Context, &usecase.ReserveIdempotencyKeyInput{
+			Key:         key.Value,
+			RequestHash: hex.EncodeToString(h.Sum(nil)),
+		})
+		if err != nil {
+			return middleware.Response{}, errtrace.Wrap(err)
+		}
+		if out.Response != nil {
+			v := reflect.New(responseType.Elem())
+			if err := json.Unmarshal(out.Response, v.Interface()); err != nil {
+				return middleware.Response{}, errtrace.Wrap(err)
+			}
+			return middleware.Response{Type: v.Interface()}, nil
+		}
+
+		resp, err := next(req)
+		if err != nil {
+			if err := uc.ReleaseIdempotencyKey(req.Context, key.Value); err != nil {
+				atel.ErrorLog(req.Context, "Failed to release idempotency key", err)
+			}
+			return resp, err
+		}
+		// リクエストの処理は完了しているため、レスポンスボディを保存できなくても成功として返す
+		bs, err := json.Marshal(resp.Type)
+		if err != nil {
+			atel.ErrorLog(req.Context, "Failed to encode response for idempotency key", errtrace.Wrap(err))
+			return resp, nil
+		}
+		if err := uc.CompleteIdempotencyKey(req.Context, key.Value, bs); err != nil {
+			atel.ErrorLog(req.Context, "Failed to complete idempotency key", err)
+		}
+		return resp, nil
+	}
+}
@@ -0,0 +1,14 @@
+// Code generated by ogen, DO NOT EDIT.
+package openapi
+
+type GetProjectRes interface {
+	getProjectRes()
+}
+
+type GetTagRes interface {
+	getTagRes()
+}
+
+type GetTaskRes interface {
+	getTaskRes()
+}
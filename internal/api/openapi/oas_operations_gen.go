@@ -6,25 +6,67 @@ package openapi
 type OperationName = string
 
 const (
-	CheckHealthOperation   OperationName = "CheckHealth"
-	CreateProjectOperation OperationName = "CreateProject"
-	CreateStepOperation    OperationName = "CreateStep"
-	CreateTagOperation     OperationName = "CreateTag"
-	CreateTaskOperation    OperationName = "CreateTask"
-	DeleteProjectOperation OperationName = "DeleteProject"
-	DeleteStepOperation    OperationName = "DeleteStep"
-	DeleteTagOperation     OperationName = "DeleteTag"
-	DeleteTaskOperation    OperationName = "DeleteTask"
-	GetProjectOperation    OperationName = "GetProject"
-	GetTagOperation        OperationName = "GetTag"
-	GetTaskOperation       OperationName = "GetTask"
-	ListProjectsOperation  OperationName = "ListProjects"
-	ListTagsOperation      OperationName = "ListTags"
-	ListTasksOperation     OperationName = "ListTasks"
-	SignInOperation        OperationName = "SignIn"
-	SignUpOperation        OperationName = "SignUp"
-	UpdateProjectOperation OperationName = "UpdateProject"
-	UpdateStepOperation    OperationName = "UpdateStep"
-	UpdateTagOperation     OperationName = "UpdateTag"
-	UpdateTaskOperation    OperationName = "UpdateTask"
+	AcceptInvitationOperation          OperationName = "AcceptInvitation"
+	ChangePasswordOperation            OperationName = "ChangePassword"
+	CheckHealthOperation               OperationName = "CheckHealth"
+	ConfirmPasswordResetOperation      OperationName = "ConfirmPasswordReset"
+	ConfirmTOTPOperation               OperationName = "ConfirmTOTP"
+	CreateCommentOperation             OperationName = "CreateComment"
+	CreatePersonalAccessTokenOperation OperationName = "CreatePersonalAccessToken"
+	CreateProjectOperation             OperationName = "CreateProject"
+	CreateStepOperation                OperationName = "CreateStep"
+	CreateTagOperation                 OperationName = "CreateTag"
+	CreateTaskOperation                OperationName = "CreateTask"
+	DeleteAccountOperation             OperationName = "DeleteAccount"
+	DeleteCommentOperation             OperationName = "DeleteComment"
+	DeleteInvitationOperation          OperationName = "DeleteInvitation"
+	DeletePersonalAccessTokenOperation OperationName = "DeletePersonalAccessToken"
+	DeleteProjectOperation             OperationName = "DeleteProject"
+	DeleteProjectMemberOperation       OperationName = "DeleteProjectMember"
+	DeleteStepOperation                OperationName = "DeleteStep"
+	DeleteTagOperation                 OperationName = "DeleteTag"
+	DeleteTaskOperation                OperationName = "DeleteTask"
+	EnrollTOTPOperation                OperationName = "EnrollTOTP"
+	GetJWKSOperation                   OperationName = "GetJWKS"
+	GetMeOperation                     OperationName = "GetMe"
+	GetProjectOperation                OperationName = "GetProject"
+	GetTagOperation                    OperationName = "GetTag"
+	GetTaskOperation                   OperationName = "GetTask"
+	InviteProjectMemberOperation       OperationName = "InviteProjectMember"
+	ListAssignedTasksOperation         OperationName = "ListAssignedTasks"
+	ListCommentsOperation              OperationName = "ListComments"
+	ListInvitationsOperation           OperationName = "ListInvitations"
+	ListOverdueTasksOperation          OperationName = "ListOverdueTasks"
+	ListPersonalAccessTokensOperation  OperationName = "ListPersonalAccessTokens"
+	ListProjectHistoryOperation        OperationName = "ListProjectHistory"
+	ListProjectMembersOperation        OperationName = "ListProjectMembers"
+	ListProjectsOperation              OperationName = "ListProjects"
+	ListSearchResultsOperation         OperationName = "ListSearchResults"
+	ListTagsOperation                  OperationName = "ListTags"
+	ListTaskHistoryOperation           OperationName = "ListTaskHistory"
+	ListTasksOperation                 OperationName = "ListTasks"
+	ListTodayTasksOperation            OperationName = "ListTodayTasks"
+	ListTrashOperation                 OperationName = "ListTrash"
+	ListUpcomingTasksOperation         OperationName = "ListUpcomingTasks"
+	MoveProjectOperation               OperationName = "MoveProject"
+	MoveStepOperation                  OperationName = "MoveStep"
+	MoveTaskOperation                  OperationName = "MoveTask"
+	RefreshTokenOperation              OperationName = "RefreshToken"
+	RequestPasswordResetOperation      OperationName = "RequestPasswordReset"
+	RestoreTrashItemOperation          OperationName = "RestoreTrashItem"
+	SignInOperation                    OperationName = "SignIn"
+	SignInWithOIDCOperation            OperationName = "SignInWithOIDC"
+	SignInWithTwoFactorOperation       OperationName = "SignInWithTwoFactor"
+	SignOutOperation                   OperationName = "SignOut"
+	SignUpOperation                    OperationName = "SignUp"
+	StartOIDCSignInOperation           OperationName = "StartOIDCSignIn"
+	UpdateCommentOperation             OperationName = "UpdateComment"
+	UpdateEmailOperation               OperationName = "UpdateEmail"
+	UpdateLanguageOperation            OperationName = "UpdateLanguage"
+	UpdateProjectOperation             OperationName = "UpdateProject"
+	UpdateProjectMemberOperation       OperationName = "UpdateProjectMember"
+	UpdateStepOperation                OperationName = "UpdateStep"
+	UpdateTagOperation                 OperationName = "UpdateTag"
+	UpdateTaskOperation                OperationName = "UpdateTask"
+	VerifyEmailOperation               OperationName = "VerifyEmail"
 )
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
 )
 
 type BearerAuth struct {
@@ -33,6 +34,56 @@ func (s *BearerAuth) SetRoles(val []string) {
 	s.Roles = val
 }
 
+type ChangePasswordOK struct {
+	IDToken      string `json:"id_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// GetIDToken returns the value of IDToken.
+func (s *ChangePasswordOK) GetIDToken() string {
+	return s.IDToken
+}
+
+// GetRefreshToken returns the value of RefreshToken.
+func (s *ChangePasswordOK) GetRefreshToken() string {
+	return s.RefreshToken
+}
+
+// SetIDToken sets the value of IDToken.
+func (s *ChangePasswordOK) SetIDToken(val string) {
+	s.IDToken = val
+}
+
+// SetRefreshToken sets the value of RefreshToken.
+func (s *ChangePasswordOK) SetRefreshToken(val string) {
+	s.RefreshToken = val
+}
+
+type ChangePasswordReq struct {
+	CurrentPassword OptString `json:"current_password"`
+	NewPassword     string    `json:"new_password"`
+}
+
+// GetCurrentPassword returns the value of CurrentPassword.
+func (s *ChangePasswordReq) GetCurrentPassword() OptString {
+	return s.CurrentPassword
+}
+
+// GetNewPassword returns the value of NewPassword.
+func (s *ChangePasswordReq) GetNewPassword() string {
+	return s.NewPassword
+}
+
+// SetCurrentPassword sets the value of CurrentPassword.
+func (s *ChangePasswordReq) SetCurrentPassword(val OptString) {
+	s.CurrentPassword = val
+}
+
+// SetNewPassword sets the value of NewPassword.
+func (s *ChangePasswordReq) SetNewPassword(val string) {
+	s.NewPassword = val
+}
+
 type CheckHealthOK struct {
 	Revision string `json:"revision"`
 }
@@ -47,6 +98,265 @@ func (s *CheckHealthOK) SetRevision(val string) {
 	s.Revision = val
 }
 
+// Ref: #/components/schemas/comment
+type Comment struct {
+	ID        string    `json:"id"`
+	TaskID    string    `json:"task_id"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Comment) GetID() string {
+	return s.ID
+}
+
+// GetTaskID returns the value of TaskID.
+func (s *Comment) GetTaskID() string {
+	return s.TaskID
+}
+
+// GetContent returns the value of Content.
+func (s *Comment) GetContent() string {
+	return s.Content
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Comment) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Comment) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Comment) SetID(val string) {
+	s.ID = val
+}
+
+// SetTaskID sets the value of TaskID.
+func (s *Comment) SetTaskID(val string) {
+	s.TaskID = val
+}
+
+// SetContent sets the value of Content.
+func (s *Comment) SetContent(val string) {
+	s.Content = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Comment) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Comment) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+// ConfirmPasswordResetOK is response for ConfirmPasswordReset operation.
+type ConfirmPasswordResetOK struct{}
+
+type ConfirmPasswordResetReq struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+// GetToken returns the value of Token.
+func (s *ConfirmPasswordResetReq) GetToken() string {
+	return s.Token
+}
+
+// GetPassword returns the value of Password.
+func (s *ConfirmPasswordResetReq) GetPassword() string {
+	return s.Password
+}
+
+// SetToken sets the value of Token.
+func (s *ConfirmPasswordResetReq) SetToken(val string) {
+	s.Token = val
+}
+
+// SetPassword sets the value of Password.
+func (s *ConfirmPasswordResetReq) SetPassword(val string) {
+	s.Password = val
+}
+
+type ConfirmTOTPOK struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// GetRecoveryCodes returns the value of RecoveryCodes.
+func (s *ConfirmTOTPOK) GetRecoveryCodes() []string {
+	return s.RecoveryCodes
+}
+
+// SetRecoveryCodes sets the value of RecoveryCodes.
+func (s *ConfirmTOTPOK) SetRecoveryCodes(val []string) {
+	s.RecoveryCodes = val
+}
+
+type ConfirmTOTPReq struct {
+	Code string `json:"code"`
+}
+
+// GetCode returns the value of Code.
+func (s *ConfirmTOTPReq) GetCode() string {
+	return s.Code
+}
+
+// SetCode sets the value of Code.
+func (s *ConfirmTOTPReq) SetCode(val string) {
+	s.Code = val
+}
+
+type CreateCommentReq struct {
+	Content string `json:"content" log:"allow"`
+}
+
+// GetContent returns the value of Content.
+func (s *CreateCommentReq) GetContent() string {
+	return s.Content
+}
+
+// SetContent sets the value of Content.
+func (s *CreateCommentReq) SetContent(val string) {
+	s.Content = val
+}
+
+type CreatePersonalAccessTokenOK struct {
+	Token               string              `json:"token"`
+	PersonalAccessToken PersonalAccessToken `json:"personal_access_token"`
+}
+
+// GetToken returns the value of Token.
+func (s *CreatePersonalAccessTokenOK) GetToken() string {
+	return s.Token
+}
+
+// GetPersonalAccessToken returns the value of PersonalAccessToken.
+func (s *CreatePersonalAccessTokenOK) GetPersonalAccessToken() PersonalAccessToken {
+	return s.PersonalAccessToken
+}
+
+// SetToken sets the value of Token.
+func (s *CreatePersonalAccessTokenOK) SetToken(val string) {
+	s.Token = val
+}
+
+// SetPersonalAccessToken sets the value of PersonalAccessToken.
+func (s *CreatePersonalAccessTokenOK) SetPersonalAccessToken(val PersonalAccessToken) {
+	s.PersonalAccessToken = val
+}
+
+type CreatePersonalAccessTokenReq struct {
+	Name          string                                   `json:"name" log:"allow"`
+	Scopes        []CreatePersonalAccessTokenReqScopesItem `json:"scopes" log:"allow"`
+	ExpiresInDays OptInt                                   `json:"expires_in_days" log:"allow"`
+}
+
+// GetName returns the value of Name.
+func (s *CreatePersonalAccessTokenReq) GetName() string {
+	return s.Name
+}
+
+// GetScopes returns the value of Scopes.
+func (s *CreatePersonalAccessTokenReq) GetScopes() []CreatePersonalAccessTokenReqScopesItem {
+	return s.Scopes
+}
+
+// GetExpiresInDays returns the value of ExpiresInDays.
+func (s *CreatePersonalAccessTokenReq) GetExpiresInDays() OptInt {
+	return s.ExpiresInDays
+}
+
+// SetName sets the value of Name.
+func (s *CreatePersonalAccessTokenReq) SetName(val string) {
+	s.Name = val
+}
+
+// SetScopes sets the value of Scopes.
+func (s *CreatePersonalAccessTokenReq) SetScopes(val []CreatePersonalAccessTokenReqScopesItem) {
+	s.Scopes = val
+}
+
+// SetExpiresInDays sets the value of ExpiresInDays.
+func (s *CreatePersonalAccessTokenReq) SetExpiresInDays(val OptInt) {
+	s.ExpiresInDays = val
+}
+
+type CreatePersonalAccessTokenReqScopesItem string
+
+const (
+	CreatePersonalAccessTokenReqScopesItemProjectsRead  CreatePersonalAccessTokenReqScopesItem = "projects:read"
+	CreatePersonalAccessTokenReqScopesItemProjectsWrite CreatePersonalAccessTokenReqScopesItem = "projects:write"
+	CreatePersonalAccessTokenReqScopesItemTasksRead     CreatePersonalAccessTokenReqScopesItem = "tasks:read"
+	CreatePersonalAccessTokenReqScopesItemTasksWrite    CreatePersonalAccessTokenReqScopesItem = "tasks:write"
+	CreatePersonalAccessTokenReqScopesItemTagsRead      CreatePersonalAccessTokenReqScopesItem = "tags:read"
+	CreatePersonalAccessTokenReqScopesItemTagsWrite     CreatePersonalAccessTokenReqScopesItem = "tags:write"
+)
+
+// AllValues returns all CreatePersonalAccessTokenReqScopesItem values.
+func (CreatePersonalAccessTokenReqScopesItem) AllValues() []CreatePersonalAccessTokenReqScopesItem {
+	return []CreatePersonalAccessTokenReqScopesItem{
+		CreatePersonalAccessTokenReqScopesItemProjectsRead,
+		CreatePersonalAccessTokenReqScopesItemProjectsWrite,
+		CreatePersonalAccessTokenReqScopesItemTasksRead,
+		CreatePersonalAccessTokenReqScopesItemTasksWrite,
+		CreatePersonalAccessTokenReqScopesItemTagsRead,
+		CreatePersonalAccessTokenReqScopesItemTagsWrite,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CreatePersonalAccessTokenReqScopesItem) MarshalText() ([]byte, error) {
+	switch s {
+	case CreatePersonalAccessTokenReqScopesItemProjectsRead:
+		return []byte(s), nil
+	case CreatePersonalAccessTokenReqScopesItemProjectsWrite:
+		return []byte(s), nil
+	case CreatePersonalAccessTokenReqScopesItemTasksRead:
+		return []byte(s), nil
+	case CreatePersonalAccessTokenReqScopesItemTasksWrite:
+		return []byte(s), nil
+	case CreatePersonalAccessTokenReqScopesItemTagsRead:
+		return []byte(s), nil
+	case CreatePersonalAccessTokenReqScopesItemTagsWrite:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CreatePersonalAccessTokenReqScopesItem) UnmarshalText(data []byte) error {
+	switch CreatePersonalAccessTokenReqScopesItem(data) {
+	case CreatePersonalAccessTokenReqScopesItemProjectsRead:
+		*s = CreatePersonalAccessTokenReqScopesItemProjectsRead
+		return nil
+	case CreatePersonalAccessTokenReqScopesItemProjectsWrite:
+		*s = CreatePersonalAccessTokenReqScopesItemProjectsWrite
+		return nil
+	case CreatePersonalAccessTokenReqScopesItemTasksRead:
+		*s = CreatePersonalAccessTokenReqScopesItemTasksRead
+		return nil
+	case CreatePersonalAccessTokenReqScopesItemTasksWrite:
+		*s = CreatePersonalAccessTokenReqScopesItemTasksWrite
+		return nil
+	case CreatePersonalAccessTokenReqScopesItemTagsRead:
+		*s = CreatePersonalAccessTokenReqScopesItemTagsRead
+		return nil
+	case CreatePersonalAccessTokenReqScopesItemTagsWrite:
+		*s = CreatePersonalAccessTokenReqScopesItemTagsWrite
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 type CreateProjectReq struct {
 	Name  string                   `json:"name" log:"allow"`
 	Color OptCreateProjectReqColor `json:"color" log:"allow"`
@@ -198,8 +508,15 @@ func (s *CreateTagReq) SetName(val string) {
 }
 
 type CreateTaskReq struct {
-	Name     string `json:"name" log:"allow"`
-	Priority OptInt `json:"priority" log:"allow"`
+	AssigneeID OptString `json:"assignee_id" log:"allow"`
+	Name       string    `json:"name" log:"allow"`
+	Priority   OptInt    `json:"priority" log:"allow"`
+	Recurrence OptString `json:"recurrence" log:"allow"`
+}
+
+// GetAssigneeID returns the value of AssigneeID.
+func (s *CreateTaskReq) GetAssigneeID() OptString {
+	return s.AssigneeID
 }
 
 // GetName returns the value of Name.
@@ -212,6 +529,16 @@ func (s *CreateTaskReq) GetPriority() OptInt {
 	return s.Priority
 }
 
+// GetRecurrence returns the value of Recurrence.
+func (s *CreateTaskReq) GetRecurrence() OptString {
+	return s.Recurrence
+}
+
+// SetAssigneeID sets the value of AssigneeID.
+func (s *CreateTaskReq) SetAssigneeID(val OptString) {
+	s.AssigneeID = val
+}
+
 // SetName sets the value of Name.
 func (s *CreateTaskReq) SetName(val string) {
 	s.Name = val
@@ -222,6 +549,40 @@ func (s *CreateTaskReq) SetPriority(val OptInt) {
 	s.Priority = val
 }
 
+// SetRecurrence sets the value of Recurrence.
+func (s *CreateTaskReq) SetRecurrence(val OptString) {
+	s.Recurrence = val
+}
+
+// DeleteAccountOK is response for DeleteAccount operation.
+type DeleteAccountOK struct{}
+
+type DeleteAccountReq struct {
+	Password OptString `json:"password"`
+}
+
+// GetPassword returns the value of Password.
+func (s *DeleteAccountReq) GetPassword() OptString {
+	return s.Password
+}
+
+// SetPassword sets the value of Password.
+func (s *DeleteAccountReq) SetPassword(val OptString) {
+	s.Password = val
+}
+
+// DeleteCommentOK is response for DeleteComment operation.
+type DeleteCommentOK struct{}
+
+// DeleteInvitationOK is response for DeleteInvitation operation.
+type DeleteInvitationOK struct{}
+
+// DeletePersonalAccessTokenOK is response for DeletePersonalAccessToken operation.
+type DeletePersonalAccessTokenOK struct{}
+
+// DeleteProjectMemberOK is response for DeleteProjectMember operation.
+type DeleteProjectMemberOK struct{}
+
 // DeleteProjectOK is response for DeleteProject operation.
 type DeleteProjectOK struct{}
 
@@ -234,662 +595,2714 @@ type DeleteTagOK struct{}
 // DeleteTaskOK is response for DeleteTask operation.
 type DeleteTaskOK struct{}
 
-type ListProjectsOK struct {
-	Projects []Project `json:"projects"`
-	HasNext  bool      `json:"has_next"`
+type EnrollTOTPOK struct {
+	Secret     string `json:"secret"`
+	OtpauthURI string `json:"otpauth_uri"`
 }
 
-// GetProjects returns the value of Projects.
-func (s *ListProjectsOK) GetProjects() []Project {
-	return s.Projects
+// GetSecret returns the value of Secret.
+func (s *EnrollTOTPOK) GetSecret() string {
+	return s.Secret
 }
 
-// GetHasNext returns the value of HasNext.
-func (s *ListProjectsOK) GetHasNext() bool {
-	return s.HasNext
+// GetOtpauthURI returns the value of OtpauthURI.
+func (s *EnrollTOTPOK) GetOtpauthURI() string {
+	return s.OtpauthURI
 }
 
-// SetProjects sets the value of Projects.
-func (s *ListProjectsOK) SetProjects(val []Project) {
-	s.Projects = val
+// SetSecret sets the value of Secret.
+func (s *EnrollTOTPOK) SetSecret(val string) {
+	s.Secret = val
 }
 
-// SetHasNext sets the value of HasNext.
-func (s *ListProjectsOK) SetHasNext(val bool) {
-	s.HasNext = val
+// SetOtpauthURI sets the value of OtpauthURI.
+func (s *EnrollTOTPOK) SetOtpauthURI(val string) {
+	s.OtpauthURI = val
 }
 
-type ListTagsOK struct {
-	Tags    []Tag `json:"tags"`
-	HasNext bool  `json:"has_next"`
+// フィールドの変更前と変更後の値であり、作成時の変更前の値と削除時の変更後の値はnullになる.
+// Ref: #/components/schemas/fieldChange
+type FieldChange struct {
+	Field  string `json:"field"`
+	Before jx.Raw `json:"before"`
+	After  jx.Raw `json:"after"`
 }
 
-// GetTags returns the value of Tags.
-func (s *ListTagsOK) GetTags() []Tag {
-	return s.Tags
+// GetField returns the value of Field.
+func (s *FieldChange) GetField() string {
+	return s.Field
 }
 
-// GetHasNext returns the value of HasNext.
-func (s *ListTagsOK) GetHasNext() bool {
-	return s.HasNext
+// GetBefore returns the value of Before.
+func (s *FieldChange) GetBefore() jx.Raw {
+	return s.Before
 }
 
-// SetTags sets the value of Tags.
-func (s *ListTagsOK) SetTags(val []Tag) {
-	s.Tags = val
+// GetAfter returns the value of After.
+func (s *FieldChange) GetAfter() jx.Raw {
+	return s.After
 }
 
-// SetHasNext sets the value of HasNext.
-func (s *ListTagsOK) SetHasNext(val bool) {
-	s.HasNext = val
+// SetField sets the value of Field.
+func (s *FieldChange) SetField(val string) {
+	s.Field = val
 }
 
-type ListTasksOK struct {
-	Tasks   []Task `json:"tasks"`
-	HasNext bool   `json:"has_next"`
+// SetBefore sets the value of Before.
+func (s *FieldChange) SetBefore(val jx.Raw) {
+	s.Before = val
 }
 
-// GetTasks returns the value of Tasks.
-func (s *ListTasksOK) GetTasks() []Task {
-	return s.Tasks
+// SetAfter sets the value of After.
+func (s *FieldChange) SetAfter(val jx.Raw) {
+	s.After = val
 }
 
-// GetHasNext returns the value of HasNext.
-func (s *ListTasksOK) GetHasNext() bool {
-	return s.HasNext
+type GetJWKSOK struct {
+	Keys []Jwk `json:"keys"`
 }
 
-// SetTasks sets the value of Tasks.
-func (s *ListTasksOK) SetTasks(val []Task) {
-	s.Tasks = val
+// GetKeys returns the value of Keys.
+func (s *GetJWKSOK) GetKeys() []Jwk {
+	return s.Keys
 }
 
-// SetHasNext sets the value of HasNext.
-func (s *ListTasksOK) SetHasNext(val bool) {
-	s.HasNext = val
+// SetKeys sets the value of Keys.
+func (s *GetJWKSOK) SetKeys(val []Jwk) {
+	s.Keys = val
 }
 
-// NewOptBool returns new OptBool with value set to v.
-func NewOptBool(v bool) OptBool {
-	return OptBool{
-		Value: v,
-		Set:   true,
-	}
+// GetProjectNotModified is response for GetProject operation.
+type GetProjectNotModified struct {
+	ETag string
 }
 
-// OptBool is optional bool.
-type OptBool struct {
-	Value bool
-	Set   bool
+// GetETag returns the value of ETag.
+func (s *GetProjectNotModified) GetETag() string {
+	return s.ETag
 }
 
-// IsSet returns true if OptBool was set.
-func (o OptBool) IsSet() bool { return o.Set }
+// SetETag sets the value of ETag.
+func (s *GetProjectNotModified) SetETag(val string) {
+	s.ETag = val
+}
 
-// Reset unsets value.
-func (o *OptBool) Reset() {
-	var v bool
-	o.Value = v
-	o.Set = false
+func (*GetProjectNotModified) getProjectRes() {}
+
+// GetTagNotModified is response for GetTag operation.
+type GetTagNotModified struct {
+	ETag string
 }
 
-// SetTo sets value to v.
-func (o *OptBool) SetTo(v bool) {
-	o.Set = true
-	o.Value = v
+// GetETag returns the value of ETag.
+func (s *GetTagNotModified) GetETag() string {
+	return s.ETag
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptBool) Get() (v bool, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetETag sets the value of ETag.
+func (s *GetTagNotModified) SetETag(val string) {
+	s.ETag = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptBool) Or(d bool) bool {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+func (*GetTagNotModified) getTagRes() {}
+
+// GetTaskNotModified is response for GetTask operation.
+type GetTaskNotModified struct {
+	ETag string
 }
 
-// NewOptCreateProjectReqColor returns new OptCreateProjectReqColor with value set to v.
-func NewOptCreateProjectReqColor(v CreateProjectReqColor) OptCreateProjectReqColor {
-	return OptCreateProjectReqColor{
-		Value: v,
-		Set:   true,
-	}
+// GetETag returns the value of ETag.
+func (s *GetTaskNotModified) GetETag() string {
+	return s.ETag
 }
 
-// OptCreateProjectReqColor is optional CreateProjectReqColor.
-type OptCreateProjectReqColor struct {
-	Value CreateProjectReqColor
-	Set   bool
+// SetETag sets the value of ETag.
+func (s *GetTaskNotModified) SetETag(val string) {
+	s.ETag = val
 }
 
-// IsSet returns true if OptCreateProjectReqColor was set.
-func (o OptCreateProjectReqColor) IsSet() bool { return o.Set }
+func (*GetTaskNotModified) getTaskRes() {}
 
-// Reset unsets value.
-func (o *OptCreateProjectReqColor) Reset() {
-	var v CreateProjectReqColor
-	o.Value = v
-	o.Set = false
+// Ref: #/components/schemas/historyEntry
+type HistoryEntry struct {
+	ID int64 `json:"id"`
+	// 操作したユーザのIDであり、ユーザが退会した場合は含まない.
+	UserID     OptString              `json:"user_id"`
+	EntityType HistoryEntryEntityType `json:"entity_type"`
+	EntityID   string                 `json:"entity_id"`
+	Action     HistoryEntryAction     `json:"action"`
+	Changes    []FieldChange          `json:"changes"`
+	CreatedAt  time.Time              `json:"created_at"`
 }
 
-// SetTo sets value to v.
-func (o *OptCreateProjectReqColor) SetTo(v CreateProjectReqColor) {
-	o.Set = true
-	o.Value = v
+// GetID returns the value of ID.
+func (s *HistoryEntry) GetID() int64 {
+	return s.ID
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptCreateProjectReqColor) Get() (v CreateProjectReqColor, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetUserID returns the value of UserID.
+func (s *HistoryEntry) GetUserID() OptString {
+	return s.UserID
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptCreateProjectReqColor) Or(d CreateProjectReqColor) CreateProjectReqColor {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetEntityType returns the value of EntityType.
+func (s *HistoryEntry) GetEntityType() HistoryEntryEntityType {
+	return s.EntityType
 }
 
-// NewOptDate returns new OptDate with value set to v.
-func NewOptDate(v time.Time) OptDate {
-	return OptDate{
-		Value: v,
-		Set:   true,
-	}
+// GetEntityID returns the value of EntityID.
+func (s *HistoryEntry) GetEntityID() string {
+	return s.EntityID
 }
 
-// OptDate is optional time.Time.
-type OptDate struct {
-	Value time.Time
-	Set   bool
+// GetAction returns the value of Action.
+func (s *HistoryEntry) GetAction() HistoryEntryAction {
+	return s.Action
 }
 
-// IsSet returns true if OptDate was set.
-func (o OptDate) IsSet() bool { return o.Set }
+// GetChanges returns the value of Changes.
+func (s *HistoryEntry) GetChanges() []FieldChange {
+	return s.Changes
+}
 
-// Reset unsets value.
-func (o *OptDate) Reset() {
-	var v time.Time
-	o.Value = v
-	o.Set = false
+// GetCreatedAt returns the value of CreatedAt.
+func (s *HistoryEntry) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// SetTo sets value to v.
-func (o *OptDate) SetTo(v time.Time) {
-	o.Set = true
-	o.Value = v
+// SetID sets the value of ID.
+func (s *HistoryEntry) SetID(val int64) {
+	s.ID = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptDate) Get() (v time.Time, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetUserID sets the value of UserID.
+func (s *HistoryEntry) SetUserID(val OptString) {
+	s.UserID = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptDate) Or(d time.Time) time.Time {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetEntityType sets the value of EntityType.
+func (s *HistoryEntry) SetEntityType(val HistoryEntryEntityType) {
+	s.EntityType = val
 }
 
-// NewOptDateTime returns new OptDateTime with value set to v.
-func NewOptDateTime(v time.Time) OptDateTime {
-	return OptDateTime{
-		Value: v,
-		Set:   true,
-	}
+// SetEntityID sets the value of EntityID.
+func (s *HistoryEntry) SetEntityID(val string) {
+	s.EntityID = val
 }
 
-// OptDateTime is optional time.Time.
-type OptDateTime struct {
-	Value time.Time
-	Set   bool
+// SetAction sets the value of Action.
+func (s *HistoryEntry) SetAction(val HistoryEntryAction) {
+	s.Action = val
 }
 
-// IsSet returns true if OptDateTime was set.
-func (o OptDateTime) IsSet() bool { return o.Set }
+// SetChanges sets the value of Changes.
+func (s *HistoryEntry) SetChanges(val []FieldChange) {
+	s.Changes = val
+}
 
-// Reset unsets value.
-func (o *OptDateTime) Reset() {
-	var v time.Time
-	o.Value = v
-	o.Set = false
+// SetCreatedAt sets the value of CreatedAt.
+func (s *HistoryEntry) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// SetTo sets value to v.
-func (o *OptDateTime) SetTo(v time.Time) {
-	o.Set = true
-	o.Value = v
+type HistoryEntryAction string
+
+const (
+	HistoryEntryActionCreate  HistoryEntryAction = "create"
+	HistoryEntryActionUpdate  HistoryEntryAction = "update"
+	HistoryEntryActionDelete  HistoryEntryAction = "delete"
+	HistoryEntryActionRestore HistoryEntryAction = "restore"
+)
+
+// AllValues returns all HistoryEntryAction values.
+func (HistoryEntryAction) AllValues() []HistoryEntryAction {
+	return []HistoryEntryAction{
+		HistoryEntryActionCreate,
+		HistoryEntryActionUpdate,
+		HistoryEntryActionDelete,
+		HistoryEntryActionRestore,
+	}
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptDateTime) Get() (v time.Time, ok bool) {
-	if !o.Set {
-		return v, false
+// MarshalText implements encoding.TextMarshaler.
+func (s HistoryEntryAction) MarshalText() ([]byte, error) {
+	switch s {
+	case HistoryEntryActionCreate:
+		return []byte(s), nil
+	case HistoryEntryActionUpdate:
+		return []byte(s), nil
+	case HistoryEntryActionDelete:
+		return []byte(s), nil
+	case HistoryEntryActionRestore:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptDateTime) Or(d time.Time) time.Time {
-	if v, ok := o.Get(); ok {
-		return v
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *HistoryEntryAction) UnmarshalText(data []byte) error {
+	switch HistoryEntryAction(data) {
+	case HistoryEntryActionCreate:
+		*s = HistoryEntryActionCreate
+		return nil
+	case HistoryEntryActionUpdate:
+		*s = HistoryEntryActionUpdate
+		return nil
+	case HistoryEntryActionDelete:
+		*s = HistoryEntryActionDelete
+		return nil
+	case HistoryEntryActionRestore:
+		*s = HistoryEntryActionRestore
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
-	return d
 }
 
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
-		Value: v,
-		Set:   true,
+type HistoryEntryEntityType string
+
+const (
+	HistoryEntryEntityTypeProject HistoryEntryEntityType = "project"
+	HistoryEntryEntityTypeTask    HistoryEntryEntityType = "task"
+	HistoryEntryEntityTypeStep    HistoryEntryEntityType = "step"
+	HistoryEntryEntityTypeTag     HistoryEntryEntityType = "tag"
+)
+
+// AllValues returns all HistoryEntryEntityType values.
+func (HistoryEntryEntityType) AllValues() []HistoryEntryEntityType {
+	return []HistoryEntryEntityType{
+		HistoryEntryEntityTypeProject,
+		HistoryEntryEntityTypeTask,
+		HistoryEntryEntityTypeStep,
+		HistoryEntryEntityTypeTag,
 	}
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
-	Set   bool
+// MarshalText implements encoding.TextMarshaler.
+func (s HistoryEntryEntityType) MarshalText() ([]byte, error) {
+	switch s {
+	case HistoryEntryEntityTypeProject:
+		return []byte(s), nil
+	case HistoryEntryEntityTypeTask:
+		return []byte(s), nil
+	case HistoryEntryEntityTypeStep:
+		return []byte(s), nil
+	case HistoryEntryEntityTypeTag:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *HistoryEntryEntityType) UnmarshalText(data []byte) error {
+	switch HistoryEntryEntityType(data) {
+	case HistoryEntryEntityTypeProject:
+		*s = HistoryEntryEntityTypeProject
+		return nil
+	case HistoryEntryEntityTypeTask:
+		*s = HistoryEntryEntityTypeTask
+		return nil
+	case HistoryEntryEntityTypeStep:
+		*s = HistoryEntryEntityTypeStep
+		return nil
+	case HistoryEntryEntityTypeTag:
+		*s = HistoryEntryEntityTypeTag
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
 
-// Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
-	o.Value = v
-	o.Set = false
+type InviteProjectMemberReq struct {
+	Email string                     `json:"email"`
+	Role  InviteProjectMemberReqRole `json:"role" log:"allow"`
 }
 
-// SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
-	o.Set = true
-	o.Value = v
+// GetEmail returns the value of Email.
+func (s *InviteProjectMemberReq) GetEmail() string {
+	return s.Email
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
-	if !o.Set {
-		return v, false
+// GetRole returns the value of Role.
+func (s *InviteProjectMemberReq) GetRole() InviteProjectMemberReqRole {
+	return s.Role
+}
+
+// SetEmail sets the value of Email.
+func (s *InviteProjectMemberReq) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetRole sets the value of Role.
+func (s *InviteProjectMemberReq) SetRole(val InviteProjectMemberReqRole) {
+	s.Role = val
+}
+
+type InviteProjectMemberReqRole string
+
+const (
+	InviteProjectMemberReqRoleEditor InviteProjectMemberReqRole = "editor"
+	InviteProjectMemberReqRoleViewer InviteProjectMemberReqRole = "viewer"
+)
+
+// AllValues returns all InviteProjectMemberReqRole values.
+func (InviteProjectMemberReqRole) AllValues() []InviteProjectMemberReqRole {
+	return []InviteProjectMemberReqRole{
+		InviteProjectMemberReqRoleEditor,
+		InviteProjectMemberReqRoleViewer,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s InviteProjectMemberReqRole) MarshalText() ([]byte, error) {
+	switch s {
+	case InviteProjectMemberReqRoleEditor:
+		return []byte(s), nil
+	case InviteProjectMemberReqRoleViewer:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// NewOptNilDate returns new OptNilDate with value set to v.
-func NewOptNilDate(v time.Time) OptNilDate {
-	return OptNilDate{
-		Value: v,
-		Set:   true,
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *InviteProjectMemberReqRole) UnmarshalText(data []byte) error {
+	switch InviteProjectMemberReqRole(data) {
+	case InviteProjectMemberReqRoleEditor:
+		*s = InviteProjectMemberReqRoleEditor
+		return nil
+	case InviteProjectMemberReqRoleViewer:
+		*s = InviteProjectMemberReqRoleViewer
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// OptNilDate is optional nullable time.Time.
-type OptNilDate struct {
-	Value time.Time
-	Set   bool
-	Null  bool
+// Ref: #/components/schemas/jwk
+type Jwk struct {
+	Kty string    `json:"kty"`
+	Kid string    `json:"kid"`
+	Alg string    `json:"alg"`
+	Use string    `json:"use"`
+	Crv OptString `json:"crv"`
+	X   OptString `json:"x"`
+	N   OptString `json:"n"`
+	E   OptString `json:"e"`
 }
 
-// IsSet returns true if OptNilDate was set.
-func (o OptNilDate) IsSet() bool { return o.Set }
+// GetKty returns the value of Kty.
+func (s *Jwk) GetKty() string {
+	return s.Kty
+}
 
-// Reset unsets value.
-func (o *OptNilDate) Reset() {
-	var v time.Time
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// GetKid returns the value of Kid.
+func (s *Jwk) GetKid() string {
+	return s.Kid
 }
 
-// SetTo sets value to v.
-func (o *OptNilDate) SetTo(v time.Time) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// GetAlg returns the value of Alg.
+func (s *Jwk) GetAlg() string {
+	return s.Alg
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilDate) IsNull() bool { return o.Null }
+// GetUse returns the value of Use.
+func (s *Jwk) GetUse() string {
+	return s.Use
+}
 
-// SetToNull sets value to null.
-func (o *OptNilDate) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v time.Time
-	o.Value = v
+// GetCrv returns the value of Crv.
+func (s *Jwk) GetCrv() OptString {
+	return s.Crv
 }
 
-// IsEmpty returns true if the field was omitted from the payload (not Set and not Null).
-func (o OptNilDate) IsEmpty() bool {
+// GetX returns the value of X.
+func (s *Jwk) GetX() OptString {
+	return s.X
+}
+
+// GetN returns the value of N.
+func (s *Jwk) GetN() OptString {
+	return s.N
+}
+
+// GetE returns the value of E.
+func (s *Jwk) GetE() OptString {
+	return s.E
+}
+
+// SetKty sets the value of Kty.
+func (s *Jwk) SetKty(val string) {
+	s.Kty = val
+}
+
+// SetKid sets the value of Kid.
+func (s *Jwk) SetKid(val string) {
+	s.Kid = val
+}
+
+// SetAlg sets the value of Alg.
+func (s *Jwk) SetAlg(val string) {
+	s.Alg = val
+}
+
+// SetUse sets the value of Use.
+func (s *Jwk) SetUse(val string) {
+	s.Use = val
+}
+
+// SetCrv sets the value of Crv.
+func (s *Jwk) SetCrv(val OptString) {
+	s.Crv = val
+}
+
+// SetX sets the value of X.
+func (s *Jwk) SetX(val OptString) {
+	s.X = val
+}
+
+// SetN sets the value of N.
+func (s *Jwk) SetN(val OptString) {
+	s.N = val
+}
+
+// SetE sets the value of E.
+func (s *Jwk) SetE(val OptString) {
+	s.E = val
+}
+
+type ListAssignedTasksOK struct {
+	Tasks      []Task    `json:"tasks"`
+	HasNext    bool      `json:"has_next"`
+	NextCursor OptString `json:"next_cursor"`
+}
+
+// GetTasks returns the value of Tasks.
+func (s *ListAssignedTasksOK) GetTasks() []Task {
+	return s.Tasks
+}
+
+// GetHasNext returns the value of HasNext.
+func (s *ListAssignedTasksOK) GetHasNext() bool {
+	return s.HasNext
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *ListAssignedTasksOK) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetTasks sets the value of Tasks.
+func (s *ListAssignedTasksOK) SetTasks(val []Task) {
+	s.Tasks = val
+}
+
+// SetHasNext sets the value of HasNext.
+func (s *ListAssignedTasksOK) SetHasNext(val bool) {
+	s.HasNext = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *ListAssignedTasksOK) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+type ListCommentsOK struct {
+	Comments   []Comment `json:"comments"`
+	HasNext    bool      `json:"has_next"`
+	NextCursor OptString `json:"next_cursor"`
+}
+
+// GetComments returns the value of Comments.
+func (s *ListCommentsOK) GetComments() []Comment {
+	return s.Comments
+}
+
+// GetHasNext returns the value of HasNext.
+func (s *ListCommentsOK) GetHasNext() bool {
+	return s.HasNext
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *ListCommentsOK) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetComments sets the value of Comments.
+func (s *ListCommentsOK) SetComments(val []Comment) {
+	s.Comments = val
+}
+
+// SetHasNext sets the value of HasNext.
+func (s *ListCommentsOK) SetHasNext(val bool) {
+	s.HasNext = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *ListCommentsOK) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+type ListInvitationsOK struct {
+	Invitations []ProjectInvitation `json:"invitations"`
+}
+
+// GetInvitations returns the value of Invitations.
+func (s *ListInvitationsOK) GetInvitations() []ProjectInvitation {
+	return s.Invitations
+}
+
+// SetInvitations sets the value of Invitations.
+func (s *ListInvitationsOK) SetInvitations(val []ProjectInvitation) {
+	s.Invitations = val
+}
+
+type ListOverdueTasksOK struct {
+	Tasks      []Task    `json:"tasks"`
+	HasNext    bool      `json:"has_next"`
+	NextCursor OptString `json:"next_cursor"`
+}
+
+// GetTasks returns the value of Tasks.
+func (s *ListOverdueTasksOK) GetTasks() []Task {
+	return s.Tasks
+}
+
+// GetHasNext returns the value of HasNext.
+func (s *ListOverdueTasksOK) GetHasNext() bool {
+	return s.HasNext
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *ListOverdueTasksOK) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetTasks sets the value of Tasks.
+func (s *ListOverdueTasksOK) SetTasks(val []Task) {
+	s.Tasks = val
+}
+
+// SetHasNext sets the value of HasNext.
+func (s *ListOverdueTasksOK) SetHasNext(val bool) {
+	s.HasNext = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *ListOverdueTasksOK) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+type ListPersonalAccessTokensOK struct {
+	PersonalAccessTokens []PersonalAccessToken `json:"personal_access_tokens"`
+}
+
+// GetPersonalAccessTokens returns the value of PersonalAccessTokens.
+func (s *ListPersonalAccessTokensOK) GetPersonalAccessTokens() []PersonalAccessToken {
+	return s.PersonalAccessTokens
+}
+
+// SetPersonalAccessTokens sets the value of PersonalAccessTokens.
+func (s *ListPersonalAccessTokensOK) SetPersonalAccessTokens(val []PersonalAccessToken) {
+	s.PersonalAccessTokens = val
+}
+
+type ListProjectHistoryOK struct {
+	Entries    []HistoryEntry `json:"entries"`
+	HasNext    bool           `json:"has_next"`
+	NextCursor OptString      `json:"next_cursor"`
+}
+
+// GetEntries returns the value of Entries.
+func (s *ListProjectHistoryOK) GetEntries() []HistoryEntry {
+	return s.Entries
+}
+
+// GetHasNext returns the value of HasNext.
+func (s *ListProjectHistoryOK) GetHasNext() bool {
+	return s.HasNext
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *ListProjectHistoryOK) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetEntries sets the value of Entries.
+func (s *ListProjectHistoryOK) SetEntries(val []HistoryEntry) {
+	s.Entries = val
+}
+
+// SetHasNext sets the value of HasNext.
+func (s *ListProjectHistoryOK) SetHasNext(val bool) {
+	s.HasNext = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *ListProjectHistoryOK) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+type ListProjectMembersOK struct {
+	Members     []ProjectMember     `json:"members"`
+	Invitations []ProjectInvitation `json:"invitations"`
+}
+
+// GetMembers returns the value of Members.
+func (s *ListProjectMembersOK) GetMembers() []ProjectMember {
+	return s.Members
+}
+
+// GetInvitations returns the value of Invitations.
+func (s *ListProjectMembersOK) GetInvitations() []ProjectInvitation {
+	return s.Invitations
+}
+
+// SetMembers sets the value of Members.
+func (s *ListProjectMembersOK) SetMembers(val []ProjectMember) {
+	s.Members = val
+}
+
+// SetInvitations sets the value of Invitations.
+func (s *ListProjectMembersOK) SetInvitations(val []ProjectInvitation) {
+	s.Invitations = val
+}
+
+type ListProjectsOK struct {
+	Projects   []Project `json:"projects"`
+	HasNext    bool      `json:"has_next"`
+	NextCursor OptString `json:"next_cursor"`
+}
+
+// GetProjects returns the value of Projects.
+func (s *ListProjectsOK) GetProjects() []Project {
+	return s.Projects
+}
+
+// GetHasNext returns the value of HasNext.
+func (s *ListProjectsOK) GetHasNext() bool {
+	return s.HasNext
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *ListProjectsOK) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetProjects sets the value of Projects.
+func (s *ListProjectsOK) SetProjects(val []Project) {
+	s.Projects = val
+}
+
+// SetHasNext sets the value of HasNext.
+func (s *ListProjectsOK) SetHasNext(val bool) {
+	s.HasNext = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *ListProjectsOK) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+type ListSearchResultsOK struct {
+	Results []SearchResult `json:"results"`
+	HasNext bool           `json:"has_next"`
+}
+
+// GetResults returns the value of Results.
+func (s *ListSearchResultsOK) GetResults() []SearchResult {
+	return s.Results
+}
+
+// GetHasNext returns the value of HasNext.
+func (s *ListSearchResultsOK) GetHasNext() bool {
+	return s.HasNext
+}
+
+// SetResults sets the value of Results.
+func (s *ListSearchResultsOK) SetResults(val []SearchResult) {
+	s.Results = val
+}
+
+// SetHasNext sets the value of HasNext.
+func (s *ListSearchResultsOK) SetHasNext(val bool) {
+	s.HasNext = val
+}
+
+type ListSearchResultsTagMatch string
+
+const (
+	ListSearchResultsTagMatchAny ListSearchResultsTagMatch = "any"
+	ListSearchResultsTagMatchAll ListSearchResultsTagMatch = "all"
+)
+
+// AllValues returns all ListSearchResultsTagMatch values.
+func (ListSearchResultsTagMatch) AllValues() []ListSearchResultsTagMatch {
+	return []ListSearchResultsTagMatch{
+		ListSearchResultsTagMatchAny,
+		ListSearchResultsTagMatchAll,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ListSearchResultsTagMatch) MarshalText() ([]byte, error) {
+	switch s {
+	case ListSearchResultsTagMatchAny:
+		return []byte(s), nil
+	case ListSearchResultsTagMatchAll:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ListSearchResultsTagMatch) UnmarshalText(data []byte) error {
+	switch ListSearchResultsTagMatch(data) {
+	case ListSearchResultsTagMatchAny:
+		*s = ListSearchResultsTagMatchAny
+		return nil
+	case ListSearchResultsTagMatchAll:
+		*s = ListSearchResultsTagMatchAll
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type ListTagsOK struct {
+	Tags       []Tag     `json:"tags"`
+	HasNext    bool      `json:"has_next"`
+	NextCursor OptString `json:"next_cursor"`
+}
+
+// GetTags returns the value of Tags.
+func (s *ListTagsOK) GetTags() []Tag {
+	return s.Tags
+}
+
+// GetHasNext returns the value of HasNext.
+func (s *ListTagsOK) GetHasNext() bool {
+	return s.HasNext
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *ListTagsOK) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetTags sets the value of Tags.
+func (s *ListTagsOK) SetTags(val []Tag) {
+	s.Tags = val
+}
+
+// SetHasNext sets the value of HasNext.
+func (s *ListTagsOK) SetHasNext(val bool) {
+	s.HasNext = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *ListTagsOK) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+type ListTaskHistoryOK struct {
+	Entries    []HistoryEntry `json:"entries"`
+	HasNext    bool           `json:"has_next"`
+	NextCursor OptString      `json:"next_cursor"`
+}
+
+// GetEntries returns the value of Entries.
+func (s *ListTaskHistoryOK) GetEntries() []HistoryEntry {
+	return s.Entries
+}
+
+// GetHasNext returns the value of HasNext.
+func (s *ListTaskHistoryOK) GetHasNext() bool {
+	return s.HasNext
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *ListTaskHistoryOK) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetEntries sets the value of Entries.
+func (s *ListTaskHistoryOK) SetEntries(val []HistoryEntry) {
+	s.Entries = val
+}
+
+// SetHasNext sets the value of HasNext.
+func (s *ListTaskHistoryOK) SetHasNext(val bool) {
+	s.HasNext = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *ListTaskHistoryOK) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+type ListTasksOK struct {
+	Tasks      []Task    `json:"tasks"`
+	HasNext    bool      `json:"has_next"`
+	NextCursor OptString `json:"next_cursor"`
+}
+
+// GetTasks returns the value of Tasks.
+func (s *ListTasksOK) GetTasks() []Task {
+	return s.Tasks
+}
+
+// GetHasNext returns the value of HasNext.
+func (s *ListTasksOK) GetHasNext() bool {
+	return s.HasNext
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *ListTasksOK) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetTasks sets the value of Tasks.
+func (s *ListTasksOK) SetTasks(val []Task) {
+	s.Tasks = val
+}
+
+// SetHasNext sets the value of HasNext.
+func (s *ListTasksOK) SetHasNext(val bool) {
+	s.HasNext = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *ListTasksOK) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+type ListTasksOrder string
+
+const (
+	ListTasksOrderAsc  ListTasksOrder = "asc"
+	ListTasksOrderDesc ListTasksOrder = "desc"
+)
+
+// AllValues returns all ListTasksOrder values.
+func (ListTasksOrder) AllValues() []ListTasksOrder {
+	return []ListTasksOrder{
+		ListTasksOrderAsc,
+		ListTasksOrderDesc,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ListTasksOrder) MarshalText() ([]byte, error) {
+	switch s {
+	case ListTasksOrderAsc:
+		return []byte(s), nil
+	case ListTasksOrderDesc:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ListTasksOrder) UnmarshalText(data []byte) error {
+	switch ListTasksOrder(data) {
+	case ListTasksOrderAsc:
+		*s = ListTasksOrderAsc
+		return nil
+	case ListTasksOrderDesc:
+		*s = ListTasksOrderDesc
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type ListTasksSort string
+
+const (
+	ListTasksSortPosition  ListTasksSort = "position"
+	ListTasksSortPriority  ListTasksSort = "priority"
+	ListTasksSortDueOn     ListTasksSort = "due_on"
+	ListTasksSortCreatedAt ListTasksSort = "created_at"
+	ListTasksSortUpdatedAt ListTasksSort = "updated_at"
+	ListTasksSortName      ListTasksSort = "name"
+)
+
+// AllValues returns all ListTasksSort values.
+func (ListTasksSort) AllValues() []ListTasksSort {
+	return []ListTasksSort{
+		ListTasksSortPosition,
+		ListTasksSortPriority,
+		ListTasksSortDueOn,
+		ListTasksSortCreatedAt,
+		ListTasksSortUpdatedAt,
+		ListTasksSortName,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ListTasksSort) MarshalText() ([]byte, error) {
+	switch s {
+	case ListTasksSortPosition:
+		return []byte(s), nil
+	case ListTasksSortPriority:
+		return []byte(s), nil
+	case ListTasksSortDueOn:
+		return []byte(s), nil
+	case ListTasksSortCreatedAt:
+		return []byte(s), nil
+	case ListTasksSortUpdatedAt:
+		return []byte(s), nil
+	case ListTasksSortName:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ListTasksSort) UnmarshalText(data []byte) error {
+	switch ListTasksSort(data) {
+	case ListTasksSortPosition:
+		*s = ListTasksSortPosition
+		return nil
+	case ListTasksSortPriority:
+		*s = ListTasksSortPriority
+		return nil
+	case ListTasksSortDueOn:
+		*s = ListTasksSortDueOn
+		return nil
+	case ListTasksSortCreatedAt:
+		*s = ListTasksSortCreatedAt
+		return nil
+	case ListTasksSortUpdatedAt:
+		*s = ListTasksSortUpdatedAt
+		return nil
+	case ListTasksSortName:
+		*s = ListTasksSortName
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type ListTasksTagMatch string
+
+const (
+	ListTasksTagMatchAny ListTasksTagMatch = "any"
+	ListTasksTagMatchAll ListTasksTagMatch = "all"
+)
+
+// AllValues returns all ListTasksTagMatch values.
+func (ListTasksTagMatch) AllValues() []ListTasksTagMatch {
+	return []ListTasksTagMatch{
+		ListTasksTagMatchAny,
+		ListTasksTagMatchAll,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ListTasksTagMatch) MarshalText() ([]byte, error) {
+	switch s {
+	case ListTasksTagMatchAny:
+		return []byte(s), nil
+	case ListTasksTagMatchAll:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ListTasksTagMatch) UnmarshalText(data []byte) error {
+	switch ListTasksTagMatch(data) {
+	case ListTasksTagMatchAny:
+		*s = ListTasksTagMatchAny
+		return nil
+	case ListTasksTagMatchAll:
+		*s = ListTasksTagMatchAll
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type ListTodayTasksOK struct {
+	Tasks      []Task    `json:"tasks"`
+	HasNext    bool      `json:"has_next"`
+	NextCursor OptString `json:"next_cursor"`
+}
+
+// GetTasks returns the value of Tasks.
+func (s *ListTodayTasksOK) GetTasks() []Task {
+	return s.Tasks
+}
+
+// GetHasNext returns the value of HasNext.
+func (s *ListTodayTasksOK) GetHasNext() bool {
+	return s.HasNext
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *ListTodayTasksOK) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetTasks sets the value of Tasks.
+func (s *ListTodayTasksOK) SetTasks(val []Task) {
+	s.Tasks = val
+}
+
+// SetHasNext sets the value of HasNext.
+func (s *ListTodayTasksOK) SetHasNext(val bool) {
+	s.HasNext = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *ListTodayTasksOK) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+type ListTrashOK struct {
+	Items   []TrashItem `json:"items"`
+	HasNext bool        `json:"has_next"`
+}
+
+// GetItems returns the value of Items.
+func (s *ListTrashOK) GetItems() []TrashItem {
+	return s.Items
+}
+
+// GetHasNext returns the value of HasNext.
+func (s *ListTrashOK) GetHasNext() bool {
+	return s.HasNext
+}
+
+// SetItems sets the value of Items.
+func (s *ListTrashOK) SetItems(val []TrashItem) {
+	s.Items = val
+}
+
+// SetHasNext sets the value of HasNext.
+func (s *ListTrashOK) SetHasNext(val bool) {
+	s.HasNext = val
+}
+
+type ListUpcomingTasksOK struct {
+	Tasks      []Task    `json:"tasks"`
+	HasNext    bool      `json:"has_next"`
+	NextCursor OptString `json:"next_cursor"`
+}
+
+// GetTasks returns the value of Tasks.
+func (s *ListUpcomingTasksOK) GetTasks() []Task {
+	return s.Tasks
+}
+
+// GetHasNext returns the value of HasNext.
+func (s *ListUpcomingTasksOK) GetHasNext() bool {
+	return s.HasNext
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *ListUpcomingTasksOK) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetTasks sets the value of Tasks.
+func (s *ListUpcomingTasksOK) SetTasks(val []Task) {
+	s.Tasks = val
+}
+
+// SetHasNext sets the value of HasNext.
+func (s *ListUpcomingTasksOK) SetHasNext(val bool) {
+	s.HasNext = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *ListUpcomingTasksOK) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+type MoveProjectReq struct {
+	BeforeID OptString `json:"before_id" log:"allow"`
+	AfterID  OptString `json:"after_id" log:"allow"`
+}
+
+// GetBeforeID returns the value of BeforeID.
+func (s *MoveProjectReq) GetBeforeID() OptString {
+	return s.BeforeID
+}
+
+// GetAfterID returns the value of AfterID.
+func (s *MoveProjectReq) GetAfterID() OptString {
+	return s.AfterID
+}
+
+// SetBeforeID sets the value of BeforeID.
+func (s *MoveProjectReq) SetBeforeID(val OptString) {
+	s.BeforeID = val
+}
+
+// SetAfterID sets the value of AfterID.
+func (s *MoveProjectReq) SetAfterID(val OptString) {
+	s.AfterID = val
+}
+
+type MoveStepReq struct {
+	BeforeID OptString `json:"before_id" log:"allow"`
+	AfterID  OptString `json:"after_id" log:"allow"`
+}
+
+// GetBeforeID returns the value of BeforeID.
+func (s *MoveStepReq) GetBeforeID() OptString {
+	return s.BeforeID
+}
+
+// GetAfterID returns the value of AfterID.
+func (s *MoveStepReq) GetAfterID() OptString {
+	return s.AfterID
+}
+
+// SetBeforeID sets the value of BeforeID.
+func (s *MoveStepReq) SetBeforeID(val OptString) {
+	s.BeforeID = val
+}
+
+// SetAfterID sets the value of AfterID.
+func (s *MoveStepReq) SetAfterID(val OptString) {
+	s.AfterID = val
+}
+
+type MoveTaskReq struct {
+	BeforeID OptString `json:"before_id" log:"allow"`
+	AfterID  OptString `json:"after_id" log:"allow"`
+}
+
+// GetBeforeID returns the value of BeforeID.
+func (s *MoveTaskReq) GetBeforeID() OptString {
+	return s.BeforeID
+}
+
+// GetAfterID returns the value of AfterID.
+func (s *MoveTaskReq) GetAfterID() OptString {
+	return s.AfterID
+}
+
+// SetBeforeID sets the value of BeforeID.
+func (s *MoveTaskReq) SetBeforeID(val OptString) {
+	s.BeforeID = val
+}
+
+// SetAfterID sets the value of AfterID.
+func (s *MoveTaskReq) SetAfterID(val OptString) {
+	s.AfterID = val
+}
+
+// NewNilUpdateLanguageReqLanguage returns new NilUpdateLanguageReqLanguage with value set to v.
+func NewNilUpdateLanguageReqLanguage(v UpdateLanguageReqLanguage) NilUpdateLanguageReqLanguage {
+	return NilUpdateLanguageReqLanguage{
+		Value: v,
+	}
+}
+
+// NilUpdateLanguageReqLanguage is nullable UpdateLanguageReqLanguage.
+type NilUpdateLanguageReqLanguage struct {
+	Value UpdateLanguageReqLanguage
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilUpdateLanguageReqLanguage) SetTo(v UpdateLanguageReqLanguage) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilUpdateLanguageReqLanguage) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilUpdateLanguageReqLanguage) SetToNull() {
+	o.Null = true
+	var v UpdateLanguageReqLanguage
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilUpdateLanguageReqLanguage) Get() (v UpdateLanguageReqLanguage, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilUpdateLanguageReqLanguage) Or(d UpdateLanguageReqLanguage) UpdateLanguageReqLanguage {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCreateProjectReqColor returns new OptCreateProjectReqColor with value set to v.
+func NewOptCreateProjectReqColor(v CreateProjectReqColor) OptCreateProjectReqColor {
+	return OptCreateProjectReqColor{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCreateProjectReqColor is optional CreateProjectReqColor.
+type OptCreateProjectReqColor struct {
+	Value CreateProjectReqColor
+	Set   bool
+}
+
+// IsSet returns true if OptCreateProjectReqColor was set.
+func (o OptCreateProjectReqColor) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCreateProjectReqColor) Reset() {
+	var v CreateProjectReqColor
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCreateProjectReqColor) SetTo(v CreateProjectReqColor) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCreateProjectReqColor) Get() (v CreateProjectReqColor, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCreateProjectReqColor) Or(d CreateProjectReqColor) CreateProjectReqColor {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDate returns new OptDate with value set to v.
+func NewOptDate(v time.Time) OptDate {
+	return OptDate{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDate is optional time.Time.
+type OptDate struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDate was set.
+func (o OptDate) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDate) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDate) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDate) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDate) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptListSearchResultsTagMatch returns new OptListSearchResultsTagMatch with value set to v.
+func NewOptListSearchResultsTagMatch(v ListSearchResultsTagMatch) OptListSearchResultsTagMatch {
+	return OptListSearchResultsTagMatch{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptListSearchResultsTagMatch is optional ListSearchResultsTagMatch.
+type OptListSearchResultsTagMatch struct {
+	Value ListSearchResultsTagMatch
+	Set   bool
+}
+
+// IsSet returns true if OptListSearchResultsTagMatch was set.
+func (o OptListSearchResultsTagMatch) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptListSearchResultsTagMatch) Reset() {
+	var v ListSearchResultsTagMatch
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptListSearchResultsTagMatch) SetTo(v ListSearchResultsTagMatch) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptListSearchResultsTagMatch) Get() (v ListSearchResultsTagMatch, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptListSearchResultsTagMatch) Or(d ListSearchResultsTagMatch) ListSearchResultsTagMatch {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptListTasksOrder returns new OptListTasksOrder with value set to v.
+func NewOptListTasksOrder(v ListTasksOrder) OptListTasksOrder {
+	return OptListTasksOrder{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptListTasksOrder is optional ListTasksOrder.
+type OptListTasksOrder struct {
+	Value ListTasksOrder
+	Set   bool
+}
+
+// IsSet returns true if OptListTasksOrder was set.
+func (o OptListTasksOrder) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptListTasksOrder) Reset() {
+	var v ListTasksOrder
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptListTasksOrder) SetTo(v ListTasksOrder) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptListTasksOrder) Get() (v ListTasksOrder, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptListTasksOrder) Or(d ListTasksOrder) ListTasksOrder {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptListTasksSort returns new OptListTasksSort with value set to v.
+func NewOptListTasksSort(v ListTasksSort) OptListTasksSort {
+	return OptListTasksSort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptListTasksSort is optional ListTasksSort.
+type OptListTasksSort struct {
+	Value ListTasksSort
+	Set   bool
+}
+
+// IsSet returns true if OptListTasksSort was set.
+func (o OptListTasksSort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptListTasksSort) Reset() {
+	var v ListTasksSort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptListTasksSort) SetTo(v ListTasksSort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptListTasksSort) Get() (v ListTasksSort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptListTasksSort) Or(d ListTasksSort) ListTasksSort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptListTasksTagMatch returns new OptListTasksTagMatch with value set to v.
+func NewOptListTasksTagMatch(v ListTasksTagMatch) OptListTasksTagMatch {
+	return OptListTasksTagMatch{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptListTasksTagMatch is optional ListTasksTagMatch.
+type OptListTasksTagMatch struct {
+	Value ListTasksTagMatch
+	Set   bool
+}
+
+// IsSet returns true if OptListTasksTagMatch was set.
+func (o OptListTasksTagMatch) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptListTasksTagMatch) Reset() {
+	var v ListTasksTagMatch
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptListTasksTagMatch) SetTo(v ListTasksTagMatch) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptListTasksTagMatch) Get() (v ListTasksTagMatch, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptListTasksTagMatch) Or(d ListTasksTagMatch) ListTasksTagMatch {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilDate returns new OptNilDate with value set to v.
+func NewOptNilDate(v time.Time) OptNilDate {
+	return OptNilDate{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilDate is optional nullable time.Time.
+type OptNilDate struct {
+	Value time.Time
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilDate was set.
+func (o OptNilDate) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilDate) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilDate) SetTo(v time.Time) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilDate) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilDate) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v time.Time
+	o.Value = v
+}
+
+// IsEmpty returns true if the field was omitted from the payload (not Set and not Null).
+func (o OptNilDate) IsEmpty() bool {
+	return !o.Set && !o.Null
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilDate) Get() (v time.Time, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilDate) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilDateTime returns new OptNilDateTime with value set to v.
+func NewOptNilDateTime(v time.Time) OptNilDateTime {
+	return OptNilDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilDateTime is optional nullable time.Time.
+type OptNilDateTime struct {
+	Value time.Time
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilDateTime was set.
+func (o OptNilDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilDateTime) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilDateTime) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v time.Time
+	o.Value = v
+}
+
+// IsEmpty returns true if the field was omitted from the payload (not Set and not Null).
+func (o OptNilDateTime) IsEmpty() bool {
+	return !o.Set && !o.Null
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilDateTime) Get() (v time.Time, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilString returns new OptNilString with value set to v.
+func NewOptNilString(v string) OptNilString {
+	return OptNilString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilString is optional nullable string.
+type OptNilString struct {
+	Value string
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilString was set.
+func (o OptNilString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilString) SetTo(v string) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilString) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilString) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v string
+	o.Value = v
+}
+
+// IsEmpty returns true if the field was omitted from the payload (not Set and not Null).
+func (o OptNilString) IsEmpty() bool {
 	return !o.Set && !o.Null
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilDate) Get() (v time.Time, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUpdateProjectReqColor returns new OptUpdateProjectReqColor with value set to v.
+func NewOptUpdateProjectReqColor(v UpdateProjectReqColor) OptUpdateProjectReqColor {
+	return OptUpdateProjectReqColor{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUpdateProjectReqColor is optional UpdateProjectReqColor.
+type OptUpdateProjectReqColor struct {
+	Value UpdateProjectReqColor
+	Set   bool
+}
+
+// IsSet returns true if OptUpdateProjectReqColor was set.
+func (o OptUpdateProjectReqColor) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUpdateProjectReqColor) Reset() {
+	var v UpdateProjectReqColor
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUpdateProjectReqColor) SetTo(v UpdateProjectReqColor) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUpdateProjectReqColor) Get() (v UpdateProjectReqColor, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUpdateProjectReqColor) Or(d UpdateProjectReqColor) UpdateProjectReqColor {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUserLanguage returns new OptUserLanguage with value set to v.
+func NewOptUserLanguage(v UserLanguage) OptUserLanguage {
+	return OptUserLanguage{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUserLanguage is optional UserLanguage.
+type OptUserLanguage struct {
+	Value UserLanguage
+	Set   bool
+}
+
+// IsSet returns true if OptUserLanguage was set.
+func (o OptUserLanguage) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUserLanguage) Reset() {
+	var v UserLanguage
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUserLanguage) SetTo(v UserLanguage) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUserLanguage) Get() (v UserLanguage, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUserLanguage) Or(d UserLanguage) UserLanguage {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/personalAccessToken
+type PersonalAccessToken struct {
+	ID         string                          `json:"id"`
+	Name       string                          `json:"name"`
+	Scopes     []PersonalAccessTokenScopesItem `json:"scopes"`
+	ExpiresAt  OptDateTime                     `json:"expires_at"`
+	LastUsedAt OptDateTime                     `json:"last_used_at"`
+	CreatedAt  time.Time                       `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *PersonalAccessToken) GetID() string {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *PersonalAccessToken) GetName() string {
+	return s.Name
+}
+
+// GetScopes returns the value of Scopes.
+func (s *PersonalAccessToken) GetScopes() []PersonalAccessTokenScopesItem {
+	return s.Scopes
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *PersonalAccessToken) GetExpiresAt() OptDateTime {
+	return s.ExpiresAt
+}
+
+// GetLastUsedAt returns the value of LastUsedAt.
+func (s *PersonalAccessToken) GetLastUsedAt() OptDateTime {
+	return s.LastUsedAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *PersonalAccessToken) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *PersonalAccessToken) SetID(val string) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *PersonalAccessToken) SetName(val string) {
+	s.Name = val
+}
+
+// SetScopes sets the value of Scopes.
+func (s *PersonalAccessToken) SetScopes(val []PersonalAccessTokenScopesItem) {
+	s.Scopes = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *PersonalAccessToken) SetExpiresAt(val OptDateTime) {
+	s.ExpiresAt = val
+}
+
+// SetLastUsedAt sets the value of LastUsedAt.
+func (s *PersonalAccessToken) SetLastUsedAt(val OptDateTime) {
+	s.LastUsedAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *PersonalAccessToken) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+type PersonalAccessTokenScopesItem string
+
+const (
+	PersonalAccessTokenScopesItemProjectsRead  PersonalAccessTokenScopesItem = "projects:read"
+	PersonalAccessTokenScopesItemProjectsWrite PersonalAccessTokenScopesItem = "projects:write"
+	PersonalAccessTokenScopesItemTasksRead     PersonalAccessTokenScopesItem = "tasks:read"
+	PersonalAccessTokenScopesItemTasksWrite    PersonalAccessTokenScopesItem = "tasks:write"
+	PersonalAccessTokenScopesItemTagsRead      PersonalAccessTokenScopesItem = "tags:read"
+	PersonalAccessTokenScopesItemTagsWrite     PersonalAccessTokenScopesItem = "tags:write"
+)
+
+// AllValues returns all PersonalAccessTokenScopesItem values.
+func (PersonalAccessTokenScopesItem) AllValues() []PersonalAccessTokenScopesItem {
+	return []PersonalAccessTokenScopesItem{
+		PersonalAccessTokenScopesItemProjectsRead,
+		PersonalAccessTokenScopesItemProjectsWrite,
+		PersonalAccessTokenScopesItemTasksRead,
+		PersonalAccessTokenScopesItemTasksWrite,
+		PersonalAccessTokenScopesItemTagsRead,
+		PersonalAccessTokenScopesItemTagsWrite,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PersonalAccessTokenScopesItem) MarshalText() ([]byte, error) {
+	switch s {
+	case PersonalAccessTokenScopesItemProjectsRead:
+		return []byte(s), nil
+	case PersonalAccessTokenScopesItemProjectsWrite:
+		return []byte(s), nil
+	case PersonalAccessTokenScopesItemTasksRead:
+		return []byte(s), nil
+	case PersonalAccessTokenScopesItemTasksWrite:
+		return []byte(s), nil
+	case PersonalAccessTokenScopesItemTagsRead:
+		return []byte(s), nil
+	case PersonalAccessTokenScopesItemTagsWrite:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PersonalAccessTokenScopesItem) UnmarshalText(data []byte) error {
+	switch PersonalAccessTokenScopesItem(data) {
+	case PersonalAccessTokenScopesItemProjectsRead:
+		*s = PersonalAccessTokenScopesItemProjectsRead
+		return nil
+	case PersonalAccessTokenScopesItemProjectsWrite:
+		*s = PersonalAccessTokenScopesItemProjectsWrite
+		return nil
+	case PersonalAccessTokenScopesItemTasksRead:
+		*s = PersonalAccessTokenScopesItemTasksRead
+		return nil
+	case PersonalAccessTokenScopesItemTasksWrite:
+		*s = PersonalAccessTokenScopesItemTasksWrite
+		return nil
+	case PersonalAccessTokenScopesItemTagsRead:
+		*s = PersonalAccessTokenScopesItemTagsRead
+		return nil
+	case PersonalAccessTokenScopesItemTagsWrite:
+		*s = PersonalAccessTokenScopesItemTagsWrite
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/project
+type Project struct {
+	ID         string       `json:"id"`
+	Name       string       `json:"name"`
+	Color      ProjectColor `json:"color"`
+	IsArchived bool         `json:"is_archived"`
+	CreatedAt  time.Time    `json:"created_at"`
+	UpdatedAt  time.Time    `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Project) GetID() string {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Project) GetName() string {
+	return s.Name
+}
+
+// GetColor returns the value of Color.
+func (s *Project) GetColor() ProjectColor {
+	return s.Color
+}
+
+// GetIsArchived returns the value of IsArchived.
+func (s *Project) GetIsArchived() bool {
+	return s.IsArchived
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Project) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Project) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Project) SetID(val string) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Project) SetName(val string) {
+	s.Name = val
+}
+
+// SetColor sets the value of Color.
+func (s *Project) SetColor(val ProjectColor) {
+	s.Color = val
+}
+
+// SetIsArchived sets the value of IsArchived.
+func (s *Project) SetIsArchived(val bool) {
+	s.IsArchived = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Project) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Project) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+type ProjectColor string
+
+const (
+	ProjectColorBlue    ProjectColor = "blue"
+	ProjectColorBrown   ProjectColor = "brown"
+	ProjectColorDefault ProjectColor = "default"
+	ProjectColorGray    ProjectColor = "gray"
+	ProjectColorGreen   ProjectColor = "green"
+	ProjectColorOrange  ProjectColor = "orange"
+	ProjectColorPink    ProjectColor = "pink"
+	ProjectColorPurple  ProjectColor = "purple"
+	ProjectColorRed     ProjectColor = "red"
+	ProjectColorYellow  ProjectColor = "yellow"
+)
+
+// AllValues returns all ProjectColor values.
+func (ProjectColor) AllValues() []ProjectColor {
+	return []ProjectColor{
+		ProjectColorBlue,
+		ProjectColorBrown,
+		ProjectColorDefault,
+		ProjectColorGray,
+		ProjectColorGreen,
+		ProjectColorOrange,
+		ProjectColorPink,
+		ProjectColorPurple,
+		ProjectColorRed,
+		ProjectColorYellow,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilDate) Or(d time.Time) time.Time {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s ProjectColor) MarshalText() ([]byte, error) {
+	switch s {
+	case ProjectColorBlue:
+		return []byte(s), nil
+	case ProjectColorBrown:
+		return []byte(s), nil
+	case ProjectColorDefault:
+		return []byte(s), nil
+	case ProjectColorGray:
+		return []byte(s), nil
+	case ProjectColorGreen:
+		return []byte(s), nil
+	case ProjectColorOrange:
+		return []byte(s), nil
+	case ProjectColorPink:
+		return []byte(s), nil
+	case ProjectColorPurple:
+		return []byte(s), nil
+	case ProjectColorRed:
+		return []byte(s), nil
+	case ProjectColorYellow:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// NewOptNilDateTime returns new OptNilDateTime with value set to v.
-func NewOptNilDateTime(v time.Time) OptNilDateTime {
-	return OptNilDateTime{
-		Value: v,
-		Set:   true,
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ProjectColor) UnmarshalText(data []byte) error {
+	switch ProjectColor(data) {
+	case ProjectColorBlue:
+		*s = ProjectColorBlue
+		return nil
+	case ProjectColorBrown:
+		*s = ProjectColorBrown
+		return nil
+	case ProjectColorDefault:
+		*s = ProjectColorDefault
+		return nil
+	case ProjectColorGray:
+		*s = ProjectColorGray
+		return nil
+	case ProjectColorGreen:
+		*s = ProjectColorGreen
+		return nil
+	case ProjectColorOrange:
+		*s = ProjectColorOrange
+		return nil
+	case ProjectColorPink:
+		*s = ProjectColorPink
+		return nil
+	case ProjectColorPurple:
+		*s = ProjectColorPurple
+		return nil
+	case ProjectColorRed:
+		*s = ProjectColorRed
+		return nil
+	case ProjectColorYellow:
+		*s = ProjectColorYellow
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// OptNilDateTime is optional nullable time.Time.
-type OptNilDateTime struct {
-	Value time.Time
-	Set   bool
-	Null  bool
+// ProjectHeaders wraps Project with response headers.
+type ProjectHeaders struct {
+	ETag     string
+	Response Project
 }
 
-// IsSet returns true if OptNilDateTime was set.
-func (o OptNilDateTime) IsSet() bool { return o.Set }
+// GetETag returns the value of ETag.
+func (s *ProjectHeaders) GetETag() string {
+	return s.ETag
+}
 
-// Reset unsets value.
-func (o *OptNilDateTime) Reset() {
-	var v time.Time
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// GetResponse returns the value of Response.
+func (s *ProjectHeaders) GetResponse() Project {
+	return s.Response
 }
 
-// SetTo sets value to v.
-func (o *OptNilDateTime) SetTo(v time.Time) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// SetETag sets the value of ETag.
+func (s *ProjectHeaders) SetETag(val string) {
+	s.ETag = val
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilDateTime) IsNull() bool { return o.Null }
+// SetResponse sets the value of Response.
+func (s *ProjectHeaders) SetResponse(val Project) {
+	s.Response = val
+}
 
-// SetToNull sets value to null.
-func (o *OptNilDateTime) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v time.Time
-	o.Value = v
+func (*ProjectHeaders) getProjectRes() {}
+
+// Ref: #/components/schemas/projectInvitation
+type ProjectInvitation struct {
+	ID          string                `json:"id"`
+	ProjectID   string                `json:"project_id"`
+	ProjectName string                `json:"project_name"`
+	Email       string                `json:"email"`
+	Role        ProjectInvitationRole `json:"role"`
+	CreatedAt   time.Time             `json:"created_at"`
 }
 
-// IsEmpty returns true if the field was omitted from the payload (not Set and not Null).
-func (o OptNilDateTime) IsEmpty() bool {
-	return !o.Set && !o.Null
+// GetID returns the value of ID.
+func (s *ProjectInvitation) GetID() string {
+	return s.ID
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilDateTime) Get() (v time.Time, ok bool) {
-	if o.Null {
-		return v, false
+// GetProjectID returns the value of ProjectID.
+func (s *ProjectInvitation) GetProjectID() string {
+	return s.ProjectID
+}
+
+// GetProjectName returns the value of ProjectName.
+func (s *ProjectInvitation) GetProjectName() string {
+	return s.ProjectName
+}
+
+// GetEmail returns the value of Email.
+func (s *ProjectInvitation) GetEmail() string {
+	return s.Email
+}
+
+// GetRole returns the value of Role.
+func (s *ProjectInvitation) GetRole() ProjectInvitationRole {
+	return s.Role
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ProjectInvitation) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *ProjectInvitation) SetID(val string) {
+	s.ID = val
+}
+
+// SetProjectID sets the value of ProjectID.
+func (s *ProjectInvitation) SetProjectID(val string) {
+	s.ProjectID = val
+}
+
+// SetProjectName sets the value of ProjectName.
+func (s *ProjectInvitation) SetProjectName(val string) {
+	s.ProjectName = val
+}
+
+// SetEmail sets the value of Email.
+func (s *ProjectInvitation) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetRole sets the value of Role.
+func (s *ProjectInvitation) SetRole(val ProjectInvitationRole) {
+	s.Role = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ProjectInvitation) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+type ProjectInvitationRole string
+
+const (
+	ProjectInvitationRoleEditor ProjectInvitationRole = "editor"
+	ProjectInvitationRoleViewer ProjectInvitationRole = "viewer"
+)
+
+// AllValues returns all ProjectInvitationRole values.
+func (ProjectInvitationRole) AllValues() []ProjectInvitationRole {
+	return []ProjectInvitationRole{
+		ProjectInvitationRoleEditor,
+		ProjectInvitationRoleViewer,
 	}
-	if !o.Set {
-		return v, false
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ProjectInvitationRole) MarshalText() ([]byte, error) {
+	switch s {
+	case ProjectInvitationRoleEditor:
+		return []byte(s), nil
+	case ProjectInvitationRoleViewer:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilDateTime) Or(d time.Time) time.Time {
-	if v, ok := o.Get(); ok {
-		return v
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ProjectInvitationRole) UnmarshalText(data []byte) error {
+	switch ProjectInvitationRole(data) {
+	case ProjectInvitationRoleEditor:
+		*s = ProjectInvitationRoleEditor
+		return nil
+	case ProjectInvitationRoleViewer:
+		*s = ProjectInvitationRoleViewer
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
-	return d
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
-		Value: v,
-		Set:   true,
+// Ref: #/components/schemas/projectMember
+type ProjectMember struct {
+	UserID    string            `json:"user_id"`
+	Email     string            `json:"email"`
+	Role      ProjectMemberRole `json:"role"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+// GetUserID returns the value of UserID.
+func (s *ProjectMember) GetUserID() string {
+	return s.UserID
+}
+
+// GetEmail returns the value of Email.
+func (s *ProjectMember) GetEmail() string {
+	return s.Email
+}
+
+// GetRole returns the value of Role.
+func (s *ProjectMember) GetRole() ProjectMemberRole {
+	return s.Role
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ProjectMember) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetUserID sets the value of UserID.
+func (s *ProjectMember) SetUserID(val string) {
+	s.UserID = val
+}
+
+// SetEmail sets the value of Email.
+func (s *ProjectMember) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetRole sets the value of Role.
+func (s *ProjectMember) SetRole(val ProjectMemberRole) {
+	s.Role = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ProjectMember) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+type ProjectMemberRole string
+
+const (
+	ProjectMemberRoleOwner  ProjectMemberRole = "owner"
+	ProjectMemberRoleEditor ProjectMemberRole = "editor"
+	ProjectMemberRoleViewer ProjectMemberRole = "viewer"
+)
+
+// AllValues returns all ProjectMemberRole values.
+func (ProjectMemberRole) AllValues() []ProjectMemberRole {
+	return []ProjectMemberRole{
+		ProjectMemberRoleOwner,
+		ProjectMemberRoleEditor,
+		ProjectMemberRoleViewer,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ProjectMemberRole) MarshalText() ([]byte, error) {
+	switch s {
+	case ProjectMemberRoleOwner:
+		return []byte(s), nil
+	case ProjectMemberRoleEditor:
+		return []byte(s), nil
+	case ProjectMemberRoleViewer:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ProjectMemberRole) UnmarshalText(data []byte) error {
+	switch ProjectMemberRole(data) {
+	case ProjectMemberRoleOwner:
+		*s = ProjectMemberRoleOwner
+		return nil
+	case ProjectMemberRoleEditor:
+		*s = ProjectMemberRoleEditor
+		return nil
+	case ProjectMemberRoleViewer:
+		*s = ProjectMemberRoleViewer
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
-	Set   bool
+type RefreshTokenOK struct {
+	IDToken      string `json:"id_token"`
+	RefreshToken string `json:"refresh_token"`
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// GetIDToken returns the value of IDToken.
+func (s *RefreshTokenOK) GetIDToken() string {
+	return s.IDToken
+}
 
-// Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
+// GetRefreshToken returns the value of RefreshToken.
+func (s *RefreshTokenOK) GetRefreshToken() string {
+	return s.RefreshToken
 }
 
-// SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
-	o.Set = true
-	o.Value = v
+// SetIDToken sets the value of IDToken.
+func (s *RefreshTokenOK) SetIDToken(val string) {
+	s.IDToken = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetRefreshToken sets the value of RefreshToken.
+func (s *RefreshTokenOK) SetRefreshToken(val string) {
+	s.RefreshToken = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+type RefreshTokenReq struct {
+	RefreshToken string `json:"refresh_token"`
 }
 
-// NewOptUpdateProjectReqColor returns new OptUpdateProjectReqColor with value set to v.
-func NewOptUpdateProjectReqColor(v UpdateProjectReqColor) OptUpdateProjectReqColor {
-	return OptUpdateProjectReqColor{
-		Value: v,
-		Set:   true,
-	}
+// GetRefreshToken returns the value of RefreshToken.
+func (s *RefreshTokenReq) GetRefreshToken() string {
+	return s.RefreshToken
 }
 
-// OptUpdateProjectReqColor is optional UpdateProjectReqColor.
-type OptUpdateProjectReqColor struct {
-	Value UpdateProjectReqColor
-	Set   bool
+// SetRefreshToken sets the value of RefreshToken.
+func (s *RefreshTokenReq) SetRefreshToken(val string) {
+	s.RefreshToken = val
 }
 
-// IsSet returns true if OptUpdateProjectReqColor was set.
-func (o OptUpdateProjectReqColor) IsSet() bool { return o.Set }
+// RequestPasswordResetOK is response for RequestPasswordReset operation.
+type RequestPasswordResetOK struct{}
 
-// Reset unsets value.
-func (o *OptUpdateProjectReqColor) Reset() {
-	var v UpdateProjectReqColor
-	o.Value = v
-	o.Set = false
+type RequestPasswordResetReq struct {
+	Email string `json:"email"`
 }
 
-// SetTo sets value to v.
-func (o *OptUpdateProjectReqColor) SetTo(v UpdateProjectReqColor) {
-	o.Set = true
-	o.Value = v
+// GetEmail returns the value of Email.
+func (s *RequestPasswordResetReq) GetEmail() string {
+	return s.Email
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptUpdateProjectReqColor) Get() (v UpdateProjectReqColor, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetEmail sets the value of Email.
+func (s *RequestPasswordResetReq) SetEmail(val string) {
+	s.Email = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptUpdateProjectReqColor) Or(d UpdateProjectReqColor) UpdateProjectReqColor {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// Ref: #/components/schemas/searchResult
+type SearchResult struct {
+	Type SearchResultType `json:"type"`
+	ID   string           `json:"id"`
+	// プロジェクト・タスク・ステップの場合に、そのプロジェクトのID.
+	ProjectID OptString `json:"project_id"`
+	// ステップの場合に、そのタスクのID.
+	TaskID OptString `json:"task_id"`
+	Name   string    `json:"name"`
+	// 検索語を含む部分を切り出したHTMLであり、検索語をタグで囲む.
+	Snippet string `json:"snippet"`
 }
 
-// Ref: #/components/schemas/project
-type Project struct {
-	ID         string       `json:"id"`
-	Name       string       `json:"name"`
-	Color      ProjectColor `json:"color"`
-	IsArchived bool         `json:"is_archived"`
-	CreatedAt  time.Time    `json:"created_at"`
-	UpdatedAt  time.Time    `json:"updated_at"`
+// GetType returns the value of Type.
+func (s *SearchResult) GetType() SearchResultType {
+	return s.Type
 }
 
 // GetID returns the value of ID.
-func (s *Project) GetID() string {
+func (s *SearchResult) GetID() string {
 	return s.ID
 }
 
-// GetName returns the value of Name.
-func (s *Project) GetName() string {
-	return s.Name
+// GetProjectID returns the value of ProjectID.
+func (s *SearchResult) GetProjectID() OptString {
+	return s.ProjectID
 }
 
-// GetColor returns the value of Color.
-func (s *Project) GetColor() ProjectColor {
-	return s.Color
+// GetTaskID returns the value of TaskID.
+func (s *SearchResult) GetTaskID() OptString {
+	return s.TaskID
 }
 
-// GetIsArchived returns the value of IsArchived.
-func (s *Project) GetIsArchived() bool {
-	return s.IsArchived
+// GetName returns the value of Name.
+func (s *SearchResult) GetName() string {
+	return s.Name
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *Project) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// GetSnippet returns the value of Snippet.
+func (s *SearchResult) GetSnippet() string {
+	return s.Snippet
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *Project) GetUpdatedAt() time.Time {
-	return s.UpdatedAt
+// SetType sets the value of Type.
+func (s *SearchResult) SetType(val SearchResultType) {
+	s.Type = val
 }
 
 // SetID sets the value of ID.
-func (s *Project) SetID(val string) {
+func (s *SearchResult) SetID(val string) {
 	s.ID = val
 }
 
-// SetName sets the value of Name.
-func (s *Project) SetName(val string) {
-	s.Name = val
-}
-
-// SetColor sets the value of Color.
-func (s *Project) SetColor(val ProjectColor) {
-	s.Color = val
+// SetProjectID sets the value of ProjectID.
+func (s *SearchResult) SetProjectID(val OptString) {
+	s.ProjectID = val
 }
 
-// SetIsArchived sets the value of IsArchived.
-func (s *Project) SetIsArchived(val bool) {
-	s.IsArchived = val
+// SetTaskID sets the value of TaskID.
+func (s *SearchResult) SetTaskID(val OptString) {
+	s.TaskID = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *Project) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+// SetName sets the value of Name.
+func (s *SearchResult) SetName(val string) {
+	s.Name = val
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *Project) SetUpdatedAt(val time.Time) {
-	s.UpdatedAt = val
+// SetSnippet sets the value of Snippet.
+func (s *SearchResult) SetSnippet(val string) {
+	s.Snippet = val
 }
 
-type ProjectColor string
+type SearchResultType string
 
 const (
-	ProjectColorBlue    ProjectColor = "blue"
-	ProjectColorBrown   ProjectColor = "brown"
-	ProjectColorDefault ProjectColor = "default"
-	ProjectColorGray    ProjectColor = "gray"
-	ProjectColorGreen   ProjectColor = "green"
-	ProjectColorOrange  ProjectColor = "orange"
-	ProjectColorPink    ProjectColor = "pink"
-	ProjectColorPurple  ProjectColor = "purple"
-	ProjectColorRed     ProjectColor = "red"
-	ProjectColorYellow  ProjectColor = "yellow"
+	SearchResultTypeProject SearchResultType = "project"
+	SearchResultTypeTask    SearchResultType = "task"
+	SearchResultTypeStep    SearchResultType = "step"
+	SearchResultTypeTag     SearchResultType = "tag"
 )
 
-// AllValues returns all ProjectColor values.
-func (ProjectColor) AllValues() []ProjectColor {
-	return []ProjectColor{
-		ProjectColorBlue,
-		ProjectColorBrown,
-		ProjectColorDefault,
-		ProjectColorGray,
-		ProjectColorGreen,
-		ProjectColorOrange,
-		ProjectColorPink,
-		ProjectColorPurple,
-		ProjectColorRed,
-		ProjectColorYellow,
+// AllValues returns all SearchResultType values.
+func (SearchResultType) AllValues() []SearchResultType {
+	return []SearchResultType{
+		SearchResultTypeProject,
+		SearchResultTypeTask,
+		SearchResultTypeStep,
+		SearchResultTypeTag,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s ProjectColor) MarshalText() ([]byte, error) {
+func (s SearchResultType) MarshalText() ([]byte, error) {
 	switch s {
-	case ProjectColorBlue:
-		return []byte(s), nil
-	case ProjectColorBrown:
-		return []byte(s), nil
-	case ProjectColorDefault:
-		return []byte(s), nil
-	case ProjectColorGray:
-		return []byte(s), nil
-	case ProjectColorGreen:
-		return []byte(s), nil
-	case ProjectColorOrange:
-		return []byte(s), nil
-	case ProjectColorPink:
+	case SearchResultTypeProject:
 		return []byte(s), nil
-	case ProjectColorPurple:
+	case SearchResultTypeTask:
 		return []byte(s), nil
-	case ProjectColorRed:
+	case SearchResultTypeStep:
 		return []byte(s), nil
-	case ProjectColorYellow:
+	case SearchResultTypeTag:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -897,84 +3310,217 @@ func (s ProjectColor) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *ProjectColor) UnmarshalText(data []byte) error {
-	switch ProjectColor(data) {
-	case ProjectColorBlue:
-		*s = ProjectColorBlue
-		return nil
-	case ProjectColorBrown:
-		*s = ProjectColorBrown
-		return nil
-	case ProjectColorDefault:
-		*s = ProjectColorDefault
-		return nil
-	case ProjectColorGray:
-		*s = ProjectColorGray
-		return nil
-	case ProjectColorGreen:
-		*s = ProjectColorGreen
-		return nil
-	case ProjectColorOrange:
-		*s = ProjectColorOrange
-		return nil
-	case ProjectColorPink:
-		*s = ProjectColorPink
+func (s *SearchResultType) UnmarshalText(data []byte) error {
+	switch SearchResultType(data) {
+	case SearchResultTypeProject:
+		*s = SearchResultTypeProject
 		return nil
-	case ProjectColorPurple:
-		*s = ProjectColorPurple
+	case SearchResultTypeTask:
+		*s = SearchResultTypeTask
 		return nil
-	case ProjectColorRed:
-		*s = ProjectColorRed
+	case SearchResultTypeStep:
+		*s = SearchResultTypeStep
 		return nil
-	case ProjectColorYellow:
-		*s = ProjectColorYellow
+	case SearchResultTypeTag:
+		*s = SearchResultTypeTag
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-type SignInOK struct {
-	IDToken string `json:"id_token"`
+type SignInOK struct {
+	IDToken        OptString `json:"id_token"`
+	RefreshToken   OptString `json:"refresh_token"`
+	ChallengeToken OptString `json:"challenge_token"`
+}
+
+// GetIDToken returns the value of IDToken.
+func (s *SignInOK) GetIDToken() OptString {
+	return s.IDToken
+}
+
+// GetRefreshToken returns the value of RefreshToken.
+func (s *SignInOK) GetRefreshToken() OptString {
+	return s.RefreshToken
+}
+
+// GetChallengeToken returns the value of ChallengeToken.
+func (s *SignInOK) GetChallengeToken() OptString {
+	return s.ChallengeToken
+}
+
+// SetIDToken sets the value of IDToken.
+func (s *SignInOK) SetIDToken(val OptString) {
+	s.IDToken = val
+}
+
+// SetRefreshToken sets the value of RefreshToken.
+func (s *SignInOK) SetRefreshToken(val OptString) {
+	s.RefreshToken = val
+}
+
+// SetChallengeToken sets the value of ChallengeToken.
+func (s *SignInOK) SetChallengeToken(val OptString) {
+	s.ChallengeToken = val
+}
+
+type SignInReq struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// GetEmail returns the value of Email.
+func (s *SignInReq) GetEmail() string {
+	return s.Email
+}
+
+// GetPassword returns the value of Password.
+func (s *SignInReq) GetPassword() string {
+	return s.Password
+}
+
+// SetEmail sets the value of Email.
+func (s *SignInReq) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetPassword sets the value of Password.
+func (s *SignInReq) SetPassword(val string) {
+	s.Password = val
+}
+
+type SignInWithOIDCOK struct {
+	IDToken        OptString `json:"id_token"`
+	RefreshToken   OptString `json:"refresh_token"`
+	ChallengeToken OptString `json:"challenge_token"`
+}
+
+// GetIDToken returns the value of IDToken.
+func (s *SignInWithOIDCOK) GetIDToken() OptString {
+	return s.IDToken
+}
+
+// GetRefreshToken returns the value of RefreshToken.
+func (s *SignInWithOIDCOK) GetRefreshToken() OptString {
+	return s.RefreshToken
+}
+
+// GetChallengeToken returns the value of ChallengeToken.
+func (s *SignInWithOIDCOK) GetChallengeToken() OptString {
+	return s.ChallengeToken
+}
+
+// SetIDToken sets the value of IDToken.
+func (s *SignInWithOIDCOK) SetIDToken(val OptString) {
+	s.IDToken = val
+}
+
+// SetRefreshToken sets the value of RefreshToken.
+func (s *SignInWithOIDCOK) SetRefreshToken(val OptString) {
+	s.RefreshToken = val
+}
+
+// SetChallengeToken sets the value of ChallengeToken.
+func (s *SignInWithOIDCOK) SetChallengeToken(val OptString) {
+	s.ChallengeToken = val
+}
+
+type SignInWithOIDCReq struct {
+	State string `json:"state"`
+	Code  string `json:"code"`
+}
+
+// GetState returns the value of State.
+func (s *SignInWithOIDCReq) GetState() string {
+	return s.State
+}
+
+// GetCode returns the value of Code.
+func (s *SignInWithOIDCReq) GetCode() string {
+	return s.Code
+}
+
+// SetState sets the value of State.
+func (s *SignInWithOIDCReq) SetState(val string) {
+	s.State = val
+}
+
+// SetCode sets the value of Code.
+func (s *SignInWithOIDCReq) SetCode(val string) {
+	s.Code = val
+}
+
+type SignInWithTwoFactorOK struct {
+	IDToken      string `json:"id_token"`
+	RefreshToken string `json:"refresh_token"`
 }
 
 // GetIDToken returns the value of IDToken.
-func (s *SignInOK) GetIDToken() string {
+func (s *SignInWithTwoFactorOK) GetIDToken() string {
 	return s.IDToken
 }
 
+// GetRefreshToken returns the value of RefreshToken.
+func (s *SignInWithTwoFactorOK) GetRefreshToken() string {
+	return s.RefreshToken
+}
+
 // SetIDToken sets the value of IDToken.
-func (s *SignInOK) SetIDToken(val string) {
+func (s *SignInWithTwoFactorOK) SetIDToken(val string) {
 	s.IDToken = val
 }
 
-type SignInReq struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+// SetRefreshToken sets the value of RefreshToken.
+func (s *SignInWithTwoFactorOK) SetRefreshToken(val string) {
+	s.RefreshToken = val
 }
 
-// GetEmail returns the value of Email.
-func (s *SignInReq) GetEmail() string {
-	return s.Email
+type SignInWithTwoFactorReq struct {
+	ChallengeToken string `json:"challenge_token"`
+	Code           string `json:"code"`
 }
 
-// GetPassword returns the value of Password.
-func (s *SignInReq) GetPassword() string {
-	return s.Password
+// GetChallengeToken returns the value of ChallengeToken.
+func (s *SignInWithTwoFactorReq) GetChallengeToken() string {
+	return s.ChallengeToken
 }
 
-// SetEmail sets the value of Email.
-func (s *SignInReq) SetEmail(val string) {
-	s.Email = val
+// GetCode returns the value of Code.
+func (s *SignInWithTwoFactorReq) GetCode() string {
+	return s.Code
 }
 
-// SetPassword sets the value of Password.
-func (s *SignInReq) SetPassword(val string) {
-	s.Password = val
+// SetChallengeToken sets the value of ChallengeToken.
+func (s *SignInWithTwoFactorReq) SetChallengeToken(val string) {
+	s.ChallengeToken = val
+}
+
+// SetCode sets the value of Code.
+func (s *SignInWithTwoFactorReq) SetCode(val string) {
+	s.Code = val
+}
+
+// SignOutOK is response for SignOut operation.
+type SignOutOK struct{}
+
+type SignOutReq struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// GetRefreshToken returns the value of RefreshToken.
+func (s *SignOutReq) GetRefreshToken() string {
+	return s.RefreshToken
+}
+
+// SetRefreshToken sets the value of RefreshToken.
+func (s *SignOutReq) SetRefreshToken(val string) {
+	s.RefreshToken = val
 }
 
 type SignUpOK struct {
-	IDToken string `json:"id_token"`
+	IDToken      string `json:"id_token"`
+	RefreshToken string `json:"refresh_token"`
 }
 
 // GetIDToken returns the value of IDToken.
@@ -982,11 +3528,21 @@ func (s *SignUpOK) GetIDToken() string {
 	return s.IDToken
 }
 
+// GetRefreshToken returns the value of RefreshToken.
+func (s *SignUpOK) GetRefreshToken() string {
+	return s.RefreshToken
+}
+
 // SetIDToken sets the value of IDToken.
 func (s *SignUpOK) SetIDToken(val string) {
 	s.IDToken = val
 }
 
+// SetRefreshToken sets the value of RefreshToken.
+func (s *SignUpOK) SetRefreshToken(val string) {
+	s.RefreshToken = val
+}
+
 type SignUpReq struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
@@ -1012,6 +3568,31 @@ func (s *SignUpReq) SetPassword(val string) {
 	s.Password = val
 }
 
+type StartOIDCSignInOK struct {
+	AuthorizationURL string `json:"authorization_url"`
+	State            string `json:"state"`
+}
+
+// GetAuthorizationURL returns the value of AuthorizationURL.
+func (s *StartOIDCSignInOK) GetAuthorizationURL() string {
+	return s.AuthorizationURL
+}
+
+// GetState returns the value of State.
+func (s *StartOIDCSignInOK) GetState() string {
+	return s.State
+}
+
+// SetAuthorizationURL sets the value of AuthorizationURL.
+func (s *StartOIDCSignInOK) SetAuthorizationURL(val string) {
+	s.AuthorizationURL = val
+}
+
+// SetState sets the value of State.
+func (s *StartOIDCSignInOK) SetState(val string) {
+	s.State = val
+}
+
 // Ref: #/components/schemas/step
 type Step struct {
 	ID          string      `json:"id"`
@@ -1082,6 +3663,32 @@ func (s *Step) SetUpdatedAt(val time.Time) {
 	s.UpdatedAt = val
 }
 
+// StepHeaders wraps Step with response headers.
+type StepHeaders struct {
+	ETag     string
+	Response Step
+}
+
+// GetETag returns the value of ETag.
+func (s *StepHeaders) GetETag() string {
+	return s.ETag
+}
+
+// GetResponse returns the value of Response.
+func (s *StepHeaders) GetResponse() Step {
+	return s.Response
+}
+
+// SetETag sets the value of ETag.
+func (s *StepHeaders) SetETag(val string) {
+	s.ETag = val
+}
+
+// SetResponse sets the value of Response.
+func (s *StepHeaders) SetResponse(val Step) {
+	s.Response = val
+}
+
 // Ref: #/components/schemas/tag
 type Tag struct {
 	ID        string    `json:"id"`
@@ -1105,154 +3712,519 @@ func (s *Tag) GetCreatedAt() time.Time {
 	return s.CreatedAt
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *Tag) GetUpdatedAt() time.Time {
-	return s.UpdatedAt
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Tag) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Tag) SetID(val string) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Tag) SetName(val string) {
+	s.Name = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Tag) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Tag) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+// TagHeaders wraps Tag with response headers.
+type TagHeaders struct {
+	ETag     string
+	Response Tag
+}
+
+// GetETag returns the value of ETag.
+func (s *TagHeaders) GetETag() string {
+	return s.ETag
+}
+
+// GetResponse returns the value of Response.
+func (s *TagHeaders) GetResponse() Tag {
+	return s.Response
+}
+
+// SetETag sets the value of ETag.
+func (s *TagHeaders) SetETag(val string) {
+	s.ETag = val
+}
+
+// SetResponse sets the value of Response.
+func (s *TagHeaders) SetResponse(val Tag) {
+	s.Response = val
+}
+
+func (*TagHeaders) getTagRes() {}
+
+// Ref: #/components/schemas/task
+type Task struct {
+	ID         string    `json:"id"`
+	ProjectID  string    `json:"project_id"`
+	AssigneeID OptString `json:"assignee_id"`
+	Name       string    `json:"name"`
+	Content    string    `json:"content"`
+	Priority   int       `json:"priority"`
+	DueOn      OptDate   `json:"due_on"`
+	Recurrence OptString `json:"recurrence"`
+	// 繰り返しタスクを完了したときに作成した次回分のタスクのIDであり、作成していない場合は含まない.
+	NextOccurrenceID OptString   `json:"next_occurrence_id"`
+	CompletedAt      OptDateTime `json:"completed_at"`
+	CreatedAt        time.Time   `json:"created_at"`
+	UpdatedAt        time.Time   `json:"updated_at"`
+	Steps            []Step      `json:"steps"`
+	Tags             []Tag       `json:"tags"`
+	CommentCount     int         `json:"comment_count"`
+}
+
+// GetID returns the value of ID.
+func (s *Task) GetID() string {
+	return s.ID
+}
+
+// GetProjectID returns the value of ProjectID.
+func (s *Task) GetProjectID() string {
+	return s.ProjectID
+}
+
+// GetAssigneeID returns the value of AssigneeID.
+func (s *Task) GetAssigneeID() OptString {
+	return s.AssigneeID
+}
+
+// GetName returns the value of Name.
+func (s *Task) GetName() string {
+	return s.Name
+}
+
+// GetContent returns the value of Content.
+func (s *Task) GetContent() string {
+	return s.Content
+}
+
+// GetPriority returns the value of Priority.
+func (s *Task) GetPriority() int {
+	return s.Priority
+}
+
+// GetDueOn returns the value of DueOn.
+func (s *Task) GetDueOn() OptDate {
+	return s.DueOn
+}
+
+// GetRecurrence returns the value of Recurrence.
+func (s *Task) GetRecurrence() OptString {
+	return s.Recurrence
+}
+
+// GetNextOccurrenceID returns the value of NextOccurrenceID.
+func (s *Task) GetNextOccurrenceID() OptString {
+	return s.NextOccurrenceID
+}
+
+// GetCompletedAt returns the value of CompletedAt.
+func (s *Task) GetCompletedAt() OptDateTime {
+	return s.CompletedAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Task) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Task) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// GetSteps returns the value of Steps.
+func (s *Task) GetSteps() []Step {
+	return s.Steps
+}
+
+// GetTags returns the value of Tags.
+func (s *Task) GetTags() []Tag {
+	return s.Tags
+}
+
+// GetCommentCount returns the value of CommentCount.
+func (s *Task) GetCommentCount() int {
+	return s.CommentCount
+}
+
+// SetID sets the value of ID.
+func (s *Task) SetID(val string) {
+	s.ID = val
+}
+
+// SetProjectID sets the value of ProjectID.
+func (s *Task) SetProjectID(val string) {
+	s.ProjectID = val
+}
+
+// SetAssigneeID sets the value of AssigneeID.
+func (s *Task) SetAssigneeID(val OptString) {
+	s.AssigneeID = val
+}
+
+// SetName sets the value of Name.
+func (s *Task) SetName(val string) {
+	s.Name = val
+}
+
+// SetContent sets the value of Content.
+func (s *Task) SetContent(val string) {
+	s.Content = val
+}
+
+// SetPriority sets the value of Priority.
+func (s *Task) SetPriority(val int) {
+	s.Priority = val
+}
+
+// SetDueOn sets the value of DueOn.
+func (s *Task) SetDueOn(val OptDate) {
+	s.DueOn = val
+}
+
+// SetRecurrence sets the value of Recurrence.
+func (s *Task) SetRecurrence(val OptString) {
+	s.Recurrence = val
+}
+
+// SetNextOccurrenceID sets the value of NextOccurrenceID.
+func (s *Task) SetNextOccurrenceID(val OptString) {
+	s.NextOccurrenceID = val
+}
+
+// SetCompletedAt sets the value of CompletedAt.
+func (s *Task) SetCompletedAt(val OptDateTime) {
+	s.CompletedAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Task) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Task) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+// SetSteps sets the value of Steps.
+func (s *Task) SetSteps(val []Step) {
+	s.Steps = val
+}
+
+// SetTags sets the value of Tags.
+func (s *Task) SetTags(val []Tag) {
+	s.Tags = val
+}
+
+// SetCommentCount sets the value of CommentCount.
+func (s *Task) SetCommentCount(val int) {
+	s.CommentCount = val
+}
+
+// TaskHeaders wraps Task with response headers.
+type TaskHeaders struct {
+	ETag     string
+	Response Task
+}
+
+// GetETag returns the value of ETag.
+func (s *TaskHeaders) GetETag() string {
+	return s.ETag
+}
+
+// GetResponse returns the value of Response.
+func (s *TaskHeaders) GetResponse() Task {
+	return s.Response
+}
+
+// SetETag sets the value of ETag.
+func (s *TaskHeaders) SetETag(val string) {
+	s.ETag = val
+}
+
+// SetResponse sets the value of Response.
+func (s *TaskHeaders) SetResponse(val Task) {
+	s.Response = val
+}
+
+func (*TaskHeaders) getTaskRes() {}
+
+// Ref: #/components/schemas/trashItem
+type TrashItem struct {
+	ID   string        `json:"id"`
+	Type TrashItemType `json:"type"`
+	// タスクの場合はプロジェクトのID、ステップの場合はタスクのID.
+	ParentID  OptString `json:"parent_id"`
+	Name      string    `json:"name"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+// GetID returns the value of ID.
+func (s *TrashItem) GetID() string {
+	return s.ID
+}
+
+// GetType returns the value of Type.
+func (s *TrashItem) GetType() TrashItemType {
+	return s.Type
+}
+
+// GetParentID returns the value of ParentID.
+func (s *TrashItem) GetParentID() OptString {
+	return s.ParentID
+}
+
+// GetName returns the value of Name.
+func (s *TrashItem) GetName() string {
+	return s.Name
+}
+
+// GetDeletedAt returns the value of DeletedAt.
+func (s *TrashItem) GetDeletedAt() time.Time {
+	return s.DeletedAt
 }
 
 // SetID sets the value of ID.
-func (s *Tag) SetID(val string) {
+func (s *TrashItem) SetID(val string) {
 	s.ID = val
 }
 
+// SetType sets the value of Type.
+func (s *TrashItem) SetType(val TrashItemType) {
+	s.Type = val
+}
+
+// SetParentID sets the value of ParentID.
+func (s *TrashItem) SetParentID(val OptString) {
+	s.ParentID = val
+}
+
 // SetName sets the value of Name.
-func (s *Tag) SetName(val string) {
+func (s *TrashItem) SetName(val string) {
 	s.Name = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *Tag) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+// SetDeletedAt sets the value of DeletedAt.
+func (s *TrashItem) SetDeletedAt(val time.Time) {
+	s.DeletedAt = val
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *Tag) SetUpdatedAt(val time.Time) {
-	s.UpdatedAt = val
-}
+type TrashItemType string
 
-// Ref: #/components/schemas/task
-type Task struct {
-	ID          string      `json:"id"`
-	ProjectID   string      `json:"project_id"`
-	Name        string      `json:"name"`
-	Content     string      `json:"content"`
-	Priority    int         `json:"priority"`
-	DueOn       OptDate     `json:"due_on"`
-	CompletedAt OptDateTime `json:"completed_at"`
-	CreatedAt   time.Time   `json:"created_at"`
-	UpdatedAt   time.Time   `json:"updated_at"`
-	Steps       []Step      `json:"steps"`
-	Tags        []Tag       `json:"tags"`
+const (
+	TrashItemTypeProject TrashItemType = "project"
+	TrashItemTypeTask    TrashItemType = "task"
+	TrashItemTypeStep    TrashItemType = "step"
+	TrashItemTypeTag     TrashItemType = "tag"
+)
+
+// AllValues returns all TrashItemType values.
+func (TrashItemType) AllValues() []TrashItemType {
+	return []TrashItemType{
+		TrashItemTypeProject,
+		TrashItemTypeTask,
+		TrashItemTypeStep,
+		TrashItemTypeTag,
+	}
 }
 
-// GetID returns the value of ID.
-func (s *Task) GetID() string {
-	return s.ID
+// MarshalText implements encoding.TextMarshaler.
+func (s TrashItemType) MarshalText() ([]byte, error) {
+	switch s {
+	case TrashItemTypeProject:
+		return []byte(s), nil
+	case TrashItemTypeTask:
+		return []byte(s), nil
+	case TrashItemTypeStep:
+		return []byte(s), nil
+	case TrashItemTypeTag:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetProjectID returns the value of ProjectID.
-func (s *Task) GetProjectID() string {
-	return s.ProjectID
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TrashItemType) UnmarshalText(data []byte) error {
+	switch TrashItemType(data) {
+	case TrashItemTypeProject:
+		*s = TrashItemTypeProject
+		return nil
+	case TrashItemTypeTask:
+		*s = TrashItemTypeTask
+		return nil
+	case TrashItemTypeStep:
+		*s = TrashItemTypeStep
+		return nil
+	case TrashItemTypeTag:
+		*s = TrashItemTypeTag
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetName returns the value of Name.
-func (s *Task) GetName() string {
-	return s.Name
+type UpdateCommentReq struct {
+	Content OptString `json:"content" log:"allow"`
 }
 
 // GetContent returns the value of Content.
-func (s *Task) GetContent() string {
+func (s *UpdateCommentReq) GetContent() OptString {
 	return s.Content
 }
 
-// GetPriority returns the value of Priority.
-func (s *Task) GetPriority() int {
-	return s.Priority
+// SetContent sets the value of Content.
+func (s *UpdateCommentReq) SetContent(val OptString) {
+	s.Content = val
 }
 
-// GetDueOn returns the value of DueOn.
-func (s *Task) GetDueOn() OptDate {
-	return s.DueOn
+type UpdateEmailReq struct {
+	Email    string    `json:"email"`
+	Password OptString `json:"password"`
 }
 
-// GetCompletedAt returns the value of CompletedAt.
-func (s *Task) GetCompletedAt() OptDateTime {
-	return s.CompletedAt
+// GetEmail returns the value of Email.
+func (s *UpdateEmailReq) GetEmail() string {
+	return s.Email
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *Task) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// GetPassword returns the value of Password.
+func (s *UpdateEmailReq) GetPassword() OptString {
+	return s.Password
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *Task) GetUpdatedAt() time.Time {
-	return s.UpdatedAt
+// SetEmail sets the value of Email.
+func (s *UpdateEmailReq) SetEmail(val string) {
+	s.Email = val
 }
 
-// GetSteps returns the value of Steps.
-func (s *Task) GetSteps() []Step {
-	return s.Steps
+// SetPassword sets the value of Password.
+func (s *UpdateEmailReq) SetPassword(val OptString) {
+	s.Password = val
 }
 
-// GetTags returns the value of Tags.
-func (s *Task) GetTags() []Tag {
-	return s.Tags
+type UpdateLanguageReq struct {
+	Language NilUpdateLanguageReqLanguage `json:"language"`
 }
 
-// SetID sets the value of ID.
-func (s *Task) SetID(val string) {
-	s.ID = val
+// GetLanguage returns the value of Language.
+func (s *UpdateLanguageReq) GetLanguage() NilUpdateLanguageReqLanguage {
+	return s.Language
 }
 
-// SetProjectID sets the value of ProjectID.
-func (s *Task) SetProjectID(val string) {
-	s.ProjectID = val
+// SetLanguage sets the value of Language.
+func (s *UpdateLanguageReq) SetLanguage(val NilUpdateLanguageReqLanguage) {
+	s.Language = val
 }
 
-// SetName sets the value of Name.
-func (s *Task) SetName(val string) {
-	s.Name = val
+type UpdateLanguageReqLanguage string
+
+const (
+	UpdateLanguageReqLanguageJa UpdateLanguageReqLanguage = "ja"
+	UpdateLanguageReqLanguageEn UpdateLanguageReqLanguage = "en"
+)
+
+// AllValues returns all UpdateLanguageReqLanguage values.
+func (UpdateLanguageReqLanguage) AllValues() []UpdateLanguageReqLanguage {
+	return []UpdateLanguageReqLanguage{
+		UpdateLanguageReqLanguageJa,
+		UpdateLanguageReqLanguageEn,
+	}
 }
 
-// SetContent sets the value of Content.
-func (s *Task) SetContent(val string) {
-	s.Content = val
+// MarshalText implements encoding.TextMarshaler.
+func (s UpdateLanguageReqLanguage) MarshalText() ([]byte, error) {
+	switch s {
+	case UpdateLanguageReqLanguageJa:
+		return []byte(s), nil
+	case UpdateLanguageReqLanguageEn:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetPriority sets the value of Priority.
-func (s *Task) SetPriority(val int) {
-	s.Priority = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UpdateLanguageReqLanguage) UnmarshalText(data []byte) error {
+	switch UpdateLanguageReqLanguage(data) {
+	case UpdateLanguageReqLanguageJa:
+		*s = UpdateLanguageReqLanguageJa
+		return nil
+	case UpdateLanguageReqLanguageEn:
+		*s = UpdateLanguageReqLanguageEn
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetDueOn sets the value of DueOn.
-func (s *Task) SetDueOn(val OptDate) {
-	s.DueOn = val
+type UpdateProjectMemberReq struct {
+	Role UpdateProjectMemberReqRole `json:"role" log:"allow"`
 }
 
-// SetCompletedAt sets the value of CompletedAt.
-func (s *Task) SetCompletedAt(val OptDateTime) {
-	s.CompletedAt = val
+// GetRole returns the value of Role.
+func (s *UpdateProjectMemberReq) GetRole() UpdateProjectMemberReqRole {
+	return s.Role
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *Task) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+// SetRole sets the value of Role.
+func (s *UpdateProjectMemberReq) SetRole(val UpdateProjectMemberReqRole) {
+	s.Role = val
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *Task) SetUpdatedAt(val time.Time) {
-	s.UpdatedAt = val
+type UpdateProjectMemberReqRole string
+
+const (
+	UpdateProjectMemberReqRoleEditor UpdateProjectMemberReqRole = "editor"
+	UpdateProjectMemberReqRoleViewer UpdateProjectMemberReqRole = "viewer"
+)
+
+// AllValues returns all UpdateProjectMemberReqRole values.
+func (UpdateProjectMemberReqRole) AllValues() []UpdateProjectMemberReqRole {
+	return []UpdateProjectMemberReqRole{
+		UpdateProjectMemberReqRoleEditor,
+		UpdateProjectMemberReqRoleViewer,
+	}
 }
 
-// SetSteps sets the value of Steps.
-func (s *Task) SetSteps(val []Step) {
-	s.Steps = val
+// MarshalText implements encoding.TextMarshaler.
+func (s UpdateProjectMemberReqRole) MarshalText() ([]byte, error) {
+	switch s {
+	case UpdateProjectMemberReqRoleEditor:
+		return []byte(s), nil
+	case UpdateProjectMemberReqRoleViewer:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetTags sets the value of Tags.
-func (s *Task) SetTags(val []Tag) {
-	s.Tags = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UpdateProjectMemberReqRole) UnmarshalText(data []byte) error {
+	switch UpdateProjectMemberReqRole(data) {
+	case UpdateProjectMemberReqRoleEditor:
+		*s = UpdateProjectMemberReqRoleEditor
+		return nil
+	case UpdateProjectMemberReqRoleViewer:
+		*s = UpdateProjectMemberReqRoleViewer
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
 type UpdateProjectReq struct {
@@ -1428,14 +4400,27 @@ func (s *UpdateTagReq) SetName(val OptString) {
 }
 
 type UpdateTaskReq struct {
+	ProjectID   OptString      `json:"project_id" log:"allow"`
+	AssigneeID  OptNilString   `json:"assignee_id" log:"allow"`
 	Name        OptString      `json:"name" log:"allow"`
 	TagIds      []string       `json:"tag_ids" log:"allow"`
 	Content     OptString      `json:"content" log:"allow"`
 	Priority    OptInt         `json:"priority" log:"allow"`
 	DueOn       OptNilDate     `json:"due_on" log:"allow"`
+	Recurrence  OptNilString   `json:"recurrence" log:"allow"`
 	CompletedAt OptNilDateTime `json:"completed_at" log:"allow"`
 }
 
+// GetProjectID returns the value of ProjectID.
+func (s *UpdateTaskReq) GetProjectID() OptString {
+	return s.ProjectID
+}
+
+// GetAssigneeID returns the value of AssigneeID.
+func (s *UpdateTaskReq) GetAssigneeID() OptNilString {
+	return s.AssigneeID
+}
+
 // GetName returns the value of Name.
 func (s *UpdateTaskReq) GetName() OptString {
 	return s.Name
@@ -1461,11 +4446,26 @@ func (s *UpdateTaskReq) GetDueOn() OptNilDate {
 	return s.DueOn
 }
 
+// GetRecurrence returns the value of Recurrence.
+func (s *UpdateTaskReq) GetRecurrence() OptNilString {
+	return s.Recurrence
+}
+
 // GetCompletedAt returns the value of CompletedAt.
 func (s *UpdateTaskReq) GetCompletedAt() OptNilDateTime {
 	return s.CompletedAt
 }
 
+// SetProjectID sets the value of ProjectID.
+func (s *UpdateTaskReq) SetProjectID(val OptString) {
+	s.ProjectID = val
+}
+
+// SetAssigneeID sets the value of AssigneeID.
+func (s *UpdateTaskReq) SetAssigneeID(val OptNilString) {
+	s.AssigneeID = val
+}
+
 // SetName sets the value of Name.
 func (s *UpdateTaskReq) SetName(val OptString) {
 	s.Name = val
@@ -1491,7 +4491,165 @@ func (s *UpdateTaskReq) SetDueOn(val OptNilDate) {
 	s.DueOn = val
 }
 
+// SetRecurrence sets the value of Recurrence.
+func (s *UpdateTaskReq) SetRecurrence(val OptNilString) {
+	s.Recurrence = val
+}
+
 // SetCompletedAt sets the value of CompletedAt.
 func (s *UpdateTaskReq) SetCompletedAt(val OptNilDateTime) {
 	s.CompletedAt = val
 }
+
+// Ref: #/components/schemas/user
+type User struct {
+	ID              string      `json:"id"`
+	Email           string      `json:"email"`
+	EmailVerifiedAt OptDateTime `json:"email_verified_at"`
+	// 変更を申請して確認を待っているメールアドレスであり、申請していない場合は含まない.
+	PendingEmail     OptString `json:"pending_email"`
+	TwoFactorEnabled bool      `json:"two_factor_enabled"`
+	// エラーメッセージなどに使う言語であり、設定していない場合は含まない.
+	Language  OptUserLanguage `json:"language"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *User) GetID() string {
+	return s.ID
+}
+
+// GetEmail returns the value of Email.
+func (s *User) GetEmail() string {
+	return s.Email
+}
+
+// GetEmailVerifiedAt returns the value of EmailVerifiedAt.
+func (s *User) GetEmailVerifiedAt() OptDateTime {
+	return s.EmailVerifiedAt
+}
+
+// GetPendingEmail returns the value of PendingEmail.
+func (s *User) GetPendingEmail() OptString {
+	return s.PendingEmail
+}
+
+// GetTwoFactorEnabled returns the value of TwoFactorEnabled.
+func (s *User) GetTwoFactorEnabled() bool {
+	return s.TwoFactorEnabled
+}
+
+// GetLanguage returns the value of Language.
+func (s *User) GetLanguage() OptUserLanguage {
+	return s.Language
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *User) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *User) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *User) SetID(val string) {
+	s.ID = val
+}
+
+// SetEmail sets the value of Email.
+func (s *User) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetEmailVerifiedAt sets the value of EmailVerifiedAt.
+func (s *User) SetEmailVerifiedAt(val OptDateTime) {
+	s.EmailVerifiedAt = val
+}
+
+// SetPendingEmail sets the value of PendingEmail.
+func (s *User) SetPendingEmail(val OptString) {
+	s.PendingEmail = val
+}
+
+// SetTwoFactorEnabled sets the value of TwoFactorEnabled.
+func (s *User) SetTwoFactorEnabled(val bool) {
+	s.TwoFactorEnabled = val
+}
+
+// SetLanguage sets the value of Language.
+func (s *User) SetLanguage(val OptUserLanguage) {
+	s.Language = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *User) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *User) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+// エラーメッセージなどに使う言語であり、設定していない場合は含まない.
+type UserLanguage string
+
+const (
+	UserLanguageJa UserLanguage = "ja"
+	UserLanguageEn UserLanguage = "en"
+)
+
+// AllValues returns all UserLanguage values.
+func (UserLanguage) AllValues() []UserLanguage {
+	return []UserLanguage{
+		UserLanguageJa,
+		UserLanguageEn,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s UserLanguage) MarshalText() ([]byte, error) {
+	switch s {
+	case UserLanguageJa:
+		return []byte(s), nil
+	case UserLanguageEn:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UserLanguage) UnmarshalText(data []byte) error {
+	switch UserLanguage(data) {
+	case UserLanguageJa:
+		*s = UserLanguageJa
+		return nil
+	case UserLanguageEn:
+		*s = UserLanguageEn
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// VerifyEmailOK is response for VerifyEmail operation.
+type VerifyEmailOK struct{}
+
+type VerifyEmailReq struct {
+	Token string `json:"token"`
+}
+
+// GetToken returns the value of Token.
+func (s *VerifyEmailReq) GetToken() string {
+	return s.Token
+}
+
+// SetToken sets the value of Token.
+func (s *VerifyEmailReq) SetToken(val string) {
+	s.Token = val
+}
@@ -34,24 +34,57 @@ func findAuthorization(h http.Header, prefix string) (string, bool) {
 
 // operationRolesBearerAuth is a private map storing roles per operation.
 var operationRolesBearerAuth = map[string][]string{
-	CreateProjectOperation: []string{},
-	CreateStepOperation:    []string{},
-	CreateTagOperation:     []string{},
-	CreateTaskOperation:    []string{},
-	DeleteProjectOperation: []string{},
-	DeleteStepOperation:    []string{},
-	DeleteTagOperation:     []string{},
-	DeleteTaskOperation:    []string{},
-	GetProjectOperation:    []string{},
-	GetTagOperation:        []string{},
-	GetTaskOperation:       []string{},
-	ListProjectsOperation:  []string{},
-	ListTagsOperation:      []string{},
-	ListTasksOperation:     []string{},
-	UpdateProjectOperation: []string{},
-	UpdateStepOperation:    []string{},
-	UpdateTagOperation:     []string{},
-	UpdateTaskOperation:    []string{},
+	AcceptInvitationOperation:          []string{},
+	ChangePasswordOperation:            []string{},
+	ConfirmTOTPOperation:               []string{},
+	CreateCommentOperation:             []string{},
+	CreatePersonalAccessTokenOperation: []string{},
+	CreateProjectOperation:             []string{},
+	CreateStepOperation:                []string{},
+	CreateTagOperation:                 []string{},
+	CreateTaskOperation:                []string{},
+	DeleteAccountOperation:             []string{},
+	DeleteCommentOperation:             []string{},
+	DeleteInvitationOperation:          []string{},
+	DeletePersonalAccessTokenOperation: []string{},
+	DeleteProjectOperation:             []string{},
+	DeleteProjectMemberOperation:       []string{},
+	DeleteStepOperation:                []string{},
+	DeleteTagOperation:                 []string{},
+	DeleteTaskOperation:                []string{},
+	EnrollTOTPOperation:                []string{},
+	GetMeOperation:                     []string{},
+	GetProjectOperation:                []string{},
+	GetTagOperation:                    []string{},
+	GetTaskOperation:                   []string{},
+	InviteProjectMemberOperation:       []string{},
+	ListAssignedTasksOperation:         []string{},
+	ListCommentsOperation:              []string{},
+	ListInvitationsOperation:           []string{},
+	ListOverdueTasksOperation:          []string{},
+	ListPersonalAccessTokensOperation:  []string{},
+	ListProjectHistoryOperation:        []string{},
+	ListProjectMembersOperation:        []string{},
+	ListProjectsOperation:              []string{},
+	ListSearchResultsOperation:         []string{},
+	ListTagsOperation:                  []string{},
+	ListTaskHistoryOperation:           []string{},
+	ListTasksOperation:                 []string{},
+	ListTodayTasksOperation:            []string{},
+	ListTrashOperation:                 []string{},
+	ListUpcomingTasksOperation:         []string{},
+	MoveProjectOperation:               []string{},
+	MoveStepOperation:                  []string{},
+	MoveTaskOperation:                  []string{},
+	RestoreTrashItemOperation:          []string{},
+	UpdateCommentOperation:             []string{},
+	UpdateEmailOperation:               []string{},
+	UpdateLanguageOperation:            []string{},
+	UpdateProjectOperation:             []string{},
+	UpdateProjectMemberOperation:       []string{},
+	UpdateStepOperation:                []string{},
+	UpdateTagOperation:                 []string{},
+	UpdateTaskOperation:                []string{},
 }
 
 // GetRolesForBearerAuth returns the required roles for the given operation.
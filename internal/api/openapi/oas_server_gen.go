@@ -8,14 +8,49 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// AcceptInvitation implements AcceptInvitation operation.
+	//
+	// POST /invitations/{invitationID}:accept
+	AcceptInvitation(ctx context.Context, params AcceptInvitationParams) (*ProjectMember, error)
+	// ChangePassword implements ChangePassword operation.
+	//
+	// 現在のパスワードを確認してパスワードを変更する。パスワードを設定していないユーザは
+	// current_password
+	// の代わりに直近のサインインで本人確認して最初のパスワードを設定する。変更後はすべての端末でサインアウトしてパーソナルアクセストークンをすべて削除し、この端末で使う新しいIDトークンとリフレッシュトークンを返す.
+	//
+	// POST /me/password
+	ChangePassword(ctx context.Context, req *ChangePasswordReq) (*ChangePasswordOK, error)
 	// CheckHealth implements CheckHealth operation.
 	//
 	// GET /health
 	CheckHealth(ctx context.Context) (*CheckHealthOK, error)
+	// ConfirmPasswordReset implements ConfirmPasswordReset operation.
+	//
+	// メールで送ったトークンを使ってパスワードを変更する。変更後はすべての端末でサインアウトし、パーソナルアクセストークンをすべて削除する.
+	//
+	// POST /password-reset/confirm
+	ConfirmPasswordReset(ctx context.Context, req *ConfirmPasswordResetReq) error
+	// ConfirmTOTP implements ConfirmTOTP operation.
+	//
+	// 認証アプリに表示された認証コードを検証して2段階認証を有効にし、リカバリーコードを返す。リカバリーコードはこのレスポンスでのみ確認できる.
+	//
+	// POST /me/totp/confirm
+	ConfirmTOTP(ctx context.Context, req *ConfirmTOTPReq) (*ConfirmTOTPOK, error)
+	// CreateComment implements CreateComment operation.
+	//
+	// POST /tasks/{taskID}/comments
+	CreateComment(ctx context.Context, req *CreateCommentReq, params CreateCommentParams) (*Comment, error)
+	// CreatePersonalAccessToken implements CreatePersonalAccessToken operation.
+	//
+	// パーソナルアクセストークンを作成する。トークンの平文はこのレスポンスでのみ確認できる。expires_in_days
+	// を省略した場合は削除するまで有効である.
+	//
+	// POST /me/tokens
+	CreatePersonalAccessToken(ctx context.Context, req *CreatePersonalAccessTokenReq) (*CreatePersonalAccessTokenOK, error)
 	// CreateProject implements CreateProject operation.
 	//
 	// POST /projects
-	CreateProject(ctx context.Context, req *CreateProjectReq) (*Project, error)
+	CreateProject(ctx context.Context, req *CreateProjectReq, params CreateProjectParams) (*Project, error)
 	// CreateStep implements CreateStep operation.
 	//
 	// POST /tasks/{taskID}/steps
@@ -23,15 +58,44 @@ type Handler interface {
 	// CreateTag implements CreateTag operation.
 	//
 	// POST /tags
-	CreateTag(ctx context.Context, req *CreateTagReq) (*Tag, error)
+	CreateTag(ctx context.Context, req *CreateTagReq, params CreateTagParams) (*Tag, error)
 	// CreateTask implements CreateTask operation.
 	//
 	// POST /projects/{projectID}/tasks
 	CreateTask(ctx context.Context, req *CreateTaskReq, params CreateTaskParams) (*Task, error)
+	// DeleteAccount implements DeleteAccount operation.
+	//
+	// アカウントと、プロジェクトやタグなどのユーザが所有するすべてのデータを削除する。他のユーザのタスクに書いたコメントと操作の履歴は、ユーザを空にして残す。発行済みのIDトークン・リフレッシュトークン・パーソナルアクセストークンはすべて無効になる。パスワードを設定していないユーザは
+	// password の代わりに直近のサインインで本人確認する.
+	//
+	// DELETE /me
+	DeleteAccount(ctx context.Context, req *DeleteAccountReq) error
+	// DeleteComment implements DeleteComment operation.
+	//
+	// DELETE /tasks/{taskID}/comments/{commentID}
+	DeleteComment(ctx context.Context, params DeleteCommentParams) error
+	// DeleteInvitation implements DeleteInvitation operation.
+	//
+	// 招待されたユーザは招待を辞退し、プロジェクトのオーナーは招待を取り消す.
+	//
+	// DELETE /invitations/{invitationID}
+	DeleteInvitation(ctx context.Context, params DeleteInvitationParams) error
+	// DeletePersonalAccessToken implements DeletePersonalAccessToken operation.
+	//
+	// パーソナルアクセストークンを削除し、以降そのトークンによるリクエストを受け付けないようにする.
+	//
+	// DELETE /me/tokens/{tokenID}
+	DeletePersonalAccessToken(ctx context.Context, params DeletePersonalAccessTokenParams) error
 	// DeleteProject implements DeleteProject operation.
 	//
 	// DELETE /projects/{projectID}
 	DeleteProject(ctx context.Context, params DeleteProjectParams) error
+	// DeleteProjectMember implements DeleteProjectMember operation.
+	//
+	// メンバーをプロジェクトから外す。メンバーは自分自身を外すことでプロジェクトから抜けられる.
+	//
+	// DELETE /projects/{projectID}/members/{userID}
+	DeleteProjectMember(ctx context.Context, params DeleteProjectMemberParams) error
 	// DeleteStep implements DeleteStep operation.
 	//
 	// DELETE /steps/{stepID}
@@ -44,54 +108,229 @@ type Handler interface {
 	//
 	// DELETE /tasks/{taskID}
 	DeleteTask(ctx context.Context, params DeleteTaskParams) error
+	// EnrollTOTP implements EnrollTOTP operation.
+	//
+	// 2段階認証の登録を開始し、認証アプリに登録する秘密鍵とotpauth
+	// URIを返す。ConfirmTOTP
+	// で認証コードを確認するまで2段階認証は有効にならない.
+	//
+	// POST /me/totp
+	EnrollTOTP(ctx context.Context) (*EnrollTOTPOK, error)
+	// GetJWKS implements GetJWKS operation.
+	//
+	// IDトークンの検証に使う公開鍵の一覧を返す.
+	//
+	// GET /.well-known/jwks.json
+	GetJWKS(ctx context.Context) (*GetJWKSOK, error)
+	// GetMe implements GetMe operation.
+	//
+	// GET /me
+	GetMe(ctx context.Context) (*User, error)
 	// GetProject implements GetProject operation.
 	//
 	// GET /projects/{projectID}
-	GetProject(ctx context.Context, params GetProjectParams) (*Project, error)
+	GetProject(ctx context.Context, params GetProjectParams) (GetProjectRes, error)
 	// GetTag implements GetTag operation.
 	//
 	// GET /tags/{tagID}
-	GetTag(ctx context.Context, params GetTagParams) (*Tag, error)
+	GetTag(ctx context.Context, params GetTagParams) (GetTagRes, error)
 	// GetTask implements GetTask operation.
 	//
 	// GET /tasks/{taskID}
-	GetTask(ctx context.Context, params GetTaskParams) (*Task, error)
+	GetTask(ctx context.Context, params GetTaskParams) (GetTaskRes, error)
+	// InviteProjectMember implements InviteProjectMember operation.
+	//
+	// メールアドレスを指定してプロジェクトに招待する。招待されたユーザが承諾するとメンバーになる.
+	//
+	// POST /projects/{projectID}/members
+	InviteProjectMember(ctx context.Context, req *InviteProjectMemberReq, params InviteProjectMemberParams) (*ProjectInvitation, error)
+	// ListAssignedTasks implements ListAssignedTasks operation.
+	//
+	// すべてのプロジェクトから自分が担当者である未完了のタスクを期日の昇順で返す.
+	//
+	// GET /me/assigned-tasks
+	ListAssignedTasks(ctx context.Context, params ListAssignedTasksParams) (*ListAssignedTasksOK, error)
+	// ListComments implements ListComments operation.
+	//
+	// タスクのコメントを作成日時の昇順で返す.
+	//
+	// GET /tasks/{taskID}/comments
+	ListComments(ctx context.Context, params ListCommentsParams) (*ListCommentsOK, error)
+	// ListInvitations implements ListInvitations operation.
+	//
+	// 自分のメールアドレス宛ての招待を招待日時の昇順で返す.
+	//
+	// GET /invitations
+	ListInvitations(ctx context.Context) (*ListInvitationsOK, error)
+	// ListOverdueTasks implements ListOverdueTasks operation.
+	//
+	// GET /tasks/overdue
+	ListOverdueTasks(ctx context.Context, params ListOverdueTasksParams) (*ListOverdueTasksOK, error)
+	// ListPersonalAccessTokens implements ListPersonalAccessTokens operation.
+	//
+	// GET /me/tokens
+	ListPersonalAccessTokens(ctx context.Context) (*ListPersonalAccessTokensOK, error)
+	// ListProjectHistory implements ListProjectHistory operation.
+	//
+	// プロジェクトとそのタスクの変更履歴を新しい順に返す.
+	//
+	// GET /projects/{projectID}/history
+	ListProjectHistory(ctx context.Context, params ListProjectHistoryParams) (*ListProjectHistoryOK, error)
+	// ListProjectMembers implements ListProjectMembers operation.
+	//
+	// プロジェクトのメンバーをオーナー、参加日時の昇順の順で返し、招待中のものも合わせて返す.
+	//
+	// GET /projects/{projectID}/members
+	ListProjectMembers(ctx context.Context, params ListProjectMembersParams) (*ListProjectMembersOK, error)
 	// ListProjects implements ListProjects operation.
 	//
 	// GET /projects
 	ListProjects(ctx context.Context, params ListProjectsParams) (*ListProjectsOK, error)
+	// ListSearchResults implements ListSearchResults operation.
+	//
+	// プロジェクト名・タスクの名前と内容・ステップ名・タグ名から、空白で区切ったすべての検索語を含むものを関連度の高い順に返す。
+	// projectIDを指定した場合はプロジェクトとそのタスク・ステップに、tagIDsを指定した場合はタグが付いたタスクとそのステップに絞り込み、いずれの場合もタグは検索しない。.
+	//
+	// GET /search
+	ListSearchResults(ctx context.Context, params ListSearchResultsParams) (*ListSearchResultsOK, error)
 	// ListTags implements ListTags operation.
 	//
 	// GET /tags
 	ListTags(ctx context.Context, params ListTagsParams) (*ListTagsOK, error)
+	// ListTaskHistory implements ListTaskHistory operation.
+	//
+	// タスクとそのステップの変更履歴を新しい順に返す.
+	//
+	// GET /tasks/{taskID}/history
+	ListTaskHistory(ctx context.Context, params ListTaskHistoryParams) (*ListTaskHistoryOK, error)
 	// ListTasks implements ListTasks operation.
 	//
 	// GET /projects/{projectID}/tasks
 	ListTasks(ctx context.Context, params ListTasksParams) (*ListTasksOK, error)
+	// ListTodayTasks implements ListTodayTasks operation.
+	//
+	// GET /tasks/today
+	ListTodayTasks(ctx context.Context, params ListTodayTasksParams) (*ListTodayTasksOK, error)
+	// ListTrash implements ListTrash operation.
+	//
+	// GET /trash
+	ListTrash(ctx context.Context, params ListTrashParams) (*ListTrashOK, error)
+	// ListUpcomingTasks implements ListUpcomingTasks operation.
+	//
+	// GET /tasks/upcoming
+	ListUpcomingTasks(ctx context.Context, params ListUpcomingTasksParams) (*ListUpcomingTasksOK, error)
+	// MoveProject implements MoveProject operation.
+	//
+	// POST /projects/{projectID}:move
+	MoveProject(ctx context.Context, req *MoveProjectReq, params MoveProjectParams) (*Project, error)
+	// MoveStep implements MoveStep operation.
+	//
+	// POST /steps/{stepID}:move
+	MoveStep(ctx context.Context, req *MoveStepReq, params MoveStepParams) (*Step, error)
+	// MoveTask implements MoveTask operation.
+	//
+	// POST /tasks/{taskID}:move
+	MoveTask(ctx context.Context, req *MoveTaskReq, params MoveTaskParams) (*Task, error)
+	// RefreshToken implements RefreshToken operation.
+	//
+	// リフレッシュトークンを使用済みにし、新しいIDトークンとリフレッシュトークンを返す。使用済みのリフレッシュトークンを再び指定した場合は、そのリフレッシュトークンから発行したものを含めてすべて無効にする.
+	//
+	// POST /token/refresh
+	RefreshToken(ctx context.Context, req *RefreshTokenReq) (*RefreshTokenOK, error)
+	// RequestPasswordReset implements RequestPasswordReset operation.
+	//
+	// パスワード再設定用のURLをメールで送る。メールアドレスが登録されていない場合もメールを送らずに成功する.
+	//
+	// POST /password-reset/request
+	RequestPasswordReset(ctx context.Context, req *RequestPasswordResetReq) error
+	// RestoreTrashItem implements RestoreTrashItem operation.
+	//
+	// POST /trash/{itemID}:restore
+	RestoreTrashItem(ctx context.Context, params RestoreTrashItemParams) (*TrashItem, error)
 	// SignIn implements SignIn operation.
 	//
+	// 2段階認証が有効な場合は、IDトークンとリフレッシュトークンの代わりにチャレンジトークンを返す。チャレンジトークンと認証コードを
+	// SignInWithTwoFactor
+	// に渡すとサインインが完了する。同じメールアドレスかIPアドレスからの失敗が続いた場合は、一時的に429を返し、Retry-Afterヘッダで再試行できるまでの秒数を返す.
+	//
 	// POST /sign-in
 	SignIn(ctx context.Context, req *SignInReq) (*SignInOK, error)
+	// SignInWithOIDC implements SignInWithOIDC operation.
+	//
+	// IDプロバイダからのコールバックで受け取った認可コードをIDトークンと交換してサインインする。初めてサインインする外部アカウントの場合は、確認済みの同じメールアドレスのユーザと連携するか、新しいユーザを作成する。2段階認証が有効な場合は
+	// SignIn と同じくチャレンジトークンを返す.
+	//
+	// POST /oidc/callback
+	SignInWithOIDC(ctx context.Context, req *SignInWithOIDCReq) (*SignInWithOIDCOK, error)
+	// SignInWithTwoFactor implements SignInWithTwoFactor operation.
+	//
+	// SignIn
+	// が返したチャレンジトークンと、TOTPの認証コードかリカバリーコードを検証する。チャレンジトークンは検証結果に関わらず1回で使用済みになる.
+	//
+	// POST /sign-in/two-factor
+	SignInWithTwoFactor(ctx context.Context, req *SignInWithTwoFactorReq) (*SignInWithTwoFactorOK, error)
+	// SignOut implements SignOut operation.
+	//
+	// リフレッシュトークンとそのリフレッシュトークンから発行したもの、発行済みのIDトークンをすべて無効にする.
+	//
+	// POST /sign-out
+	SignOut(ctx context.Context, req *SignOutReq) error
 	// SignUp implements SignUp operation.
 	//
 	// POST /sign-up
 	SignUp(ctx context.Context, req *SignUpReq) (*SignUpOK, error)
+	// StartOIDCSignIn implements StartOIDCSignIn operation.
+	//
+	// 外部のIDプロバイダによるサインインを開始し、ユーザを遷移させる認可URLを返す。クライアントは
+	// state を保存し、コールバックで受け取った state
+	// と一致することを確認してから SignInWithOIDC を呼ぶ.
+	//
+	// POST /oidc/authorize
+	StartOIDCSignIn(ctx context.Context) (*StartOIDCSignInOK, error)
+	// UpdateComment implements UpdateComment operation.
+	//
+	// PATCH /tasks/{taskID}/comments/{commentID}
+	UpdateComment(ctx context.Context, req *UpdateCommentReq, params UpdateCommentParams) (*Comment, error)
+	// UpdateEmail implements UpdateEmail operation.
+	//
+	// 新しいメールアドレスに確認用のURLを送る。メールアドレスは確認が完了した時点で変更し、それまでは
+	// pending_email として返す。パスワードを設定していないユーザは password
+	// の代わりに直近のサインインで本人確認する.
+	//
+	// PATCH /me/email
+	UpdateEmail(ctx context.Context, req *UpdateEmailReq) (*User, error)
+	// UpdateLanguage implements UpdateLanguage operation.
+	//
+	// エラーメッセージなどに使う言語を変更する。言語を設定している場合はAccept-Languageヘッダより優先し、nullを指定すると設定を解除してAccept-Languageヘッダで言語を決める.
+	//
+	// PATCH /me/language
+	UpdateLanguage(ctx context.Context, req *UpdateLanguageReq) (*User, error)
 	// UpdateProject implements UpdateProject operation.
 	//
 	// PATCH /projects/{projectID}
-	UpdateProject(ctx context.Context, req *UpdateProjectReq, params UpdateProjectParams) (*Project, error)
+	UpdateProject(ctx context.Context, req *UpdateProjectReq, params UpdateProjectParams) (*ProjectHeaders, error)
+	// UpdateProjectMember implements UpdateProjectMember operation.
+	//
+	// PATCH /projects/{projectID}/members/{userID}
+	UpdateProjectMember(ctx context.Context, req *UpdateProjectMemberReq, params UpdateProjectMemberParams) (*ProjectMember, error)
 	// UpdateStep implements UpdateStep operation.
 	//
 	// PATCH /steps/{stepID}
-	UpdateStep(ctx context.Context, req *UpdateStepReq, params UpdateStepParams) (*Step, error)
+	UpdateStep(ctx context.Context, req *UpdateStepReq, params UpdateStepParams) (*StepHeaders, error)
 	// UpdateTag implements UpdateTag operation.
 	//
 	// PATCH /tags/{tagID}
-	UpdateTag(ctx context.Context, req *UpdateTagReq, params UpdateTagParams) (*Tag, error)
+	UpdateTag(ctx context.Context, req *UpdateTagReq, params UpdateTagParams) (*TagHeaders, error)
 	// UpdateTask implements UpdateTask operation.
 	//
 	// PATCH /tasks/{taskID}
-	UpdateTask(ctx context.Context, req *UpdateTaskReq, params UpdateTaskParams) (*Task, error)
+	UpdateTask(ctx context.Context, req *UpdateTaskReq, params UpdateTaskParams) (*TaskHeaders, error)
+	// VerifyEmail implements VerifyEmail operation.
+	//
+	// メールで送ったトークンを使ってメールアドレスを確認済みにする.
+	//
+	// POST /email/verify
+	VerifyEmail(ctx context.Context, req *VerifyEmailReq) error
 }
 
 // Server implements http server based on OpenAPI v3 specification and
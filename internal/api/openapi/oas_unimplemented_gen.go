@@ -13,6 +13,24 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// AcceptInvitation implements AcceptInvitation operation.
+//
+// POST /invitations/{invitationID}:accept
+func (UnimplementedHandler) AcceptInvitation(ctx context.Context, params AcceptInvitationParams) (r *ProjectMember, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ChangePassword implements ChangePassword operation.
+//
+// 現在のパスワードを確認してパスワードを変更する。パスワードを設定していないユーザは
+// current_password
+// の代わりに直近のサインインで本人確認して最初のパスワードを設定する。変更後はすべての端末でサインアウトしてパーソナルアクセストークンをすべて削除し、この端末で使う新しいIDトークンとリフレッシュトークンを返す.
+//
+// POST /me/password
+func (UnimplementedHandler) ChangePassword(ctx context.Context, req *ChangePasswordReq) (r *ChangePasswordOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CheckHealth implements CheckHealth operation.
 //
 // GET /health
@@ -20,10 +38,45 @@ func (UnimplementedHandler) CheckHealth(ctx context.Context) (r *CheckHealthOK,
 	return r, ht.ErrNotImplemented
 }
 
+// ConfirmPasswordReset implements ConfirmPasswordReset operation.
+//
+// メールで送ったトークンを使ってパスワードを変更する。変更後はすべての端末でサインアウトし、パーソナルアクセストークンをすべて削除する.
+//
+// POST /password-reset/confirm
+func (UnimplementedHandler) ConfirmPasswordReset(ctx context.Context, req *ConfirmPasswordResetReq) error {
+	return ht.ErrNotImplemented
+}
+
+// ConfirmTOTP implements ConfirmTOTP operation.
+//
+// 認証アプリに表示された認証コードを検証して2段階認証を有効にし、リカバリーコードを返す。リカバリーコードはこのレスポンスでのみ確認できる.
+//
+// POST /me/totp/confirm
+func (UnimplementedHandler) ConfirmTOTP(ctx context.Context, req *ConfirmTOTPReq) (r *ConfirmTOTPOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateComment implements CreateComment operation.
+//
+// POST /tasks/{taskID}/comments
+func (UnimplementedHandler) CreateComment(ctx context.Context, req *CreateCommentReq, params CreateCommentParams) (r *Comment, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreatePersonalAccessToken implements CreatePersonalAccessToken operation.
+//
+// パーソナルアクセストークンを作成する。トークンの平文はこのレスポンスでのみ確認できる。expires_in_days
+// を省略した場合は削除するまで有効である.
+//
+// POST /me/tokens
+func (UnimplementedHandler) CreatePersonalAccessToken(ctx context.Context, req *CreatePersonalAccessTokenReq) (r *CreatePersonalAccessTokenOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CreateProject implements CreateProject operation.
 //
 // POST /projects
-func (UnimplementedHandler) CreateProject(ctx context.Context, req *CreateProjectReq) (r *Project, _ error) {
+func (UnimplementedHandler) CreateProject(ctx context.Context, req *CreateProjectReq, params CreateProjectParams) (r *Project, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -37,7 +90,7 @@ func (UnimplementedHandler) CreateStep(ctx context.Context, req *CreateStepReq,
 // CreateTag implements CreateTag operation.
 //
 // POST /tags
-func (UnimplementedHandler) CreateTag(ctx context.Context, req *CreateTagReq) (r *Tag, _ error) {
+func (UnimplementedHandler) CreateTag(ctx context.Context, req *CreateTagReq, params CreateTagParams) (r *Tag, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -48,6 +101,41 @@ func (UnimplementedHandler) CreateTask(ctx context.Context, req *CreateTaskReq,
 	return r, ht.ErrNotImplemented
 }
 
+// DeleteAccount implements DeleteAccount operation.
+//
+// アカウントと、プロジェクトやタグなどのユーザが所有するすべてのデータを削除する。他のユーザのタスクに書いたコメントと操作の履歴は、ユーザを空にして残す。発行済みのIDトークン・リフレッシュトークン・パーソナルアクセストークンはすべて無効になる。パスワードを設定していないユーザは
+// password の代わりに直近のサインインで本人確認する.
+//
+// DELETE /me
+func (UnimplementedHandler) DeleteAccount(ctx context.Context, req *DeleteAccountReq) error {
+	return ht.ErrNotImplemented
+}
+
+// DeleteComment implements DeleteComment operation.
+//
+// DELETE /tasks/{taskID}/comments/{commentID}
+func (UnimplementedHandler) DeleteComment(ctx context.Context, params DeleteCommentParams) error {
+	return ht.ErrNotImplemented
+}
+
+// DeleteInvitation implements DeleteInvitation operation.
+//
+// 招待されたユーザは招待を辞退し、プロジェクトのオーナーは招待を取り消す.
+//
+// DELETE /invitations/{invitationID}
+func (UnimplementedHandler) DeleteInvitation(ctx context.Context, params DeleteInvitationParams) error {
+	return ht.ErrNotImplemented
+}
+
+// DeletePersonalAccessToken implements DeletePersonalAccessToken operation.
+//
+// パーソナルアクセストークンを削除し、以降そのトークンによるリクエストを受け付けないようにする.
+//
+// DELETE /me/tokens/{tokenID}
+func (UnimplementedHandler) DeletePersonalAccessToken(ctx context.Context, params DeletePersonalAccessTokenParams) error {
+	return ht.ErrNotImplemented
+}
+
 // DeleteProject implements DeleteProject operation.
 //
 // DELETE /projects/{projectID}
@@ -55,6 +143,15 @@ func (UnimplementedHandler) DeleteProject(ctx context.Context, params DeleteProj
 	return ht.ErrNotImplemented
 }
 
+// DeleteProjectMember implements DeleteProjectMember operation.
+//
+// メンバーをプロジェクトから外す。メンバーは自分自身を外すことでプロジェクトから抜けられる.
+//
+// DELETE /projects/{projectID}/members/{userID}
+func (UnimplementedHandler) DeleteProjectMember(ctx context.Context, params DeleteProjectMemberParams) error {
+	return ht.ErrNotImplemented
+}
+
 // DeleteStep implements DeleteStep operation.
 //
 // DELETE /steps/{stepID}
@@ -76,24 +173,119 @@ func (UnimplementedHandler) DeleteTask(ctx context.Context, params DeleteTaskPar
 	return ht.ErrNotImplemented
 }
 
+// EnrollTOTP implements EnrollTOTP operation.
+//
+// 2段階認証の登録を開始し、認証アプリに登録する秘密鍵とotpauth
+// URIを返す。ConfirmTOTP
+// で認証コードを確認するまで2段階認証は有効にならない.
+//
+// POST /me/totp
+func (UnimplementedHandler) EnrollTOTP(ctx context.Context) (r *EnrollTOTPOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetJWKS implements GetJWKS operation.
+//
+// IDトークンの検証に使う公開鍵の一覧を返す.
+//
+// GET /.well-known/jwks.json
+func (UnimplementedHandler) GetJWKS(ctx context.Context) (r *GetJWKSOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMe implements GetMe operation.
+//
+// GET /me
+func (UnimplementedHandler) GetMe(ctx context.Context) (r *User, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetProject implements GetProject operation.
 //
 // GET /projects/{projectID}
-func (UnimplementedHandler) GetProject(ctx context.Context, params GetProjectParams) (r *Project, _ error) {
+func (UnimplementedHandler) GetProject(ctx context.Context, params GetProjectParams) (r GetProjectRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
 // GetTag implements GetTag operation.
 //
 // GET /tags/{tagID}
-func (UnimplementedHandler) GetTag(ctx context.Context, params GetTagParams) (r *Tag, _ error) {
+func (UnimplementedHandler) GetTag(ctx context.Context, params GetTagParams) (r GetTagRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
 // GetTask implements GetTask operation.
 //
 // GET /tasks/{taskID}
-func (UnimplementedHandler) GetTask(ctx context.Context, params GetTaskParams) (r *Task, _ error) {
+func (UnimplementedHandler) GetTask(ctx context.Context, params GetTaskParams) (r GetTaskRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// InviteProjectMember implements InviteProjectMember operation.
+//
+// メールアドレスを指定してプロジェクトに招待する。招待されたユーザが承諾するとメンバーになる.
+//
+// POST /projects/{projectID}/members
+func (UnimplementedHandler) InviteProjectMember(ctx context.Context, req *InviteProjectMemberReq, params InviteProjectMemberParams) (r *ProjectInvitation, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListAssignedTasks implements ListAssignedTasks operation.
+//
+// すべてのプロジェクトから自分が担当者である未完了のタスクを期日の昇順で返す.
+//
+// GET /me/assigned-tasks
+func (UnimplementedHandler) ListAssignedTasks(ctx context.Context, params ListAssignedTasksParams) (r *ListAssignedTasksOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListComments implements ListComments operation.
+//
+// タスクのコメントを作成日時の昇順で返す.
+//
+// GET /tasks/{taskID}/comments
+func (UnimplementedHandler) ListComments(ctx context.Context, params ListCommentsParams) (r *ListCommentsOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListInvitations implements ListInvitations operation.
+//
+// 自分のメールアドレス宛ての招待を招待日時の昇順で返す.
+//
+// GET /invitations
+func (UnimplementedHandler) ListInvitations(ctx context.Context) (r *ListInvitationsOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListOverdueTasks implements ListOverdueTasks operation.
+//
+// GET /tasks/overdue
+func (UnimplementedHandler) ListOverdueTasks(ctx context.Context, params ListOverdueTasksParams) (r *ListOverdueTasksOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListPersonalAccessTokens implements ListPersonalAccessTokens operation.
+//
+// GET /me/tokens
+func (UnimplementedHandler) ListPersonalAccessTokens(ctx context.Context) (r *ListPersonalAccessTokensOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListProjectHistory implements ListProjectHistory operation.
+//
+// プロジェクトとそのタスクの変更履歴を新しい順に返す.
+//
+// GET /projects/{projectID}/history
+func (UnimplementedHandler) ListProjectHistory(ctx context.Context, params ListProjectHistoryParams) (r *ListProjectHistoryOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListProjectMembers implements ListProjectMembers operation.
+//
+// プロジェクトのメンバーをオーナー、参加日時の昇順の順で返し、招待中のものも合わせて返す.
+//
+// GET /projects/{projectID}/members
+func (UnimplementedHandler) ListProjectMembers(ctx context.Context, params ListProjectMembersParams) (r *ListProjectMembersOK, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -104,6 +296,16 @@ func (UnimplementedHandler) ListProjects(ctx context.Context, params ListProject
 	return r, ht.ErrNotImplemented
 }
 
+// ListSearchResults implements ListSearchResults operation.
+//
+// プロジェクト名・タスクの名前と内容・ステップ名・タグ名から、空白で区切ったすべての検索語を含むものを関連度の高い順に返す。
+// projectIDを指定した場合はプロジェクトとそのタスク・ステップに、tagIDsを指定した場合はタグが付いたタスクとそのステップに絞り込み、いずれの場合もタグは検索しない。.
+//
+// GET /search
+func (UnimplementedHandler) ListSearchResults(ctx context.Context, params ListSearchResultsParams) (r *ListSearchResultsOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListTags implements ListTags operation.
 //
 // GET /tags
@@ -111,6 +313,15 @@ func (UnimplementedHandler) ListTags(ctx context.Context, params ListTagsParams)
 	return r, ht.ErrNotImplemented
 }
 
+// ListTaskHistory implements ListTaskHistory operation.
+//
+// タスクとそのステップの変更履歴を新しい順に返す.
+//
+// GET /tasks/{taskID}/history
+func (UnimplementedHandler) ListTaskHistory(ctx context.Context, params ListTaskHistoryParams) (r *ListTaskHistoryOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListTasks implements ListTasks operation.
 //
 // GET /projects/{projectID}/tasks
@@ -118,13 +329,113 @@ func (UnimplementedHandler) ListTasks(ctx context.Context, params ListTasksParam
 	return r, ht.ErrNotImplemented
 }
 
+// ListTodayTasks implements ListTodayTasks operation.
+//
+// GET /tasks/today
+func (UnimplementedHandler) ListTodayTasks(ctx context.Context, params ListTodayTasksParams) (r *ListTodayTasksOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListTrash implements ListTrash operation.
+//
+// GET /trash
+func (UnimplementedHandler) ListTrash(ctx context.Context, params ListTrashParams) (r *ListTrashOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListUpcomingTasks implements ListUpcomingTasks operation.
+//
+// GET /tasks/upcoming
+func (UnimplementedHandler) ListUpcomingTasks(ctx context.Context, params ListUpcomingTasksParams) (r *ListUpcomingTasksOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// MoveProject implements MoveProject operation.
+//
+// POST /projects/{projectID}:move
+func (UnimplementedHandler) MoveProject(ctx context.Context, req *MoveProjectReq, params MoveProjectParams) (r *Project, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// MoveStep implements MoveStep operation.
+//
+// POST /steps/{stepID}:move
+func (UnimplementedHandler) MoveStep(ctx context.Context, req *MoveStepReq, params MoveStepParams) (r *Step, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// MoveTask implements MoveTask operation.
+//
+// POST /tasks/{taskID}:move
+func (UnimplementedHandler) MoveTask(ctx context.Context, req *MoveTaskReq, params MoveTaskParams) (r *Task, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RefreshToken implements RefreshToken operation.
+//
+// リフレッシュトークンを使用済みにし、新しいIDトークンとリフレッシュトークンを返す。使用済みのリフレッシュトークンを再び指定した場合は、そのリフレッシュトークンから発行したものを含めてすべて無効にする.
+//
+// POST /token/refresh
+func (UnimplementedHandler) RefreshToken(ctx context.Context, req *RefreshTokenReq) (r *RefreshTokenOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RequestPasswordReset implements RequestPasswordReset operation.
+//
+// パスワード再設定用のURLをメールで送る。メールアドレスが登録されていない場合もメールを送らずに成功する.
+//
+// POST /password-reset/request
+func (UnimplementedHandler) RequestPasswordReset(ctx context.Context, req *RequestPasswordResetReq) error {
+	return ht.ErrNotImplemented
+}
+
+// RestoreTrashItem implements RestoreTrashItem operation.
+//
+// POST /trash/{itemID}:restore
+func (UnimplementedHandler) RestoreTrashItem(ctx context.Context, params RestoreTrashItemParams) (r *TrashItem, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // SignIn implements SignIn operation.
 //
+// 2段階認証が有効な場合は、IDトークンとリフレッシュトークンの代わりにチャレンジトークンを返す。チャレンジトークンと認証コードを
+// SignInWithTwoFactor
+// に渡すとサインインが完了する。同じメールアドレスかIPアドレスからの失敗が続いた場合は、一時的に429を返し、Retry-Afterヘッダで再試行できるまでの秒数を返す.
+//
 // POST /sign-in
 func (UnimplementedHandler) SignIn(ctx context.Context, req *SignInReq) (r *SignInOK, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// SignInWithOIDC implements SignInWithOIDC operation.
+//
+// IDプロバイダからのコールバックで受け取った認可コードをIDトークンと交換してサインインする。初めてサインインする外部アカウントの場合は、確認済みの同じメールアドレスのユーザと連携するか、新しいユーザを作成する。2段階認証が有効な場合は
+// SignIn と同じくチャレンジトークンを返す.
+//
+// POST /oidc/callback
+func (UnimplementedHandler) SignInWithOIDC(ctx context.Context, req *SignInWithOIDCReq) (r *SignInWithOIDCOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SignInWithTwoFactor implements SignInWithTwoFactor operation.
+//
+// SignIn
+// が返したチャレンジトークンと、TOTPの認証コードかリカバリーコードを検証する。チャレンジトークンは検証結果に関わらず1回で使用済みになる.
+//
+// POST /sign-in/two-factor
+func (UnimplementedHandler) SignInWithTwoFactor(ctx context.Context, req *SignInWithTwoFactorReq) (r *SignInWithTwoFactorOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SignOut implements SignOut operation.
+//
+// リフレッシュトークンとそのリフレッシュトークンから発行したもの、発行済みのIDトークンをすべて無効にする.
+//
+// POST /sign-out
+func (UnimplementedHandler) SignOut(ctx context.Context, req *SignOutReq) error {
+	return ht.ErrNotImplemented
+}
+
 // SignUp implements SignUp operation.
 //
 // POST /sign-up
@@ -132,30 +443,84 @@ func (UnimplementedHandler) SignUp(ctx context.Context, req *SignUpReq) (r *Sign
 	return r, ht.ErrNotImplemented
 }
 
+// StartOIDCSignIn implements StartOIDCSignIn operation.
+//
+// 外部のIDプロバイダによるサインインを開始し、ユーザを遷移させる認可URLを返す。クライアントは
+// state を保存し、コールバックで受け取った state
+// と一致することを確認してから SignInWithOIDC を呼ぶ.
+//
+// POST /oidc/authorize
+func (UnimplementedHandler) StartOIDCSignIn(ctx context.Context) (r *StartOIDCSignInOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateComment implements UpdateComment operation.
+//
+// PATCH /tasks/{taskID}/comments/{commentID}
+func (UnimplementedHandler) UpdateComment(ctx context.Context, req *UpdateCommentReq, params UpdateCommentParams) (r *Comment, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateEmail implements UpdateEmail operation.
+//
+// 新しいメールアドレスに確認用のURLを送る。メールアドレスは確認が完了した時点で変更し、それまでは
+// pending_email として返す。パスワードを設定していないユーザは password
+// の代わりに直近のサインインで本人確認する.
+//
+// PATCH /me/email
+func (UnimplementedHandler) UpdateEmail(ctx context.Context, req *UpdateEmailReq) (r *User, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateLanguage implements UpdateLanguage operation.
+//
+// エラーメッセージなどに使う言語を変更する。言語を設定している場合はAccept-Languageヘッダより優先し、nullを指定すると設定を解除してAccept-Languageヘッダで言語を決める.
+//
+// PATCH /me/language
+func (UnimplementedHandler) UpdateLanguage(ctx context.Context, req *UpdateLanguageReq) (r *User, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdateProject implements UpdateProject operation.
 //
 // PATCH /projects/{projectID}
-func (UnimplementedHandler) UpdateProject(ctx context.Context, req *UpdateProjectReq, params UpdateProjectParams) (r *Project, _ error) {
+func (UnimplementedHandler) UpdateProject(ctx context.Context, req *UpdateProjectReq, params UpdateProjectParams) (r *ProjectHeaders, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateProjectMember implements UpdateProjectMember operation.
+//
+// PATCH /projects/{projectID}/members/{userID}
+func (UnimplementedHandler) UpdateProjectMember(ctx context.Context, req *UpdateProjectMemberReq, params UpdateProjectMemberParams) (r *ProjectMember, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
 // UpdateStep implements UpdateStep operation.
 //
 // PATCH /steps/{stepID}
-func (UnimplementedHandler) UpdateStep(ctx context.Context, req *UpdateStepReq, params UpdateStepParams) (r *Step, _ error) {
+func (UnimplementedHandler) UpdateStep(ctx context.Context, req *UpdateStepReq, params UpdateStepParams) (r *StepHeaders, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
 // UpdateTag implements UpdateTag operation.
 //
 // PATCH /tags/{tagID}
-func (UnimplementedHandler) UpdateTag(ctx context.Context, req *UpdateTagReq, params UpdateTagParams) (r *Tag, _ error) {
+func (UnimplementedHandler) UpdateTag(ctx context.Context, req *UpdateTagReq, params UpdateTagParams) (r *TagHeaders, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
 // UpdateTask implements UpdateTask operation.
 //
 // PATCH /tasks/{taskID}
-func (UnimplementedHandler) UpdateTask(ctx context.Context, req *UpdateTaskReq, params UpdateTaskParams) (r *Task, _ error) {
+func (UnimplementedHandler) UpdateTask(ctx context.Context, req *UpdateTaskReq, params UpdateTaskParams) (r *TaskHeaders, _ error) {
 	return r, ht.ErrNotImplemented
 }
+
+// VerifyEmail implements VerifyEmail operation.
+//
+// メールで送ったトークンを使ってメールアドレスを確認済みにする.
+//
+// POST /email/verify
+func (UnimplementedHandler) VerifyEmail(ctx context.Context, req *VerifyEmailReq) error {
+	return ht.ErrNotImplemented
+}
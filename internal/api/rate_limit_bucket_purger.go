@@ -0,0 +1,26 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/atel"
+	"github.com/minguu42/harmattan/internal/lib/clock"
+)
+
+// PurgeRateLimitBucketsPeriodically は interval ごとにデータベースに保存した満杯のトークンバケットを削除する
+// ctx がキャンセルされるまで処理を続ける
+func PurgeRateLimitBucketsPeriodically(ctx context.Context, f *Factory, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := f.DB.DeleteExpiredRateLimitBuckets(ctx, clock.Now(ctx)); err != nil {
+				atel.ErrorLog(ctx, "Failed to purge rate limit buckets", err)
+			}
+		}
+	}
+}
@@ -9,12 +9,14 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"regexp"
 	"slices"
 	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/minguu42/harmattan/internal/mail"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/tools/txtar"
 )
@@ -23,6 +25,7 @@ var update = flag.Bool("update", false, "実際のテスト結果でtxtarファ
 
 // TestRegression はtestdataディレクトリ配下のtxtarファイルをリグレッションテストとして実行する
 // 各テストケースはtestdata/<OperationName>/<testcase_name>.txtarに配置する
+// db.goldenとmail.goldenは任意であり、含まれる場合のみデータベースの状態と送信したメールを検証する
 // `go test ./internal/api -update`コマンドで実際のテスト結果からgoldenファイルを一括で更新できる
 func TestRegression(t *testing.T) {
 	operationDirs, err := os.ReadDir("testdata")
@@ -61,11 +64,13 @@ func runTestCase(t *testing.T, path string) {
 
 	if file, ok := files["setup.sql"]; ok {
 		require.NoError(t, tdb.TruncateAll(t.Context()))
-		require.NoError(t, tdb.ExecScript(t.Context(), file))
+		// IDプロバイダのスタブのアドレスは実行ごとに変わるため、setup.sqlでは$OIDC_ISSUERで参照する
+		require.NoError(t, tdb.ExecScript(t.Context(), strings.ReplaceAll(file, "$OIDC_ISSUER", idp.URL)))
 	}
 
 	requestFile, ok := files["request"]
 	require.True(t, ok, "%s must contain %q file", path, "request")
+	mailer.Reset()
 	resp, err := ts.Client().Do(parseRequestFile(t, requestFile))
 	require.NoError(t, err)
 	body, err := io.ReadAll(resp.Body)
@@ -77,12 +82,19 @@ func runTestCase(t *testing.T, path string) {
 	if file, ok := files["db.golden"]; ok {
 		gotDB = generateDBGolden(t, file)
 	}
+	var gotMail string
+	if _, ok := files["mail.golden"]; ok {
+		gotMail = generateMailGolden(mailer.Messages())
+	}
 
 	if *update {
 		setArchiveFile(archive, "response.golden", gotResponse)
 		if gotDB != "" {
 			setArchiveFile(archive, "db.golden", gotDB)
 		}
+		if _, ok := files["mail.golden"]; ok {
+			setArchiveFile(archive, "mail.golden", gotMail)
+		}
 		writeTestCase(t, path, archive)
 		return
 	}
@@ -92,12 +104,15 @@ func runTestCase(t *testing.T, path string) {
 	if gotDB != "" {
 		assertGolden(t, "Database state", gotDB, files["db.golden"])
 	}
+	if _, ok := files["mail.golden"]; ok {
+		assertGolden(t, "Sent mail", gotMail, files["mail.golden"])
+	}
 }
 
 func parseRequestFile(t *testing.T, text string) *http.Request {
 	t.Helper()
 
-	vars := map[string]string{"TOKEN": token}
+	vars := map[string]string{"TOKEN": token, "FRESH_TOKEN": freshToken}
 	text = os.Expand(text, func(k string) string { return vars[k] })
 
 	head, body, _ := strings.Cut(text, "\n\n")
@@ -133,9 +148,25 @@ func generateDBGolden(t *testing.T, dbGolden string) string {
 	return b.String()
 }
 
+// generateMailGolden はリクエストの処理中に送信したメールからmail.goldenの内容を生成する
+// メールを送信しなかった場合は空になる
+func generateMailGolden(messages []mail.Message) string {
+	var b strings.Builder
+	for i, m := range messages {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString("To: " + m.To + "\n")
+		b.WriteString("Subject: " + m.Subject + "\n")
+		b.WriteString("\n" + m.Body)
+	}
+	return b.String()
+}
+
 // generateResponseGolden はレスポンスの実測値からresponse.goldenの内容を生成する
 // ヘッダはすべてダンプして検証する
 // ただし、実行ごとに値が変わるDateとボディの検証と重複するContent-Lengthは除外する
+// また、実行ごとに値が変わるボディのトレースIDは${TRACE_ID}に置き換える
 func generateResponseGolden(t *testing.T, resp *http.Response, body []byte) string {
 	t.Helper()
 
@@ -154,9 +185,12 @@ func generateResponseGolden(t *testing.T, resp *http.Response, body []byte) stri
 	}
 	var buf bytes.Buffer
 	require.NoError(t, json.Indent(&buf, body, "", "  "), "response body: %s", body)
-	return b.String() + "\n" + buf.String() + "\n"
+	body = traceIDPattern.ReplaceAll(buf.Bytes(), []byte(`"trace_id": "$${TRACE_ID}"`))
+	return b.String() + "\n" + string(body) + "\n"
 }
 
+var traceIDPattern = regexp.MustCompile(`"trace_id": "[0-9a-f]{32}"`)
+
 func setArchiveFile(archive *txtar.Archive, name, data string) {
 	for i := range archive.Files {
 		if archive.Files[i].Name == name {
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"github.com/minguu42/harmattan/internal/api/openapi"
+	"github.com/minguu42/harmattan/internal/domain"
+)
+
+// operationScopes はパーソナルアクセストークンで操作を実行するために必要なスコープである
+// ここに含まれない操作はIDトークンでのみ実行でき、パーソナルアクセストークンの作成や2段階認証の設定を漏洩したトークンで行えないようにする
+var operationScopes = map[openapi.OperationName][]domain.Scope{
+	openapi.CreateProjectOperation:       {domain.ScopeProjectsWrite},
+	openapi.ListProjectsOperation:        {domain.ScopeProjectsRead},
+	openapi.GetProjectOperation:          {domain.ScopeProjectsRead},
+	openapi.UpdateProjectOperation:       {domain.ScopeProjectsWrite},
+	openapi.DeleteProjectOperation:       {domain.ScopeProjectsWrite},
+	openapi.MoveProjectOperation:         {domain.ScopeProjectsWrite},
+	openapi.ListProjectHistoryOperation:  {domain.ScopeProjectsRead},
+	openapi.ListProjectMembersOperation:  {domain.ScopeProjectsRead},
+	openapi.InviteProjectMemberOperation: {domain.ScopeProjectsWrite},
+	openapi.UpdateProjectMemberOperation: {domain.ScopeProjectsWrite},
+	openapi.DeleteProjectMemberOperation: {domain.ScopeProjectsWrite},
+	openapi.ListInvitationsOperation:     {domain.ScopeProjectsRead},
+	openapi.DeleteInvitationOperation:    {domain.ScopeProjectsWrite},
+	openapi.AcceptInvitationOperation:    {domain.ScopeProjectsWrite},
+
+	openapi.CreateTaskOperation:        {domain.ScopeTasksWrite},
+	openapi.ListTasksOperation:         {domain.ScopeTasksRead},
+	openapi.ListAssignedTasksOperation: {domain.ScopeTasksRead},
+	openapi.ListTodayTasksOperation:    {domain.ScopeTasksRead},
+	openapi.ListUpcomingTasksOperation: {domain.ScopeTasksRead},
+	openapi.ListOverdueTasksOperation:  {domain.ScopeTasksRead},
+	openapi.GetTaskOperation:           {domain.ScopeTasksRead},
+	openapi.UpdateTaskOperation:        {domain.ScopeTasksWrite},
+	openapi.DeleteTaskOperation:        {domain.ScopeTasksWrite},
+	openapi.MoveTaskOperation:          {domain.ScopeTasksWrite},
+	openapi.ListTaskHistoryOperation:   {domain.ScopeTasksRead},
+	openapi.CreateStepOperation:        {domain.ScopeTasksWrite},
+	openapi.UpdateStepOperation:        {domain.ScopeTasksWrite},
+	openapi.DeleteStepOperation:        {domain.ScopeTasksWrite},
+	openapi.MoveStepOperation:          {domain.ScopeTasksWrite},
+	openapi.CreateCommentOperation:     {domain.ScopeTasksWrite},
+	openapi.ListCommentsOperation:      {domain.ScopeTasksRead},
+	openapi.UpdateCommentOperation:     {domain.ScopeTasksWrite},
+	openapi.DeleteCommentOperation:     {domain.ScopeTasksWrite},
+
+	openapi.CreateTagOperation: {domain.ScopeTagsWrite},
+	openapi.ListTagsOperation:  {domain.ScopeTagsRead},
+	openapi.GetTagOperation:    {domain.ScopeTagsRead},
+	openapi.UpdateTagOperation: {domain.ScopeTagsWrite},
+	openapi.DeleteTagOperation: {domain.ScopeTagsWrite},
+
+	// 検索結果とゴミ箱にはプロジェクト、タスク、タグが混在するため、すべてのリソースのスコープを必要とする
+	openapi.ListSearchResultsOperation: {domain.ScopeProjectsRead, domain.ScopeTasksRead, domain.ScopeTagsRead},
+	openapi.ListTrashOperation:         {domain.ScopeProjectsRead, domain.ScopeTasksRead, domain.ScopeTagsRead},
+	openapi.RestoreTrashItemOperation:  {domain.ScopeProjectsWrite, domain.ScopeTasksWrite, domain.ScopeTagsWrite},
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"errors"
 
 	"github.com/minguu42/harmattan/internal/api/apierror"
 	"github.com/minguu42/harmattan/internal/api/openapi"
@@ -9,6 +10,7 @@ import (
 	"github.com/minguu42/harmattan/internal/auth"
 	"github.com/minguu42/harmattan/internal/database"
 	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/clock"
 	"github.com/minguu42/harmattan/internal/lib/errtrace"
 )
 
@@ -17,18 +19,63 @@ type securityHandler struct {
 	db   *database.Client
 }
 
-func (h *securityHandler) HandleBearerAuth(ctx context.Context, _ openapi.OperationName, t openapi.BearerAuth) (context.Context, error) {
+func (h *securityHandler) HandleBearerAuth(ctx context.Context, operationName openapi.OperationName, t openapi.BearerAuth) (context.Context, error) {
 	// セキュリティハンドラはogenミドルウェアより先に実行されるため、ここでトレースIDをロガーに付与する
 	ctx = atel.ContextWithTracedLogger(ctx)
 
-	userID, err := h.auth.ParseIDToken(ctx, t.Token)
+	if auth.IsPersonalAccessToken(t.Token) {
+		return h.handlePersonalAccessToken(ctx, operationName, t.Token)
+	}
+
+	claims, err := h.auth.ParseIDToken(ctx, t.Token)
 	if err != nil {
 		return nil, errtrace.Wrap(apierror.AuthorizationError())
 	}
 
-	user, err := h.db.GetUserByID(ctx, userID)
+	user, err := h.db.GetUserByID(ctx, claims.UserID)
+	if err != nil {
+		// 退会したユーザのIDトークンは、有効期限内でも受け付けない
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, errtrace.Wrap(apierror.AuthorizationError())
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	// サインアウトなどで無効にした日時より前に発行されたIDトークンは、有効期限内でも受け付けない
+	if !user.AcceptsIDTokenIssuedAt(claims.IssuedAt) {
+		return nil, errtrace.Wrap(apierror.AuthorizationError())
+	}
+	return domain.ContextWithAuthTime(domain.ContextWithUser(ctx, user), claims.AuthTime), nil
+}
+
+// handlePersonalAccessToken はパーソナルアクセストークンを検証し、操作に必要なスコープが付与されている場合のみ受け付ける
+func (h *securityHandler) handlePersonalAccessToken(ctx context.Context, operationName openapi.OperationName, token string) (context.Context, error) {
+	pat, err := h.db.GetPersonalAccessTokenByHash(ctx, auth.HashPersonalAccessToken(token))
 	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, errtrace.Wrap(apierror.AuthorizationError())
+		}
 		return nil, errtrace.Wrap(err)
 	}
+	now := clock.Now(ctx)
+	if pat.IsExpired(now) {
+		return nil, errtrace.Wrap(apierror.AuthorizationError())
+	}
+	scopes, ok := operationScopes[operationName]
+	if !ok || !pat.HasScopes(scopes...) {
+		return nil, errtrace.Wrap(apierror.InsufficientScopeError())
+	}
+
+	user, err := h.db.GetUserByID(ctx, pat.UserID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, errtrace.Wrap(apierror.AuthorizationError())
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	if pat.ShouldRecordUse(now) {
+		if err := h.db.UpdatePersonalAccessTokenLastUsedAt(ctx, pat.ID, now); err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+	}
 	return domain.ContextWithUser(ctx, user), nil
 }
@@ -0,0 +1,28 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/api/usecase"
+	"github.com/minguu42/harmattan/internal/atel"
+)
+
+// PurgeTrashPeriodically は interval ごとに保持期間を過ぎたゴミ箱の項目を完全に削除する
+// ctx がキャンセルされるまで処理を続ける
+func PurgeTrashPeriodically(ctx context.Context, f *Factory, interval time.Duration) {
+	uc := usecase.Trash{DB: f.DB, Retention: f.TrashRetention}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := uc.PurgeTrash(ctx); err != nil {
+				atel.ErrorLog(ctx, "Failed to purge trash", err)
+			}
+		}
+	}
+}
@@ -3,19 +3,42 @@ package usecase
 import (
 	"context"
 	"errors"
+	"net/url"
+	"time"
 
 	"github.com/minguu42/harmattan/internal/api/apierror"
+	"github.com/minguu42/harmattan/internal/atel"
 	"github.com/minguu42/harmattan/internal/auth"
 	"github.com/minguu42/harmattan/internal/database"
 	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/clock"
 	"github.com/minguu42/harmattan/internal/lib/errtrace"
 	"github.com/minguu42/harmattan/internal/lib/idgen"
+	"github.com/minguu42/harmattan/internal/lib/totp"
+	"github.com/minguu42/harmattan/internal/lockout"
+	"github.com/minguu42/harmattan/internal/mail"
+	"github.com/minguu42/harmattan/internal/oidc"
 	"golang.org/x/crypto/bcrypt"
 )
 
+// totpIssuer は認証アプリにアカウントの発行元として表示するサービス名である
+const totpIssuer = "Harmattan"
+
 type Authentication struct {
-	Auth *auth.Authenticator
-	DB   *database.Client
+	Auth        *auth.Authenticator
+	DB          *database.Client
+	Mailer      mail.Mailer
+	SignInGuard *lockout.Guard
+	// OIDC が nil の場合は、外部のIDプロバイダによるサインインを無効にする
+	OIDC                             *oidc.Provider
+	WebURL                           string
+	PasswordResetTokenExpiration     time.Duration
+	EmailVerificationTokenExpiration time.Duration
+	TwoFactorChallengeExpiration     time.Duration
+	ReauthenticationMaxAge           time.Duration
+	OIDCAuthRequestExpiration        time.Duration
+	// PasswordResetResponseTime は RequestPasswordReset がレスポンスを返すまでの最短の時間である
+	PasswordResetResponseTime time.Duration
 }
 
 type SignUpInput struct {
@@ -36,10 +59,11 @@ func (in *SignUpInput) User(ctx context.Context) (*domain.User, error) {
 }
 
 type SignUpOutput struct {
-	IDToken string
+	IDToken      string
+	RefreshToken string
 }
 
-func (uc *Authentication) SignUp(ctx context.Context, in *SignUpInput) (*SignUpOutput, error) {
+func (uc *Authentication) SignUp(ctx context.Context, in *SignUpInput) (_ *SignUpOutput, err error) {
 	existingUser, err := uc.DB.GetUserByEmail(ctx, in.Email)
 	if err != nil && !errors.Is(err, database.ErrNotFound) {
 		return nil, errtrace.Wrap(err)
@@ -53,42 +77,1022 @@ func (uc *Authentication) SignUp(ctx context.Context, in *SignUpInput) (*SignUpO
 		return nil, errtrace.Wrap(err)
 	}
 
-	token, err := uc.Auth.CreateIDToken(ctx, user.ID)
+	authTime := clock.Now(ctx)
+	token, err := uc.Auth.CreateIDToken(ctx, user.ID, authTime)
 	if err != nil {
 		return nil, errtrace.Wrap(err)
 	}
 
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
 	if err := uc.DB.CreateUser(ctx, user); err != nil {
 		return nil, errtrace.Wrap(err)
 	}
-	return &SignUpOutput{IDToken: token}, nil
+	refreshToken, err := uc.issueRefreshToken(ctx, user.ID, "", authTime)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if err := uc.sendEmailVerification(ctx, user, user.Email); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &SignUpOutput{IDToken: token, RefreshToken: refreshToken}, nil
 }
 
 type SignInInput struct {
-	Email    string
-	Password string
+	Email     string
+	Password  string
+	IPAddress string
 }
 
+// SignInOutput は2段階認証が有効な場合は ChallengeToken のみを、無効な場合は IDToken と RefreshToken のみを持つ
 type SignInOutput struct {
-	IDToken string
+	IDToken        string
+	RefreshToken   string
+	ChallengeToken string
 }
 
+// SignIn はメールアドレスとパスワードを検証し、IDトークンとリフレッシュトークンを返す
+// 2段階認証が有効な場合はIDトークンの代わりに有効期限の短いチャレンジトークンを返し、SignInWithTwoFactor で認証コードを検証してからIDトークンを発行する
+// パスワードの総当たりを防ぐため、失敗が続いたメールアドレスとIPアドレスからのサインインはパスワードが正しくても一時的に拒否する
 func (uc *Authentication) SignIn(ctx context.Context, in *SignInInput) (*SignInOutput, error) {
+	retryAfter, err := uc.SignInGuard.Check(ctx, in.Email, in.IPAddress)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if retryAfter > 0 {
+		return nil, errtrace.Wrap(apierror.TooManySignInAttemptsError(retryAfter))
+	}
+
 	user, err := uc.DB.GetUserByEmail(ctx, in.Email)
 	if err != nil {
 		if errors.Is(err, database.ErrNotFound) {
+			// 登録されているメールアドレスかを推測されないように、存在しないメールアドレスでも失敗を記録する
+			if err := uc.SignInGuard.RecordFailure(ctx, in.Email, in.IPAddress); err != nil {
+				return nil, errtrace.Wrap(err)
+			}
 			return nil, errtrace.Wrap(apierror.InvalidEmailOrPasswordError())
 		}
 		return nil, errtrace.Wrap(err)
 	}
 
 	if err := bcrypt.CompareHashAndPassword([]byte(user.HashedPassword), []byte(in.Password)); err != nil {
+		if err := uc.SignInGuard.RecordFailure(ctx, in.Email, in.IPAddress); err != nil {
+			return nil, errtrace.Wrap(err)
+		}
 		return nil, errtrace.Wrap(apierror.InvalidEmailOrPasswordError())
 	}
+	if err := uc.SignInGuard.RecordSuccess(ctx, in.Email); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	if user.TwoFactorEnabled() {
+		challengeToken, err := uc.issueUserToken(ctx, user, user.Email, domain.UserTokenPurposeTwoFactorChallenge, uc.TwoFactorChallengeExpiration)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		return &SignInOutput{ChallengeToken: challengeToken}, nil
+	}
+
+	authTime := clock.Now(ctx)
+	token, err := uc.Auth.CreateIDToken(ctx, user.ID, authTime)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	refreshToken, err := uc.issueRefreshToken(ctx, user.ID, "", authTime)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &SignInOutput{IDToken: token, RefreshToken: refreshToken}, nil
+}
+
+type SignInWithTwoFactorInput struct {
+	ChallengeToken string
+	Code           string
+}
+
+type SignInWithTwoFactorOutput struct {
+	IDToken      string
+	RefreshToken string
+}
+
+// SignInWithTwoFactor はチャレンジトークンと認証コードを検証し、IDトークンとリフレッシュトークンを返す
+// 認証コードにはTOTPの認証コードか未使用のリカバリーコードを指定できる
+// 認証コードの総当たりを防ぐため、チャレンジトークンは認証コードの検証結果に関わらず1回で使用済みにする
+func (uc *Authentication) SignInWithTwoFactor(ctx context.Context, in *SignInWithTwoFactorInput) (_ *SignInWithTwoFactorOutput, err error) {
+	ut, user, err := uc.getValidUserToken(ctx, domain.UserTokenPurposeTwoFactorChallenge, in.ChallengeToken)
+	if err != nil {
+		if errors.Is(err, errInvalidUserToken) {
+			return nil, errtrace.Wrap(apierror.InvalidTwoFactorChallengeError())
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	if !user.TwoFactorEnabled() {
+		return nil, errtrace.Wrap(apierror.InvalidTwoFactorChallengeError())
+	}
+
+	// 認証コードが誤っていてもチャレンジトークンを使用済みにするため、認証コードの検証とは別にコミットする
+	ok, err := uc.DB.MarkUserTokenUsed(ctx, ut.ID, clock.Now(ctx))
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if !ok {
+		return nil, errtrace.Wrap(apierror.InvalidTwoFactorChallengeError())
+	}
+
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
+	ok, err = uc.verifySecondFactor(ctx, user, in.Code)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if !ok {
+		return nil, errtrace.Wrap(apierror.InvalidTwoFactorChallengeError())
+	}
+
+	authTime := clock.Now(ctx)
+	token, err := uc.Auth.CreateIDToken(ctx, user.ID, authTime)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	refreshToken, err := uc.issueRefreshToken(ctx, user.ID, "", authTime)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &SignInWithTwoFactorOutput{IDToken: token, RefreshToken: refreshToken}, nil
+}
+
+// verifySecondFactor は code がユーザのTOTPの認証コードか未使用のリカバリーコードであれば使用済みにし、検証に成功したかを返す
+func (uc *Authentication) verifySecondFactor(ctx context.Context, user *domain.User, code string) (bool, error) {
+	now := clock.Now(ctx)
+	step, ok, err := totp.Validate(user.TOTPSecret, code, now)
+	if err != nil {
+		return false, errtrace.Wrap(err)
+	}
+	if ok {
+		ok, err := uc.DB.UpdateUserTOTPLastUsedStep(ctx, user.ID, step)
+		if err != nil {
+			return false, errtrace.Wrap(err)
+		}
+		return ok, nil
+	}
+
+	ok, err = uc.DB.MarkRecoveryCodeUsed(ctx, user.ID, auth.HashRecoveryCode(code), now)
+	if err != nil {
+		return false, errtrace.Wrap(err)
+	}
+	return ok, nil
+}
+
+type EnrollTOTPOutput struct {
+	Secret string
+	URI    string
+}
+
+// EnrollTOTP は2段階認証の登録を開始し、認証アプリに登録する秘密鍵とotpauth URIを返す
+// ConfirmTOTP で認証コードを確認するまで2段階認証は有効にならない。登録を再び開始した場合は以前の秘密鍵を破棄する
+func (uc *Authentication) EnrollTOTP(ctx context.Context) (*EnrollTOTPOutput, error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if user.TwoFactorEnabled() {
+		return nil, errtrace.Wrap(apierror.TwoFactorAlreadyEnabledError())
+	}
+
+	secret, err := auth.CreateTOTPSecret(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if err := uc.DB.UpdateUserTOTPSecret(ctx, user.ID, secret); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &EnrollTOTPOutput{Secret: secret, URI: totp.URI(totpIssuer, user.Email, secret)}, nil
+}
+
+type ConfirmTOTPInput struct {
+	Code string
+}
+
+type ConfirmTOTPOutput struct {
+	RecoveryCodes []string
+}
+
+// ConfirmTOTP は認証アプリに表示された認証コードを検証して2段階認証を有効にし、リカバリーコードを返す
+// リカバリーコードの平文はこのときにのみ返す
+func (uc *Authentication) ConfirmTOTP(ctx context.Context, in *ConfirmTOTPInput) (_ *ConfirmTOTPOutput, err error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if user.TwoFactorEnabled() {
+		return nil, errtrace.Wrap(apierror.TwoFactorAlreadyEnabledError())
+	}
+	if user.TOTPSecret == "" {
+		return nil, errtrace.Wrap(apierror.TwoFactorNotEnrolledError())
+	}
+
+	now := clock.Now(ctx)
+	step, ok, err := totp.Validate(user.TOTPSecret, in.Code, now)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if !ok {
+		return nil, errtrace.Wrap(apierror.InvalidTOTPCodeError())
+	}
+
+	codes, err := auth.CreateRecoveryCodes(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	recoveryCodes := make([]domain.RecoveryCode, 0, len(codes))
+	for _, code := range codes {
+		recoveryCodes = append(recoveryCodes, domain.RecoveryCode{
+			UserID:    user.ID,
+			CodeHash:  auth.HashRecoveryCode(code),
+			CreatedAt: now,
+		})
+	}
+
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
+	if err := uc.DB.EnableUserTOTP(ctx, user.ID, now, step); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if err := uc.DB.ReplaceRecoveryCodes(ctx, user.ID, recoveryCodes); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &ConfirmTOTPOutput{RecoveryCodes: codes}, nil
+}
+
+type StartOIDCSignInOutput struct {
+	AuthorizationURL string
+	State            string
+}
+
+// StartOIDCSignIn は外部のIDプロバイダへの認可リクエストを作成し、ユーザを遷移させる認可URLを返す
+// nonce とコード検証子はコールバックで state から引き当てられるようにデータベースに保存し、クライアントには返さない
+func (uc *Authentication) StartOIDCSignIn(ctx context.Context) (*StartOIDCSignInOutput, error) {
+	if uc.OIDC == nil {
+		return nil, errtrace.Wrap(apierror.OIDCNotConfiguredError())
+	}
+
+	params, err := oidc.NewAuthParams(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	now := clock.Now(ctx)
+	if err := uc.DB.CreateOIDCAuthRequest(ctx, &domain.OIDCAuthRequest{
+		ID:           domain.OIDCAuthRequestID(idgen.ULID(ctx)),
+		StateHash:    auth.HashOneTimeToken(params.State),
+		Nonce:        params.Nonce,
+		CodeVerifier: params.CodeVerifier,
+		ExpiresAt:    now.Add(uc.OIDCAuthRequestExpiration),
+		CreatedAt:    now,
+	}); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &StartOIDCSignInOutput{AuthorizationURL: uc.OIDC.AuthorizationURL(params), State: params.State}, nil
+}
+
+type SignInWithOIDCInput struct {
+	State string
+	Code  string
+}
+
+// SignInWithOIDCOutput は SignInOutput と同じく、2段階認証が有効な場合は ChallengeToken のみを、無効な場合は IDToken と RefreshToken のみを持つ
+type SignInWithOIDCOutput struct {
+	IDToken        string
+	RefreshToken   string
+	ChallengeToken string
+}
+
+// SignInWithOIDC は認可コードをIDプロバイダでIDトークンと交換し、IDトークンの外部アカウントに連携したユーザとしてサインインする
+// 初めてサインインする外部アカウントの場合は、同じメールアドレスのユーザと連携するか、パスワードを持たない新しいユーザを作成する
+func (uc *Authentication) SignInWithOIDC(ctx context.Context, in *SignInWithOIDCInput) (_ *SignInWithOIDCOutput, err error) {
+	if uc.OIDC == nil {
+		return nil, errtrace.Wrap(apierror.OIDCNotConfiguredError())
+	}
+
+	req, err := uc.DB.GetOIDCAuthRequestByStateHash(ctx, auth.HashOneTimeToken(in.State))
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, errtrace.Wrap(apierror.InvalidOIDCStateError())
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	now := clock.Now(ctx)
+	if !req.IsValid(now) {
+		return nil, errtrace.Wrap(apierror.InvalidOIDCStateError())
+	}
+	// 認可コードの交換に失敗しても同じ認可リクエストを使い回せないように、交換の前に使用済みにしてコミットする
+	ok, err := uc.DB.MarkOIDCAuthRequestUsed(ctx, req.ID, now)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if !ok {
+		return nil, errtrace.Wrap(apierror.InvalidOIDCStateError())
+	}
+
+	rawIDToken, err := uc.OIDC.Exchange(ctx, in.Code, req.CodeVerifier)
+	if err != nil {
+		if errors.Is(err, oidc.ErrInvalidGrant) || errors.Is(err, oidc.ErrInvalidIDToken) {
+			return nil, errtrace.Wrap(apierror.OIDCAuthenticationFailedError())
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	claims, err := uc.OIDC.VerifyIDToken(ctx, rawIDToken, req.Nonce)
+	if err != nil {
+		if errors.Is(err, oidc.ErrInvalidIDToken) {
+			return nil, errtrace.Wrap(apierror.OIDCAuthenticationFailedError())
+		}
+		return nil, errtrace.Wrap(err)
+	}
+
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
+	user, err := uc.userForOIDCClaims(ctx, claims)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	if user.TwoFactorEnabled() {
+		challengeToken, err := uc.issueUserToken(ctx, user, user.Email, domain.UserTokenPurposeTwoFactorChallenge, uc.TwoFactorChallengeExpiration)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		return &SignInWithOIDCOutput{ChallengeToken: challengeToken}, nil
+	}
+
+	authTime := clock.Now(ctx)
+	token, err := uc.Auth.CreateIDToken(ctx, user.ID, authTime)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	refreshToken, err := uc.issueRefreshToken(ctx, user.ID, "", authTime)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &SignInWithOIDCOutput{IDToken: token, RefreshToken: refreshToken}, nil
+}
+
+// userForOIDCClaims はIDトークンの外部アカウントに連携したユーザを返し、連携していない場合は連携してから返す
+// 連携していない場合は同じメールアドレスのユーザと連携するが、他人のアカウントを乗っ取れないようにIDプロバイダと本サービスの両方でメールアドレスが確認済みの場合に限る
+// 同じメールアドレスのユーザがいない場合は新しいユーザを作成する。作成したユーザはパスワードを持たないため、パスワードの再設定をするまでパスワードではサインインできない
+func (uc *Authentication) userForOIDCClaims(ctx context.Context, claims *oidc.Claims) (*domain.User, error) {
+	now := clock.Now(ctx)
+	identity, err := uc.DB.GetUserIdentity(ctx, uc.OIDC.Issuer(), claims.Subject)
+	if err == nil {
+		if err := uc.DB.UpdateUserIdentityLastUsedAt(ctx, identity.ID, claims.Email, now); err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		user, err := uc.DB.GetUserByID(ctx, identity.UserID)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		return user, nil
+	}
+	if !errors.Is(err, database.ErrNotFound) {
+		return nil, errtrace.Wrap(err)
+	}
+
+	if claims.Email == "" {
+		return nil, errtrace.Wrap(apierror.OIDCEmailRequiredError())
+	}
+	user, err := uc.DB.GetUserByEmail(ctx, claims.Email)
+	switch {
+	case err == nil:
+		if !claims.EmailVerified || user.EmailVerifiedAt == nil {
+			return nil, errtrace.Wrap(apierror.OIDCEmailConflictError())
+		}
+	case errors.Is(err, database.ErrNotFound):
+		user = &domain.User{ID: domain.UserID(idgen.ULID(ctx)), Email: claims.Email}
+		if err := uc.DB.CreateUser(ctx, user); err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		if claims.EmailVerified {
+			if err := uc.DB.UpdateUserEmailVerifiedAt(ctx, user.ID, now); err != nil {
+				return nil, errtrace.Wrap(err)
+			}
+			user.EmailVerifiedAt = &now
+		} else if err := uc.sendEmailVerification(ctx, user, user.Email); err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+	default:
+		return nil, errtrace.Wrap(err)
+	}
+
+	if err := uc.DB.CreateUserIdentity(ctx, &domain.UserIdentity{
+		ID:         domain.UserIdentityID(idgen.ULID(ctx)),
+		UserID:     user.ID,
+		Issuer:     uc.OIDC.Issuer(),
+		Subject:    claims.Subject,
+		Email:      claims.Email,
+		LastUsedAt: &now,
+		CreatedAt:  now,
+	}); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return user, nil
+}
+
+type RefreshTokenInput struct {
+	RefreshToken string
+}
+
+type RefreshTokenOutput struct {
+	IDToken      string
+	RefreshToken string
+}
+
+// errRefreshTokenReused は使用済みのリフレッシュトークンが再び使われたことを表す
+var errRefreshTokenReused = errors.New("refresh token reused")
+
+// RefreshToken はリフレッシュトークンを使用済みにし、新しいIDトークンと同じファミリーの新しいリフレッシュトークンを返す
+// 使用済みのトークンが再び使われた場合は漏洩したものとみなし、ファミリーのトークンと発行済みのIDトークンをすべて無効にする
+func (uc *Authentication) RefreshToken(ctx context.Context, in *RefreshTokenInput) (*RefreshTokenOutput, error) {
+	rt, err := uc.DB.GetRefreshTokenByHash(ctx, auth.HashRefreshToken(in.RefreshToken))
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, errtrace.Wrap(apierror.InvalidRefreshTokenError())
+		}
+		return nil, errtrace.Wrap(err)
+	}
+
+	now := clock.Now(ctx)
+	if rt.RevokedAt != nil || rt.IsExpired(now) {
+		return nil, errtrace.Wrap(apierror.InvalidRefreshTokenError())
+	}
+
+	out, err := uc.rotateRefreshToken(ctx, rt)
+	if err != nil {
+		// ファミリーの失効は再発行のトランザクションとは別にコミットする
+		if errors.Is(err, errRefreshTokenReused) {
+			if err := uc.revokeAll(ctx, rt); err != nil {
+				return nil, errtrace.Wrap(err)
+			}
+			return nil, errtrace.Wrap(apierror.InvalidRefreshTokenError())
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	return out, nil
+}
+
+func (uc *Authentication) rotateRefreshToken(ctx context.Context, rt *domain.RefreshToken) (_ *RefreshTokenOutput, err error) {
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
+	ok, err := uc.DB.MarkRefreshTokenUsed(ctx, rt.ID, clock.Now(ctx))
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if !ok {
+		return nil, errtrace.Wrap(errRefreshTokenReused)
+	}
+
+	// 再発行しても認証した日時は変わらないため、ファミリーの最初のトークンを発行したときの日時を引き継ぐ
+	var authTime time.Time
+	if rt.AuthenticatedAt != nil {
+		authTime = *rt.AuthenticatedAt
+	}
+	token, err := uc.Auth.CreateIDToken(ctx, rt.UserID, authTime)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	refreshToken, err := uc.issueRefreshToken(ctx, rt.UserID, rt.FamilyID, authTime)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &RefreshTokenOutput{IDToken: token, RefreshToken: refreshToken}, nil
+}
+
+type SignOutInput struct {
+	RefreshToken string
+}
+
+// SignOut はリフレッシュトークンのファミリーと発行済みのIDトークンをすべて無効にする
+// 他の端末のリフレッシュトークンは有効なままのため、他の端末ではIDトークンを再発行すれば引き続き利用できる
+// 無効なリフレッシュトークンを指定した場合も、既にサインアウトしているものとして成功とする
+func (uc *Authentication) SignOut(ctx context.Context, in *SignOutInput) error {
+	rt, err := uc.DB.GetRefreshTokenByHash(ctx, auth.HashRefreshToken(in.RefreshToken))
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil
+		}
+		return errtrace.Wrap(err)
+	}
+	if err := uc.revokeAll(ctx, rt); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+type RequestPasswordResetInput struct {
+	Email string
+}
+
+// RequestPasswordReset はパスワード再設定用のURLをメールで送る
+// メールアドレスが登録されているかを推測されないように、ユーザが存在しない場合も何もせずに成功とする
+// 以前に送ったパスワード再設定用のURLは無効にする
+// 応答時間からも推測されないように、ユーザの有無に関わらず PasswordResetResponseTime が経過するまで待ってから返る
+func (uc *Authentication) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetInput) (err error) {
+	start := clock.Now(ctx)
+	defer func() {
+		remaining := uc.PasswordResetResponseTime - clock.Now(ctx).Sub(start)
+		if remaining <= 0 {
+			return
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(remaining):
+		}
+	}()
+
+	user, err := uc.DB.GetUserByEmail(ctx, in.Email)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil
+		}
+		return errtrace.Wrap(err)
+	}
+
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
+	if err := uc.DB.InvalidateUserTokens(ctx, user.ID, domain.UserTokenPurposePasswordReset, clock.Now(ctx)); err != nil {
+		return errtrace.Wrap(err)
+	}
+	token, err := uc.issueUserToken(ctx, user, user.Email, domain.UserTokenPurposePasswordReset, uc.PasswordResetTokenExpiration)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	uc.sendMail(ctx, &mail.Message{
+		To:      user.Email,
+		Subject: "【Harmattan】パスワードの再設定",
+		Body: "パスワードの再設定が申請されました。\n" +
+			"以下のURLにアクセスして、新しいパスワードを設定してください。\n\n" +
+			uc.WebURL + "/password-reset?token=" + url.QueryEscape(token) + "\n\n" +
+			"このURLは一定時間が経過すると無効になります。\n" +
+			"心当たりがない場合は、このメールを破棄してください。パスワードは変更されません。\n",
+	})
+	return nil
+}
+
+type ConfirmPasswordResetInput struct {
+	Token    string
+	Password string
+}
+
+// ConfirmPasswordReset はパスワード再設定用のトークンを使用済みにしてパスワードを変更する
+// パスワードを変更した後は、すべての端末のリフレッシュトークンと発行済みのIDトークン、パーソナルアクセストークンを無効にする
+// メールで受け取ったトークンを使うため、メールアドレスが未確認の場合は確認済みにする
+func (uc *Authentication) ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetInput) (err error) {
+	ut, user, err := uc.getValidUserToken(ctx, domain.UserTokenPurposePasswordReset, in.Token)
+	if err != nil {
+		if errors.Is(err, errInvalidUserToken) {
+			return errtrace.Wrap(apierror.InvalidPasswordResetTokenError())
+		}
+		return errtrace.Wrap(err)
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(in.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
+	now := clock.Now(ctx)
+	ok, err := uc.DB.MarkUserTokenUsed(ctx, ut.ID, now)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	if !ok {
+		return errtrace.Wrap(apierror.InvalidPasswordResetTokenError())
+	}
+
+	if err := uc.DB.UpdateUserPassword(ctx, user.ID, string(hashedPassword)); err != nil {
+		return errtrace.Wrap(err)
+	}
+	if user.EmailVerifiedAt == nil {
+		if err := uc.DB.UpdateUserEmailVerifiedAt(ctx, user.ID, now); err != nil {
+			return errtrace.Wrap(err)
+		}
+	}
+	if err := uc.DB.RevokeUserRefreshTokens(ctx, user.ID, now); err != nil {
+		return errtrace.Wrap(err)
+	}
+	if err := uc.DB.UpdateUserTokensValidAfter(ctx, user.ID, now); err != nil {
+		return errtrace.Wrap(err)
+	}
+	if err := uc.DB.DeleteUserPersonalAccessTokens(ctx, user.ID); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+type VerifyEmailInput struct {
+	Token string
+}
+
+// VerifyEmail はメールアドレス確認用のトークンを使用済みにしてユーザのメールアドレスを確認済みにする
+// 変更待ちのメールアドレスに送ったトークンの場合は、メールアドレスを変更して変更前のメールアドレスに通知する
+func (uc *Authentication) VerifyEmail(ctx context.Context, in *VerifyEmailInput) (err error) {
+	ut, user, err := uc.getValidUserToken(ctx, domain.UserTokenPurposeEmailVerification, in.Token)
+	if err != nil {
+		if errors.Is(err, errInvalidUserToken) {
+			return errtrace.Wrap(apierror.InvalidEmailVerificationTokenError())
+		}
+		return errtrace.Wrap(err)
+	}
+
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
+	now := clock.Now(ctx)
+	ok, err := uc.DB.MarkUserTokenUsed(ctx, ut.ID, now)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	if !ok {
+		return errtrace.Wrap(apierror.InvalidEmailVerificationTokenError())
+	}
+	if ut.Email != user.Email {
+		if err := uc.applyPendingEmail(ctx, user, ut.Email, now); err != nil {
+			return errtrace.Wrap(err)
+		}
+		return nil
+	}
+	if user.EmailVerifiedAt == nil {
+		if err := uc.DB.UpdateUserEmailVerifiedAt(ctx, user.ID, now); err != nil {
+			return errtrace.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// applyPendingEmail はユーザのメールアドレスを確認が完了した変更待ちのメールアドレス email に変更する
+func (uc *Authentication) applyPendingEmail(ctx context.Context, user *domain.User, email string, now time.Time) error {
+	// 変更を申請した後に同じメールアドレスで他のユーザが登録している場合は変更しない
+	if _, err := uc.DB.GetUserByEmail(ctx, email); err == nil {
+		return errtrace.Wrap(apierror.DuplicateUserEmailError())
+	} else if !errors.Is(err, database.ErrNotFound) {
+		return errtrace.Wrap(err)
+	}
+
+	if err := uc.DB.UpdateUserEmail(ctx, user.ID, email, now); err != nil {
+		return errtrace.Wrap(err)
+	}
+	uc.sendMail(ctx, &mail.Message{
+		To:      user.Email,
+		Subject: "【Harmattan】メールアドレスの変更",
+		Body: "アカウントのメールアドレスが " + email + " に変更されました。\n" +
+			"心当たりがない場合は、パスワードの再設定を行ってください。\n",
+	})
+	return nil
+}
+
+type UserOutput struct {
+	User *domain.User
+}
+
+// GetMe はリクエストしたユーザのプロフィールを返す
+func (uc *Authentication) GetMe(ctx context.Context) (*UserOutput, error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &UserOutput{User: user}, nil
+}
+
+type UpdateEmailInput struct {
+	Email    string
+	Password string
+}
+
+// UpdateEmail は本人であることを確認して新しいメールアドレスを変更待ちとして保存し、新しいメールアドレスに確認用のURLを送る
+// メールアドレスは VerifyEmail で確認が完了するまで変更しないため、入力を誤っても変更前のメールアドレスでサインインできる
+func (uc *Authentication) UpdateEmail(ctx context.Context, in *UpdateEmailInput) (_ *UserOutput, err error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if err := uc.verifyIdentity(ctx, user, in.Password); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if in.Email == user.Email {
+		return &UserOutput{User: user}, nil
+	}
 
-	token, err := uc.Auth.CreateIDToken(ctx, user.ID)
+	existingUser, err := uc.DB.GetUserByEmail(ctx, in.Email)
+	if err != nil && !errors.Is(err, database.ErrNotFound) {
+		return nil, errtrace.Wrap(err)
+	}
+	if existingUser != nil {
+		return nil, errtrace.Wrap(apierror.DuplicateUserEmailError())
+	}
+
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
+	if err := uc.DB.UpdateUserPendingEmail(ctx, user.ID, in.Email); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	updatedUser, err := uc.DB.GetUserByID(ctx, user.ID)
 	if err != nil {
 		return nil, errtrace.Wrap(err)
 	}
-	return &SignInOutput{IDToken: token}, nil
+	if err := uc.sendEmailVerification(ctx, updatedUser, in.Email); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &UserOutput{User: updatedUser}, nil
+}
+
+type UpdateLanguageInput struct {
+	// Language は空文字列の場合に言語の設定を解除する
+	Language domain.Language
+}
+
+// UpdateLanguage はエラーメッセージなどに使う言語を変更する
+func (uc *Authentication) UpdateLanguage(ctx context.Context, in *UpdateLanguageInput) (*UserOutput, error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	if err := uc.DB.UpdateUserLanguage(ctx, user.ID, in.Language); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	updatedUser, err := uc.DB.GetUserByID(ctx, user.ID)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &UserOutput{User: updatedUser}, nil
+}
+
+type ChangePasswordInput struct {
+	CurrentPassword string
+	NewPassword     string
+}
+
+type ChangePasswordOutput struct {
+	IDToken      string
+	RefreshToken string
+}
+
+// ChangePassword は本人であることを確認してパスワードを変更し、パスワードを設定していないユーザは最初のパスワードを設定する
+// パスワードを変更した後は、すべての端末のリフレッシュトークンと発行済みのIDトークン、パーソナルアクセストークンを無効にし、リクエストした端末で使う新しいトークンを返す
+func (uc *Authentication) ChangePassword(ctx context.Context, in *ChangePasswordInput) (_ *ChangePasswordOutput, err error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if err := uc.verifyIdentity(ctx, user, in.CurrentPassword); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(in.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
+	now := clock.Now(ctx)
+	if err := uc.DB.UpdateUserPassword(ctx, user.ID, string(hashedPassword)); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if err := uc.DB.RevokeUserRefreshTokens(ctx, user.ID, now); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if err := uc.DB.UpdateUserTokensValidAfter(ctx, user.ID, now); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if err := uc.DB.DeleteUserPersonalAccessTokens(ctx, user.ID); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	// 本人であることを確認したため、認証した日時を現在の日時とする
+	token, err := uc.Auth.CreateIDToken(ctx, user.ID, now)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	refreshToken, err := uc.issueRefreshToken(ctx, user.ID, "", now)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &ChangePasswordOutput{IDToken: token, RefreshToken: refreshToken}, nil
+}
+
+type DeleteAccountInput struct {
+	Password string
+}
+
+// DeleteAccount は本人であることを確認して、ユーザとユーザが所有するすべてのデータを削除する
+// ユーザが存在しなくなるため、発行済みのIDトークン・リフレッシュトークン・パーソナルアクセストークンはすべて使えなくなる
+func (uc *Authentication) DeleteAccount(ctx context.Context, in *DeleteAccountInput) error {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	if err := uc.verifyIdentity(ctx, user, in.Password); err != nil {
+		return errtrace.Wrap(err)
+	}
+
+	if err := uc.DB.DeleteUser(ctx, user.ID); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// verifyIdentity はパスワードを確認して、リクエストしたユーザが本人であることを確認する
+// パスワードを設定していないユーザは、パスワードの代わりに ReauthenticationMaxAge 以内にサインインしたことを確認する
+func (uc *Authentication) verifyIdentity(ctx context.Context, user *domain.User, password string) error {
+	if user.HashedPassword != "" {
+		// 盗まれたIDトークンでパスワードを総当たりされないように、サインインと同じくメールアドレスごとに失敗を制限する
+		retryAfter, err := uc.SignInGuard.Check(ctx, user.Email, "")
+		if err != nil {
+			return errtrace.Wrap(err)
+		}
+		if retryAfter > 0 {
+			return errtrace.Wrap(apierror.TooManySignInAttemptsError(retryAfter))
+		}
+		if err := bcrypt.CompareHashAndPassword([]byte(user.HashedPassword), []byte(password)); err != nil {
+			if err := uc.SignInGuard.RecordFailure(ctx, user.Email, ""); err != nil {
+				return errtrace.Wrap(err)
+			}
+			return errtrace.Wrap(apierror.IncorrectPasswordError())
+		}
+		if err := uc.SignInGuard.RecordSuccess(ctx, user.Email); err != nil {
+			return errtrace.Wrap(err)
+		}
+		return nil
+	}
+	if authTime := domain.AuthTimeFromContext(ctx); authTime.IsZero() || clock.Now(ctx).Sub(authTime) > uc.ReauthenticationMaxAge {
+		return errtrace.Wrap(apierror.ReauthenticationRequiredError())
+	}
+	return nil
+}
+
+type GetJWKSOutput struct {
+	Keys []auth.JWK
+}
+
+// GetJWKS は署名用・検証専用のすべての鍵の公開鍵を返す
+func (uc *Authentication) GetJWKS(_ context.Context) *GetJWKSOutput {
+	return &GetJWKSOutput{Keys: uc.Auth.JWKs()}
+}
+
+// issueRefreshToken はリフレッシュトークンを発行して平文を返す
+// familyID が空の場合は発行するトークンを新しいファミリーの最初のトークンとする
+// authTime はユーザが認証した日時であり、ゼロ値の場合は記録しない
+func (uc *Authentication) issueRefreshToken(ctx context.Context, userID domain.UserID, familyID domain.RefreshTokenID, authTime time.Time) (string, error) {
+	token, expiresAt, err := uc.Auth.CreateRefreshToken(ctx)
+	if err != nil {
+		return "", errtrace.Wrap(err)
+	}
+
+	id := domain.RefreshTokenID(idgen.ULID(ctx))
+	if familyID == "" {
+		familyID = id
+	}
+	rt := &domain.RefreshToken{
+		ID:        id,
+		FamilyID:  familyID,
+		UserID:    userID,
+		TokenHash: auth.HashRefreshToken(token),
+		ExpiresAt: expiresAt,
+		CreatedAt: clock.Now(ctx),
+	}
+	if !authTime.IsZero() {
+		rt.AuthenticatedAt = &authTime
+	}
+	if err := uc.DB.CreateRefreshToken(ctx, rt); err != nil {
+		return "", errtrace.Wrap(err)
+	}
+	return token, nil
+}
+
+// revokeAll はリフレッシュトークンのファミリーを失効させ、ユーザの発行済みのIDトークンを無効にする
+func (uc *Authentication) revokeAll(ctx context.Context, rt *domain.RefreshToken) (err error) {
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
+	now := clock.Now(ctx)
+	if err := uc.DB.RevokeRefreshTokenFamily(ctx, rt.FamilyID, now); err != nil {
+		return errtrace.Wrap(err)
+	}
+	if err := uc.DB.UpdateUserTokensValidAfter(ctx, rt.UserID, now); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// errInvalidUserToken はメールで送ったトークンが存在しないか、既に無効であることを表す
+var errInvalidUserToken = errors.New("invalid user token")
+
+// getValidUserToken は平文が token で用途が purpose の有効なトークンとその発行先のユーザを返す
+func (uc *Authentication) getValidUserToken(ctx context.Context, purpose domain.UserTokenPurpose, token string) (*domain.UserToken, *domain.User, error) {
+	ut, err := uc.DB.GetUserTokenByHash(ctx, purpose, auth.HashOneTimeToken(token))
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil, errtrace.Wrap(errInvalidUserToken)
+		}
+		return nil, nil, errtrace.Wrap(err)
+	}
+	user, err := uc.DB.GetUserByID(ctx, ut.UserID)
+	if err != nil {
+		return nil, nil, errtrace.Wrap(err)
+	}
+	if !ut.IsValidFor(user, clock.Now(ctx)) {
+		return nil, nil, errtrace.Wrap(errInvalidUserToken)
+	}
+	return ut, user, nil
+}
+
+// issueUserToken はユーザのメールアドレス email に送るトークンを発行して平文を返す
+func (uc *Authentication) issueUserToken(ctx context.Context, user *domain.User, email string, purpose domain.UserTokenPurpose, expiration time.Duration) (string, error) {
+	token, err := auth.CreateOneTimeToken(ctx)
+	if err != nil {
+		return "", errtrace.Wrap(err)
+	}
+
+	now := clock.Now(ctx)
+	if err := uc.DB.CreateUserToken(ctx, &domain.UserToken{
+		ID:        domain.UserTokenID(idgen.ULID(ctx)),
+		UserID:    user.ID,
+		Purpose:   purpose,
+		Email:     email,
+		TokenHash: auth.HashOneTimeToken(token),
+		ExpiresAt: now.Add(expiration),
+		CreatedAt: now,
+	}); err != nil {
+		return "", errtrace.Wrap(err)
+	}
+	return token, nil
+}
+
+// sendEmailVerification はメールアドレス確認用のURLをユーザのメールアドレス email に送る
+func (uc *Authentication) sendEmailVerification(ctx context.Context, user *domain.User, email string) error {
+	token, err := uc.issueUserToken(ctx, user, email, domain.UserTokenPurposeEmailVerification, uc.EmailVerificationTokenExpiration)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	uc.sendMail(ctx, &mail.Message{
+		To:      email,
+		Subject: "【Harmattan】メールアドレスの確認",
+		Body: "以下のURLにアクセスして、メールアドレスの確認を完了してください。\n\n" +
+			uc.WebURL + "/email/verify?token=" + url.QueryEscape(token) + "\n\n" +
+			"このURLは一定時間が経過すると無効になります。\n" +
+			"心当たりがない場合は、このメールを破棄してください。\n",
+	})
+	return nil
+}
+
+// sendMail はトランザクションのコミット後にメールを送る
+// コミットした変更は取り消せないため、送信に失敗した場合はログに記録するのみとする
+func (uc *Authentication) sendMail(ctx context.Context, msg *mail.Message) {
+	uc.DB.AfterCommit(ctx, func() {
+		if err := uc.Mailer.Send(ctx, msg); err != nil {
+			atel.ErrorLog(ctx, "Failed to send mail", err)
+		}
+	})
 }
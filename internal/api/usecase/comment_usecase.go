@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/minguu42/harmattan/internal/api/apierror"
+	"github.com/minguu42/harmattan/internal/cursor"
+	"github.com/minguu42/harmattan/internal/database"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/clock"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+	"github.com/minguu42/harmattan/internal/lib/idgen"
+)
+
+type Comment struct {
+	Cursor *cursor.Codec
+	DB     *database.Client
+}
+
+type CommentOutput struct {
+	Comment *domain.Comment
+}
+
+// getComment はタスク taskID に付いたユーザのコメントを、タスクへの書き込み権限を確認してから取得する
+// コメントが存在しない場合、他のユーザのコメントの場合と別のタスクのコメントの場合は CommentNotFoundError を返す
+func (uc *Comment) getComment(ctx context.Context, user *domain.User, taskID domain.TaskID, id domain.CommentID) (*domain.Comment, error) {
+	if _, err := authorizeTask(ctx, uc.DB, user, taskID, domain.PermissionWrite, apierror.TaskNotFoundError()); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	c, err := uc.DB.GetCommentByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, errtrace.Wrap(apierror.CommentNotFoundError())
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	if !user.HasComment(c) || c.TaskID != taskID {
+		return nil, errtrace.Wrap(apierror.CommentNotFoundError())
+	}
+	return c, nil
+}
+
+type CreateCommentInput struct {
+	TaskID  domain.TaskID
+	Content string
+}
+
+func (uc *Comment) CreateComment(ctx context.Context, in *CreateCommentInput) (_ *CommentOutput, err error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
+	if _, err := authorizeTask(ctx, uc.DB, user, in.TaskID, domain.PermissionWrite, apierror.TaskNotFoundError()); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	count, err := uc.DB.CountComments(ctx, in.TaskID)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if count >= domain.MaxCommentsPerTask {
+		return nil, errtrace.Wrap(apierror.TooManyCommentsError())
+	}
+
+	now := clock.Now(ctx)
+	c := domain.Comment{
+		ID:        domain.CommentID(idgen.ULID(ctx)),
+		UserID:    &user.ID,
+		TaskID:    in.TaskID,
+		Content:   in.Content,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := uc.DB.CreateComment(ctx, &c); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &CommentOutput{Comment: &c}, nil
+}
+
+type ListCommentsInput struct {
+	TaskID domain.TaskID
+	Limit  int
+	Offset int
+	Cursor string
+}
+
+type ListCommentsOutput struct {
+	Comments   domain.Comments
+	HasNext    bool
+	NextCursor string
+}
+
+func (uc *Comment) ListComments(ctx context.Context, in *ListCommentsInput) (*ListCommentsOutput, error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	if _, err := authorizeTask(ctx, uc.DB, user, in.TaskID, domain.PermissionRead, apierror.TaskNotFoundError()); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	scope := "comments:" + string(in.TaskID)
+	after, err := decodeCursor(uc.Cursor, in.Cursor, scope)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	cs, err := uc.DB.ListComments(ctx, in.TaskID, after, in.Limit+1, in.Offset)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	hasNext := false
+	var nextCursor string
+	if len(cs) == in.Limit+1 {
+		cs = cs[:in.Limit]
+		hasNext = true
+		nextCursor, err = encodeCursor(uc.Cursor, database.CommentCursor(&cs[len(cs)-1]), scope)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+	}
+	return &ListCommentsOutput{Comments: cs, HasNext: hasNext, NextCursor: nextCursor}, nil
+}
+
+type UpdateCommentInput struct {
+	TaskID  domain.TaskID
+	ID      domain.CommentID
+	Content Option[string]
+}
+
+func (uc *Comment) UpdateComment(ctx context.Context, in *UpdateCommentInput) (_ *CommentOutput, err error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
+	c, err := uc.getComment(ctx, user, in.TaskID, in.ID)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	if in.Content.Valid {
+		c.Content = in.Content.V
+	}
+	c.UpdatedAt = clock.Now(ctx)
+	if err := uc.DB.UpdateComment(ctx, c); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &CommentOutput{Comment: c}, nil
+}
+
+type DeleteCommentInput struct {
+	TaskID domain.TaskID
+	ID     domain.CommentID
+}
+
+func (uc *Comment) DeleteComment(ctx context.Context, in *DeleteCommentInput) (err error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
+	c, err := uc.getComment(ctx, user, in.TaskID, in.ID)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+
+	if err := uc.DB.DeleteCommentByID(ctx, c.ID); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
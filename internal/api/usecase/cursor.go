@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"github.com/minguu42/harmattan/internal/api/apierror"
+	"github.com/minguu42/harmattan/internal/cursor"
+	"github.com/minguu42/harmattan/internal/database"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+// pageCursor はクライアントに返すカーソルの内容である
+// Scope はカーソルを発行した一覧とその並び順を表し、別の一覧や並び順でカーソルが使われることを防ぐ
+type pageCursor struct {
+	Scope string `json:"scope"`
+	database.Cursor
+}
+
+// decodeCursor はクライアントから受け取ったカーソル s を復元する
+// s が空の場合は nil を返す
+func decodeCursor(codec *cursor.Codec, s, scope string) (*database.Cursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	var c pageCursor
+	if err := codec.Decode(s, &c); err != nil {
+		return nil, errtrace.Wrap(apierror.InvalidCursorError())
+	}
+	if c.Scope != scope {
+		return nil, errtrace.Wrap(apierror.InvalidCursorError())
+	}
+	return &c.Cursor, nil
+}
+
+func encodeCursor(codec *cursor.Codec, c *database.Cursor, scope string) (string, error) {
+	s, err := codec.Encode(pageCursor{Scope: scope, Cursor: *c})
+	if err != nil {
+		return "", errtrace.Wrap(err)
+	}
+	return s, nil
+}
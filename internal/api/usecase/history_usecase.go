@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/minguu42/harmattan/internal/api/apierror"
+	"github.com/minguu42/harmattan/internal/cursor"
+	"github.com/minguu42/harmattan/internal/database"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+// recordHistory は変更の履歴を記録する
+// 履歴は変更と同じトランザクションで記録し、e が nil の場合は何もしない
+func recordHistory(ctx context.Context, db *database.Client, e *domain.HistoryEntry) error {
+	if e == nil {
+		return nil
+	}
+	if err := db.CreateHistoryEntry(ctx, e); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+type History struct {
+	Cursor *cursor.Codec
+	DB     *database.Client
+}
+
+type ListHistoryOutput struct {
+	Entries    domain.HistoryEntries
+	HasNext    bool
+	NextCursor string
+}
+
+type ListProjectHistoryInput struct {
+	ProjectID domain.ProjectID
+	Limit     int
+	Offset    int
+	Cursor    string
+}
+
+func (uc *History) ListProjectHistory(ctx context.Context, in *ListProjectHistoryInput) (*ListHistoryOutput, error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	p, err := authorizeProject(ctx, uc.DB, user, in.ProjectID, domain.PermissionRead, apierror.ProjectNotFoundError())
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	scope := "history:project:" + string(p.ID)
+	after, err := decodeCursor(uc.Cursor, in.Cursor, scope)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	es, err := uc.DB.ListProjectHistoryEntries(ctx, p.ID, after, in.Limit+1, in.Offset)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return uc.paginate(es, in.Limit, scope)
+}
+
+type ListTaskHistoryInput struct {
+	TaskID domain.TaskID
+	Limit  int
+	Offset int
+	Cursor string
+}
+
+func (uc *History) ListTaskHistory(ctx context.Context, in *ListTaskHistoryInput) (*ListHistoryOutput, error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	task, err := authorizeTask(ctx, uc.DB, user, in.TaskID, domain.PermissionRead, apierror.TaskNotFoundError())
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	scope := "history:task:" + string(task.ID)
+	after, err := decodeCursor(uc.Cursor, in.Cursor, scope)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	es, err := uc.DB.ListTaskHistoryEntries(ctx, task.ID, after, in.Limit+1, in.Offset)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return uc.paginate(es, in.Limit, scope)
+}
+
+// paginate は limit+1 件取得した履歴 es を limit 件に切り詰め、次のページがある場合はカーソルを発行する
+func (uc *History) paginate(es domain.HistoryEntries, limit int, scope string) (*ListHistoryOutput, error) {
+	hasNext := false
+	var nextCursor string
+	if len(es) == limit+1 {
+		es = es[:limit]
+		hasNext = true
+		var err error
+		nextCursor, err = encodeCursor(uc.Cursor, database.HistoryEntryCursor(&es[len(es)-1]), scope)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+	}
+	return &ListHistoryOutput{Entries: es, HasNext: hasNext, NextCursor: nextCursor}, nil
+}
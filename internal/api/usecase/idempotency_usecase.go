@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/api/apierror"
+	"github.com/minguu42/harmattan/internal/database"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/clock"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+type Idempotency struct {
+	DB         *database.Client
+	Expiration time.Duration
+	// Lease は処理中のキーを保持する期間であり、過ぎたキーはリクエストの処理が中断されたとみなして同じキーで再び処理できる
+	Lease time.Duration
+}
+
+type ReserveIdempotencyKeyInput struct {
+	Key         string
+	RequestHash string
+}
+
+type ReserveIdempotencyKeyOutput struct {
+	// Response は同じキーのリクエストを処理済みの場合に返すレスポンスボディであり、nil の場合は呼び出し元でリクエストを処理する
+	Response []byte
+}
+
+// ReserveIdempotencyKey はキーを処理中として保存し、同じキーのリクエストを処理済みの場合は保存したレスポンスボディを返す
+// キーを保存できた場合、呼び出し元はリクエストの処理後に CompleteIdempotencyKey か ReleaseIdempotencyKey を呼ぶ
+func (uc *Idempotency) ReserveIdempotencyKey(ctx context.Context, in *ReserveIdempotencyKeyInput) (*ReserveIdempotencyKeyOutput, error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	now := clock.Now(ctx)
+	reserved, err := uc.DB.ReserveIdempotencyKey(ctx, &domain.IdempotencyKey{
+		UserID:      user.ID,
+		Key:         in.Key,
+		RequestHash: in.RequestHash,
+		ExpiresAt:   now.Add(uc.Expiration),
+		CreatedAt:   now,
+	}, now.Add(-uc.Lease))
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if reserved {
+		return &ReserveIdempotencyKeyOutput{}, nil
+	}
+
+	k, err := uc.DB.GetIdempotencyKey(ctx, user.ID, in.Key)
+	if err != nil {
+		// 保存済みのキーを読み込む前に、処理中だった同じキーのリクエストが失敗してキーを削除した
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, errtrace.Wrap(apierror.IdempotencyKeyInProgressError())
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	if k.RequestHash != in.RequestHash {
+		return nil, errtrace.Wrap(apierror.IdempotencyKeyReusedError())
+	}
+	if !k.IsCompleted() {
+		return nil, errtrace.Wrap(apierror.IdempotencyKeyInProgressError())
+	}
+	return &ReserveIdempotencyKeyOutput{Response: k.Response}, nil
+}
+
+// CompleteIdempotencyKey は処理に成功したリクエストのレスポンスボディを保存する
+func (uc *Idempotency) CompleteIdempotencyKey(ctx context.Context, key string, response []byte) error {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+
+	if err := uc.DB.CompleteIdempotencyKey(ctx, user.ID, key, response); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// ReleaseIdempotencyKey は処理に失敗したリクエストのキーを削除し、同じキーで再試行できるようにする
+func (uc *Idempotency) ReleaseIdempotencyKey(ctx context.Context, key string) error {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+
+	if err := uc.DB.DeleteIdempotencyKey(ctx, user.ID, key); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// PurgeIdempotencyKeys は有効期限を過ぎたキーを削除する
+func (uc *Idempotency) PurgeIdempotencyKeys(ctx context.Context) error {
+	if err := uc.DB.DeleteExpiredIdempotencyKeys(ctx, clock.Now(ctx)); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,354 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/minguu42/harmattan/internal/api/apierror"
+	"github.com/minguu42/harmattan/internal/database"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/clock"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+	"github.com/minguu42/harmattan/internal/lib/idgen"
+)
+
+type Member struct {
+	DB *database.Client
+}
+
+type ProjectMemberOutput struct {
+	Member *domain.ProjectMember
+}
+
+type ProjectInvitationOutput struct {
+	Invitation *domain.ProjectInvitation
+}
+
+type ListProjectMembersInput struct {
+	ProjectID domain.ProjectID
+}
+
+type ListProjectMembersOutput struct {
+	Members     domain.ProjectMembers
+	Invitations domain.ProjectInvitations
+}
+
+func (uc *Member) ListProjectMembers(ctx context.Context, in *ListProjectMembersInput) (*ListProjectMembersOutput, error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	p, err := authorizeProject(ctx, uc.DB, user, in.ProjectID, domain.PermissionRead, apierror.ProjectNotFoundError())
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	// オーナーはメンバーとして保存していないため、プロジェクトの作成日時に参加したものとして先頭に加える
+	owner, err := uc.DB.GetUserByID(ctx, p.UserID)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	ms, err := uc.DB.ListProjectMembers(ctx, p.ID)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	members := append(domain.ProjectMembers{{
+		ProjectID: p.ID,
+		UserID:    owner.ID,
+		Email:     owner.Email,
+		Role:      domain.ProjectRoleOwner,
+		CreatedAt: p.CreatedAt,
+		UpdatedAt: p.CreatedAt,
+	}}, ms...)
+
+	is, err := uc.DB.ListProjectInvitations(ctx, p.ID)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &ListProjectMembersOutput{Members: members, Invitations: is}, nil
+}
+
+type InviteProjectMemberInput struct {
+	ProjectID domain.ProjectID
+	Email     string
+	Role      domain.ProjectRole
+}
+
+func (uc *Member) InviteProjectMember(ctx context.Context, in *InviteProjectMemberInput) (_ *ProjectInvitationOutput, err error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
+	p, err := authorizeProject(ctx, uc.DB, user, in.ProjectID, domain.PermissionManage, apierror.ProjectNotFoundError())
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	// 招待するメールアドレスのユーザが未登録の場合は、登録後に招待を承諾できる
+	invitee, err := uc.DB.GetUserByEmail(ctx, in.Email)
+	if err != nil && !errors.Is(err, database.ErrNotFound) {
+		return nil, errtrace.Wrap(err)
+	}
+	if invitee != nil {
+		if invitee.ID == p.UserID {
+			return nil, errtrace.Wrap(apierror.AlreadyProjectMemberError())
+		}
+		if _, err := uc.DB.GetProjectMember(ctx, p.ID, invitee.ID); err == nil {
+			return nil, errtrace.Wrap(apierror.AlreadyProjectMemberError())
+		} else if !errors.Is(err, database.ErrNotFound) {
+			return nil, errtrace.Wrap(err)
+		}
+	}
+
+	exists, err := uc.DB.ExistsProjectInvitation(ctx, p.ID, in.Email)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if exists {
+		return nil, errtrace.Wrap(apierror.DuplicateInvitationError())
+	}
+
+	count, err := uc.DB.CountProjectMembers(ctx, p.ID)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if count >= domain.MaxMembersPerProject {
+		return nil, errtrace.Wrap(apierror.TooManyMembersError())
+	}
+
+	i := domain.ProjectInvitation{
+		ID:          domain.ProjectInvitationID(idgen.ULID(ctx)),
+		ProjectID:   p.ID,
+		ProjectName: p.Name,
+		Email:       in.Email,
+		Role:        in.Role,
+		InvitedBy:   user.ID,
+		CreatedAt:   clock.Now(ctx),
+	}
+	if err := uc.DB.CreateProjectInvitation(ctx, &i); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &ProjectInvitationOutput{Invitation: &i}, nil
+}
+
+type UpdateProjectMemberInput struct {
+	ProjectID domain.ProjectID
+	UserID    domain.UserID
+	Role      domain.ProjectRole
+}
+
+func (uc *Member) UpdateProjectMember(ctx context.Context, in *UpdateProjectMemberInput) (_ *ProjectMemberOutput, err error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
+	p, err := authorizeProject(ctx, uc.DB, user, in.ProjectID, domain.PermissionManage, apierror.ProjectNotFoundError())
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if in.UserID == p.UserID {
+		return nil, errtrace.Wrap(apierror.OwnerUnchangeableError())
+	}
+
+	m, err := uc.DB.GetProjectMember(ctx, p.ID, in.UserID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, errtrace.Wrap(apierror.MemberNotFoundError())
+		}
+		return nil, errtrace.Wrap(err)
+	}
+
+	m.Role = in.Role
+	m.UpdatedAt = clock.Now(ctx)
+	if err := uc.DB.UpdateProjectMember(ctx, m); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &ProjectMemberOutput{Member: m}, nil
+}
+
+type DeleteProjectMemberInput struct {
+	ProjectID domain.ProjectID
+	UserID    domain.UserID
+}
+
+func (uc *Member) DeleteProjectMember(ctx context.Context, in *DeleteProjectMemberInput) (err error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
+	// メンバーは閲覧の権限があれば自分自身をプロジェクトから外せる
+	perm := domain.PermissionManage
+	if in.UserID == user.ID {
+		perm = domain.PermissionRead
+	}
+	p, err := authorizeProject(ctx, uc.DB, user, in.ProjectID, perm, apierror.ProjectNotFoundError())
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	if in.UserID == p.UserID {
+		return errtrace.Wrap(apierror.OwnerUnchangeableError())
+	}
+
+	if _, err := uc.DB.GetProjectMember(ctx, p.ID, in.UserID); err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return errtrace.Wrap(apierror.MemberNotFoundError())
+		}
+		return errtrace.Wrap(err)
+	}
+	if err := uc.DB.DeleteProjectMember(ctx, p.ID, in.UserID); err != nil {
+		return errtrace.Wrap(err)
+	}
+	if err := uc.DB.UnassignTasks(ctx, p.ID, in.UserID); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+type ListInvitationsOutput struct {
+	Invitations domain.ProjectInvitations
+}
+
+func (uc *Member) ListInvitations(ctx context.Context) (*ListInvitationsOutput, error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	// 招待はメールアドレス宛てのため、そのメールアドレスの所有を確認していないユーザには見せない
+	if !user.EmailVerified() {
+		return nil, errtrace.Wrap(apierror.EmailNotVerifiedError())
+	}
+
+	is, err := uc.DB.ListProjectInvitationsByEmail(ctx, user.Email)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &ListInvitationsOutput{Invitations: is}, nil
+}
+
+type AcceptInvitationInput struct {
+	ID domain.ProjectInvitationID
+}
+
+func (uc *Member) AcceptInvitation(ctx context.Context, in *AcceptInvitationInput) (_ *ProjectMemberOutput, err error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if !user.EmailVerified() {
+		return nil, errtrace.Wrap(apierror.EmailNotVerifiedError())
+	}
+
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
+	i, err := uc.DB.GetProjectInvitationByID(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, errtrace.Wrap(apierror.InvitationNotFoundError())
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	if i.Email != user.Email {
+		return nil, errtrace.Wrap(apierror.InvitationNotFoundError())
+	}
+
+	// 招待した後に同じユーザが別の経路でメンバーになっている場合は重複して参加させない
+	p, err := uc.DB.GetProjectByID(ctx, i.ProjectID)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if p.UserID == user.ID {
+		return nil, errtrace.Wrap(apierror.AlreadyProjectMemberError())
+	}
+	if _, err := uc.DB.GetProjectMember(ctx, p.ID, user.ID); err == nil {
+		return nil, errtrace.Wrap(apierror.AlreadyProjectMemberError())
+	} else if !errors.Is(err, database.ErrNotFound) {
+		return nil, errtrace.Wrap(err)
+	}
+
+	now := clock.Now(ctx)
+	m := domain.ProjectMember{
+		ProjectID: i.ProjectID,
+		UserID:    user.ID,
+		Email:     user.Email,
+		Role:      i.Role,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	entries, err := uc.DB.ListProjectPositions(ctx, user.ID)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	m.Position, err = appendPosition(ctx, entries, p.ID, projectPositionUpdater(uc.DB, user.ID))
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if err := uc.DB.CreateProjectMember(ctx, &m); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if err := uc.DB.DeleteProjectInvitationByID(ctx, i.ID); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &ProjectMemberOutput{Member: &m}, nil
+}
+
+type DeleteInvitationInput struct {
+	ID domain.ProjectInvitationID
+}
+
+func (uc *Member) DeleteInvitation(ctx context.Context, in *DeleteInvitationInput) (err error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
+	i, err := uc.DB.GetProjectInvitationByID(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return errtrace.Wrap(apierror.InvitationNotFoundError())
+		}
+		return errtrace.Wrap(err)
+	}
+	// 招待されたユーザは辞退でき、プロジェクトのオーナーは取り消せる
+	if i.Email != user.Email || !user.EmailVerified() {
+		if _, err := authorizeProject(ctx, uc.DB, user, i.ProjectID, domain.PermissionManage, apierror.InvitationNotFoundError()); err != nil {
+			return errtrace.Wrap(err)
+		}
+	}
+
+	if err := uc.DB.DeleteProjectInvitationByID(ctx, i.ID); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/minguu42/harmattan/internal/api/apierror"
+	"github.com/minguu42/harmattan/internal/database"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+// authorizeProject はユーザがプロジェクトに対して操作 perm を行えることを確認し、プロジェクトを返す
+// プロジェクトが存在しない場合と閲覧もできない場合は、他のユーザのプロジェクトの存在を知られないように notFound を返す
+// 閲覧はできるが操作 perm を行えない場合は PermissionDeniedError を返す
+func authorizeProject(ctx context.Context, db *database.Client, user *domain.User, id domain.ProjectID, perm domain.Permission, notFound apierror.Error) (*domain.Project, error) {
+	p, err := db.GetProjectByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, errtrace.Wrap(notFound)
+		}
+		return nil, errtrace.Wrap(err)
+	}
+
+	var m *domain.ProjectMember
+	if p.UserID != user.ID {
+		m, err = db.GetProjectMember(ctx, p.ID, user.ID)
+		if err != nil && !errors.Is(err, database.ErrNotFound) {
+			return nil, errtrace.Wrap(err)
+		}
+	}
+
+	role := user.RoleIn(p, m)
+	if !role.Allows(domain.PermissionRead) {
+		return nil, errtrace.Wrap(notFound)
+	}
+	if !role.Allows(perm) {
+		return nil, errtrace.Wrap(apierror.PermissionDeniedError())
+	}
+	return p, nil
+}
+
+// authorizeTask はユーザがタスクの属するプロジェクトに対して操作 perm を行えることを確認し、タスクを返す
+// エラーの扱いは authorizeProject と同じである
+func authorizeTask(ctx context.Context, db *database.Client, user *domain.User, id domain.TaskID, perm domain.Permission, notFound apierror.Error) (*domain.Task, error) {
+	task, err := db.GetTaskByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, errtrace.Wrap(notFound)
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	if _, err := authorizeProject(ctx, db, user, task.ProjectID, perm, notFound); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return task, nil
+}
+
+// validateAssignee は担当者 assigneeID がプロジェクトのオーナーまたはメンバーであることを確認する
+// ビューアーもタスクを担当できる
+func validateAssignee(ctx context.Context, db *database.Client, p *domain.Project, assigneeID domain.UserID) error {
+	if assigneeID == p.UserID {
+		return nil
+	}
+	if _, err := db.GetProjectMember(ctx, p.ID, assigneeID); err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return errtrace.Wrap(apierror.AssigneeNotAllowedError())
+		}
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/api/apierror"
+	"github.com/minguu42/harmattan/internal/auth"
+	"github.com/minguu42/harmattan/internal/database"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/clock"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+	"github.com/minguu42/harmattan/internal/lib/idgen"
+)
+
+type PersonalAccessToken struct {
+	DB *database.Client
+}
+
+type CreatePersonalAccessTokenInput struct {
+	Name   string
+	Scopes []domain.Scope
+	// ExpiresIn が0の場合は削除するまで有効なトークンを作成する
+	ExpiresIn time.Duration
+}
+
+type CreatePersonalAccessTokenOutput struct {
+	Token               string
+	PersonalAccessToken *domain.PersonalAccessToken
+}
+
+func (uc *PersonalAccessToken) CreatePersonalAccessToken(ctx context.Context, in *CreatePersonalAccessTokenInput) (_ *CreatePersonalAccessTokenOutput, err error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
+	count, err := uc.DB.CountPersonalAccessTokens(ctx, user.ID)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if count >= domain.MaxPersonalAccessTokensPerUser {
+		return nil, errtrace.Wrap(apierror.TooManyPersonalAccessTokensError())
+	}
+
+	token, err := auth.CreatePersonalAccessToken(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	now := clock.Now(ctx)
+	t := domain.PersonalAccessToken{
+		ID:        domain.PersonalAccessTokenID(idgen.ULID(ctx)),
+		UserID:    user.ID,
+		Name:      in.Name,
+		TokenHash: auth.HashPersonalAccessToken(token),
+		Scopes:    in.Scopes,
+		CreatedAt: now,
+	}
+	if in.ExpiresIn > 0 {
+		t.ExpiresAt = new(now.Add(in.ExpiresIn))
+	}
+	if err := uc.DB.CreatePersonalAccessToken(ctx, &t); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &CreatePersonalAccessTokenOutput{Token: token, PersonalAccessToken: &t}, nil
+}
+
+type ListPersonalAccessTokensOutput struct {
+	PersonalAccessTokens domain.PersonalAccessTokens
+}
+
+func (uc *PersonalAccessToken) ListPersonalAccessTokens(ctx context.Context) (*ListPersonalAccessTokensOutput, error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	ts, err := uc.DB.ListPersonalAccessTokens(ctx, user.ID)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &ListPersonalAccessTokensOutput{PersonalAccessTokens: ts}, nil
+}
+
+type DeletePersonalAccessTokenInput struct {
+	ID domain.PersonalAccessTokenID
+}
+
+func (uc *PersonalAccessToken) DeletePersonalAccessToken(ctx context.Context, in *DeletePersonalAccessTokenInput) (err error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
+	t, err := uc.DB.GetPersonalAccessTokenByID(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return errtrace.Wrap(apierror.PersonalAccessTokenNotFoundError())
+		}
+		return errtrace.Wrap(err)
+	}
+	if !user.HasPersonalAccessToken(t) {
+		return errtrace.Wrap(apierror.PersonalAccessTokenNotFoundError())
+	}
+
+	if err := uc.DB.DeletePersonalAccessToken(ctx, t.ID); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/minguu42/harmattan/internal/database"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+// appendPosition は兄弟要素 entries の末尾に新しい要素 id を置いたときの位置を返す
+// 兄弟要素の位置を振り直した場合は update で兄弟要素の位置を更新する
+func appendPosition[ID ~string](ctx context.Context, entries []domain.PositionEntry[ID], id ID, update func(context.Context, map[ID]string) error) (string, error) {
+	positions, err := domain.MovePosition(entries, id, "", false)
+	if err != nil {
+		return "", errtrace.Wrap(err)
+	}
+
+	p := positions[id]
+	delete(positions, id)
+	if len(positions) > 0 {
+		if err := update(ctx, positions); err != nil {
+			return "", errtrace.Wrap(err)
+		}
+	}
+	return p, nil
+}
+
+// movePosition は兄弟要素 entries の中で要素 id を beforeID の直前または afterID の直後に移動し、id の新しい位置を返す
+// beforeID と afterID はいずれか一方のみが空でない前提である
+func movePosition[ID ~string](ctx context.Context, entries []domain.PositionEntry[ID], id, beforeID, afterID ID, update func(context.Context, map[ID]string) error) (string, error) {
+	anchor, before := afterID, false
+	if beforeID != "" {
+		anchor, before = beforeID, true
+	}
+	positions, err := domain.MovePosition(entries, id, anchor, before)
+	if err != nil {
+		return "", errtrace.Wrap(err)
+	}
+
+	if err := update(ctx, positions); err != nil {
+		return "", errtrace.Wrap(err)
+	}
+	return positions[id], nil
+}
+
+// projectPositionUpdater はユーザ userID から見たプロジェクトの位置を更新する関数を返す
+func projectPositionUpdater(db *database.Client, userID domain.UserID) func(context.Context, map[domain.ProjectID]string) error {
+	return func(ctx context.Context, positions map[domain.ProjectID]string) error {
+		return errtrace.Wrap(db.UpdateProjectPositions(ctx, userID, positions))
+	}
+}
@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/minguu42/harmattan/internal/api/apierror"
+	"github.com/minguu42/harmattan/internal/database"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+	"github.com/minguu42/harmattan/internal/lib/etag"
+)
+
+// checkIfMatch は ifMatch が空でなければ現在のエンティティタグ current と一致することを確認する
+func checkIfMatch(ifMatch, current string) error {
+	if ifMatch == "" || etag.MatchStrong(ifMatch, current) {
+		return nil
+	}
+	return errtrace.Wrap(apierror.PreconditionFailedError())
+}
+
+// convertVersionConflict は版数の競合を、If-Match を指定した場合は PreconditionFailedError に変換する
+func convertVersionConflict(err error, ifMatch string) error {
+	if !errors.Is(err, database.ErrVersionConflict) {
+		return err
+	}
+	if ifMatch != "" {
+		return apierror.PreconditionFailedError()
+	}
+	return apierror.ConcurrentUpdateError()
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/minguu42/harmattan/internal/api/apierror"
+	"github.com/minguu42/harmattan/internal/cursor"
 	"github.com/minguu42/harmattan/internal/database"
 	"github.com/minguu42/harmattan/internal/domain"
 	"github.com/minguu42/harmattan/internal/lib/clock"
@@ -13,7 +14,8 @@ import (
 )
 
 type Project struct {
-	DB *database.Client
+	Cursor *cursor.Codec
+	DB     *database.Client
 }
 
 type ProjectOutput struct {
@@ -25,12 +27,18 @@ type CreateProjectInput struct {
 	Color domain.ProjectColor
 }
 
-func (uc *Project) CreateProject(ctx context.Context, in *CreateProjectInput) (*ProjectOutput, error) {
+func (uc *Project) CreateProject(ctx context.Context, in *CreateProjectInput) (_ *ProjectOutput, err error) {
 	user, err := domain.UserFromContext(ctx)
 	if err != nil {
 		return nil, errtrace.Wrap(err)
 	}
 
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
 	count, err := uc.DB.CountProjects(ctx, user.ID)
 	if err != nil {
 		return nil, errtrace.Wrap(err)
@@ -45,23 +53,37 @@ func (uc *Project) CreateProject(ctx context.Context, in *CreateProjectInput) (*
 		UserID:    user.ID,
 		Name:      in.Name,
 		Color:     in.Color,
+		Version:   1,
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
+	entries, err := uc.DB.ListProjectPositions(ctx, user.ID)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	p.Position, err = appendPosition(ctx, entries, p.ID, projectPositionUpdater(uc.DB, user.ID))
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
 	if err := uc.DB.CreateProject(ctx, &p); err != nil {
 		return nil, errtrace.Wrap(err)
 	}
+	if err := recordHistory(ctx, uc.DB, domain.NewProjectHistoryEntry(user.ID, nil, &p, now)); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
 	return &ProjectOutput{Project: &p}, nil
 }
 
 type ListProjectsInput struct {
 	Limit  int
 	Offset int
+	Cursor string
 }
 
 type ListProjectsOutput struct {
-	Projects domain.Projects
-	HasNext  bool
+	Projects   domain.Projects
+	HasNext    bool
+	NextCursor string
 }
 
 func (uc *Project) ListProjects(ctx context.Context, in *ListProjectsInput) (*ListProjectsOutput, error) {
@@ -70,17 +92,27 @@ func (uc *Project) ListProjects(ctx context.Context, in *ListProjectsInput) (*Li
 		return nil, errtrace.Wrap(err)
 	}
 
-	ps, err := uc.DB.ListProjects(ctx, user.ID, in.Limit+1, in.Offset)
+	after, err := decodeCursor(uc.Cursor, in.Cursor, "projects")
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	ps, err := uc.DB.ListProjects(ctx, user.ID, after, in.Limit+1, in.Offset)
 	if err != nil {
 		return nil, errtrace.Wrap(err)
 	}
 
 	hasNext := false
+	var nextCursor string
 	if len(ps) == in.Limit+1 {
 		ps = ps[:in.Limit]
 		hasNext = true
+		nextCursor, err = encodeCursor(uc.Cursor, database.ProjectCursor(&ps[len(ps)-1]), "projects")
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
 	}
-	return &ListProjectsOutput{Projects: ps, HasNext: hasNext}, nil
+	return &ListProjectsOutput{Projects: ps, HasNext: hasNext, NextCursor: nextCursor}, nil
 }
 
 type GetProjectInput struct {
@@ -93,16 +125,10 @@ func (uc *Project) GetProject(ctx context.Context, in *GetProjectInput) (*Projec
 		return nil, errtrace.Wrap(err)
 	}
 
-	p, err := uc.DB.GetProjectByID(ctx, in.ID)
+	p, err := authorizeProject(ctx, uc.DB, user, in.ID, domain.PermissionRead, apierror.ProjectNotFoundError())
 	if err != nil {
-		if errors.Is(err, database.ErrNotFound) {
-			return nil, errtrace.Wrap(apierror.ProjectNotFoundError())
-		}
 		return nil, errtrace.Wrap(err)
 	}
-	if !user.HasProject(p) {
-		return nil, errtrace.Wrap(apierror.ProjectNotFoundError())
-	}
 
 	return &ProjectOutput{Project: p}, nil
 }
@@ -112,6 +138,8 @@ type UpdateProjectInput struct {
 	Name       Option[string]
 	Color      Option[domain.ProjectColor]
 	IsArchived Option[bool]
+	// IfMatch が空でない場合は、プロジェクトのエンティティタグと一致するときのみ更新する
+	IfMatch string
 }
 
 func (uc *Project) UpdateProject(ctx context.Context, in *UpdateProjectInput) (_ *ProjectOutput, err error) {
@@ -126,17 +154,15 @@ func (uc *Project) UpdateProject(ctx context.Context, in *UpdateProjectInput) (_
 	}
 	defer commitOrRollback(&err)
 
-	p, err := uc.DB.GetProjectByID(ctx, in.ID)
+	p, err := authorizeProject(ctx, uc.DB, user, in.ID, domain.PermissionManage, apierror.ProjectNotFoundError())
 	if err != nil {
-		if errors.Is(err, database.ErrNotFound) {
-			return nil, errtrace.Wrap(apierror.ProjectNotFoundError())
-		}
 		return nil, errtrace.Wrap(err)
 	}
-	if !user.HasProject(p) {
-		return nil, errtrace.Wrap(apierror.ProjectNotFoundError())
+	if err := checkIfMatch(in.IfMatch, p.ETag()); err != nil {
+		return nil, errtrace.Wrap(err)
 	}
 
+	before := *p
 	if in.Name.Valid {
 		p.Name = in.Name.V
 	}
@@ -146,8 +172,65 @@ func (uc *Project) UpdateProject(ctx context.Context, in *UpdateProjectInput) (_
 	if in.IsArchived.Valid {
 		p.IsArchived = in.IsArchived.V
 	}
+	p.Version++
 	p.UpdatedAt = clock.Now(ctx)
 	if err := uc.DB.UpdateProject(ctx, p); err != nil {
+		return nil, errtrace.Wrap(convertVersionConflict(err, in.IfMatch))
+	}
+	if err := recordHistory(ctx, uc.DB, domain.NewProjectHistoryEntry(user.ID, &before, p, p.UpdatedAt)); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &ProjectOutput{Project: p}, nil
+}
+
+type MoveProjectInput struct {
+	ID       domain.ProjectID
+	BeforeID domain.ProjectID
+	AfterID  domain.ProjectID
+	// IfMatch が空でない場合は、プロジェクトのエンティティタグと一致するときのみ移動する
+	IfMatch string
+}
+
+func (uc *Project) MoveProject(ctx context.Context, in *MoveProjectInput) (_ *ProjectOutput, err error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
+	// 位置はユーザごとに保存しているため、閲覧できればユーザ自身の並び順を変更できる
+	p, err := authorizeProject(ctx, uc.DB, user, in.ID, domain.PermissionRead, apierror.ProjectNotFoundError())
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if err := checkIfMatch(in.IfMatch, p.ETag()); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	before := *p
+	entries, err := uc.DB.ListProjectPositions(ctx, user.ID)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	p.Position, err = movePosition(ctx, entries, p.ID, in.BeforeID, in.AfterID, projectPositionUpdater(uc.DB, user.ID))
+	if err != nil {
+		// 基準のプロジェクトが兄弟要素に含まれない場合は、他のユーザのプロジェクトである可能性があるため存在しないものとして扱う
+		if errors.Is(err, domain.ErrPositionAnchorNotFound) {
+			return nil, errtrace.Wrap(apierror.ProjectNotFoundError())
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	// 位置はユーザごとに保存しているが、ほかのメンバーの更新と同時に行われたことを検出できるように版数を上げる
+	p.Version++
+	if err := uc.DB.UpdateProject(ctx, p); err != nil {
+		return nil, errtrace.Wrap(convertVersionConflict(err, in.IfMatch))
+	}
+	if err := recordHistory(ctx, uc.DB, domain.NewProjectHistoryEntry(user.ID, &before, p, clock.Now(ctx))); err != nil {
 		return nil, errtrace.Wrap(err)
 	}
 	return &ProjectOutput{Project: p}, nil
@@ -155,6 +238,8 @@ func (uc *Project) UpdateProject(ctx context.Context, in *UpdateProjectInput) (_
 
 type DeleteProjectInput struct {
 	ID domain.ProjectID
+	// IfMatch が空でない場合は、プロジェクトのエンティティタグと一致するときのみ削除する
+	IfMatch string
 }
 
 func (uc *Project) DeleteProject(ctx context.Context, in *DeleteProjectInput) (err error) {
@@ -169,18 +254,19 @@ func (uc *Project) DeleteProject(ctx context.Context, in *DeleteProjectInput) (e
 	}
 	defer commitOrRollback(&err)
 
-	p, err := uc.DB.GetProjectByID(ctx, in.ID)
+	p, err := authorizeProject(ctx, uc.DB, user, in.ID, domain.PermissionManage, apierror.ProjectNotFoundError())
 	if err != nil {
-		if errors.Is(err, database.ErrNotFound) {
-			return errtrace.Wrap(apierror.ProjectNotFoundError())
-		}
 		return errtrace.Wrap(err)
 	}
-	if !user.HasProject(p) {
-		return errtrace.Wrap(apierror.ProjectNotFoundError())
+	if err := checkIfMatch(in.IfMatch, p.ETag()); err != nil {
+		return errtrace.Wrap(err)
 	}
 
-	if err := uc.DB.DeleteProjectByID(ctx, p.ID); err != nil {
+	now := clock.Now(ctx)
+	if err := uc.DB.TrashProjectByID(ctx, p.ID, now); err != nil {
+		return errtrace.Wrap(err)
+	}
+	if err := recordHistory(ctx, uc.DB, domain.NewProjectHistoryEntry(user.ID, p, nil, now)); err != nil {
 		return errtrace.Wrap(err)
 	}
 	return nil
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/minguu42/harmattan/internal/api/apierror"
+	"github.com/minguu42/harmattan/internal/database"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+type Search struct {
+	DB *database.Client
+}
+
+type SearchInput struct {
+	Query         string
+	Limit         int
+	Offset        int
+	ShowCompleted bool
+	ProjectID     *domain.ProjectID
+	TagIDs        []domain.TagID
+	MatchAllTags  bool
+}
+
+type SearchOutput struct {
+	Results domain.SearchResults
+	// Terms は検索キーワードを区切った検索語であり、スニペットの強調表示に用いる
+	Terms   []string
+	HasNext bool
+}
+
+// Search はプロジェクト・タスク・ステップ・タグから検索キーワードのすべての検索語を含むものを関連度の高い順に返す
+func (uc *Search) Search(ctx context.Context, in *SearchInput) (*SearchOutput, error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	if in.ProjectID != nil {
+		if _, err := authorizeProject(ctx, uc.DB, user, *in.ProjectID, domain.PermissionRead, apierror.ProjectNotFoundError()); err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+	}
+
+	terms := domain.SearchTerms(in.Query)
+	rs, err := uc.DB.Search(ctx, user.ID, terms, &database.SearchOptions{
+		ShowCompleted: in.ShowCompleted,
+		ProjectID:     in.ProjectID,
+		TagIDs:        in.TagIDs,
+		MatchAllTags:  in.MatchAllTags,
+	}, in.Limit+1, in.Offset)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	hasNext := false
+	if len(rs) == in.Limit+1 {
+		rs = rs[:in.Limit]
+		hasNext = true
+	}
+	return &SearchOutput{Results: rs, Terms: terms, HasNext: hasNext}, nil
+}
@@ -38,16 +38,10 @@ func (uc *Step) CreateStep(ctx context.Context, in *CreateStepInput) (_ *StepOut
 	}
 	defer commitOrRollback(&err)
 
-	task, err := uc.DB.GetTaskByID(ctx, in.TaskID)
+	task, err := authorizeTask(ctx, uc.DB, user, in.TaskID, domain.PermissionWrite, apierror.TaskNotFoundError())
 	if err != nil {
-		if errors.Is(err, database.ErrNotFound) {
-			return nil, errtrace.Wrap(apierror.TaskNotFoundError())
-		}
 		return nil, errtrace.Wrap(err)
 	}
-	if !user.HasTask(task) {
-		return nil, errtrace.Wrap(apierror.TaskNotFoundError())
-	}
 
 	count, err := uc.DB.CountSteps(ctx, in.TaskID)
 	if err != nil {
@@ -60,16 +54,28 @@ func (uc *Step) CreateStep(ctx context.Context, in *CreateStepInput) (_ *StepOut
 	now := clock.Now(ctx)
 	s := domain.Step{
 		ID:        domain.StepID(idgen.ULID(ctx)),
-		UserID:    user.ID,
+		UserID:    task.UserID,
 		TaskID:    in.TaskID,
 		Name:      in.Name,
+		Version:   1,
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
+	entries, err := uc.DB.ListStepPositions(ctx, in.TaskID)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	s.Position, err = appendPosition(ctx, entries, s.ID, uc.DB.UpdateStepPositions)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
 
 	if err := uc.DB.CreateStep(ctx, &s); err != nil {
 		return nil, errtrace.Wrap(err)
 	}
+	if err := recordHistory(ctx, uc.DB, domain.NewStepHistoryEntry(user.ID, nil, &s, now)); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
 	return &StepOutput{Step: &s}, nil
 }
 
@@ -77,6 +83,8 @@ type UpdateStepInput struct {
 	ID          domain.StepID
 	Name        Option[string]
 	CompletedAt Option[*time.Time]
+	// IfMatch が空でない場合は、ステップのエンティティタグと一致するときのみ更新する
+	IfMatch string
 }
 
 func (uc *Step) UpdateStep(ctx context.Context, in *UpdateStepInput) (_ *StepOutput, err error) {
@@ -98,19 +106,85 @@ func (uc *Step) UpdateStep(ctx context.Context, in *UpdateStepInput) (_ *StepOut
 		}
 		return nil, errtrace.Wrap(err)
 	}
-	if !user.HasStep(s) {
-		return nil, errtrace.Wrap(apierror.StepNotFoundError())
+	if _, err := authorizeTask(ctx, uc.DB, user, s.TaskID, domain.PermissionWrite, apierror.StepNotFoundError()); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if err := checkIfMatch(in.IfMatch, s.ETag()); err != nil {
+		return nil, errtrace.Wrap(err)
 	}
 
+	before := *s
 	if in.Name.Valid {
 		s.Name = in.Name.V
 	}
 	if in.CompletedAt.Valid {
 		s.CompletedAt = in.CompletedAt.V
 	}
+	s.Version++
 	s.UpdatedAt = clock.Now(ctx)
 
 	if err := uc.DB.UpdateStep(ctx, s); err != nil {
+		return nil, errtrace.Wrap(convertVersionConflict(err, in.IfMatch))
+	}
+	if err := recordHistory(ctx, uc.DB, domain.NewStepHistoryEntry(user.ID, &before, s, s.UpdatedAt)); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &StepOutput{Step: s}, nil
+}
+
+type MoveStepInput struct {
+	ID       domain.StepID
+	BeforeID domain.StepID
+	AfterID  domain.StepID
+	// IfMatch が空でない場合は、ステップのエンティティタグと一致するときのみ移動する
+	IfMatch string
+}
+
+func (uc *Step) MoveStep(ctx context.Context, in *MoveStepInput) (_ *StepOutput, err error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
+	s, err := uc.DB.GetStepByID(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, errtrace.Wrap(apierror.StepNotFoundError())
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	if _, err := authorizeTask(ctx, uc.DB, user, s.TaskID, domain.PermissionWrite, apierror.StepNotFoundError()); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if err := checkIfMatch(in.IfMatch, s.ETag()); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	before := *s
+	entries, err := uc.DB.ListStepPositions(ctx, s.TaskID)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	s.Position, err = movePosition(ctx, entries, s.ID, in.BeforeID, in.AfterID, uc.DB.UpdateStepPositions)
+	if err != nil {
+		// 基準のステップが同じタスクに含まれない場合は存在しないものとして扱う
+		if errors.Is(err, domain.ErrPositionAnchorNotFound) {
+			return nil, errtrace.Wrap(apierror.StepNotFoundError())
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	// 更新日時は変更せず、版数のみ上げる
+	s.Version++
+	if err := uc.DB.UpdateStep(ctx, s); err != nil {
+		return nil, errtrace.Wrap(convertVersionConflict(err, in.IfMatch))
+	}
+	if err := recordHistory(ctx, uc.DB, domain.NewStepHistoryEntry(user.ID, &before, s, clock.Now(ctx))); err != nil {
 		return nil, errtrace.Wrap(err)
 	}
 	return &StepOutput{Step: s}, nil
@@ -118,6 +192,8 @@ func (uc *Step) UpdateStep(ctx context.Context, in *UpdateStepInput) (_ *StepOut
 
 type DeleteStepInput struct {
 	ID domain.StepID
+	// IfMatch が空でない場合は、ステップのエンティティタグと一致するときのみ削除する
+	IfMatch string
 }
 
 func (uc *Step) DeleteStep(ctx context.Context, in *DeleteStepInput) (err error) {
@@ -139,11 +215,18 @@ func (uc *Step) DeleteStep(ctx context.Context, in *DeleteStepInput) (err error)
 		}
 		return errtrace.Wrap(err)
 	}
-	if !user.HasStep(s) {
-		return errtrace.Wrap(apierror.StepNotFoundError())
+	if _, err := authorizeTask(ctx, uc.DB, user, s.TaskID, domain.PermissionWrite, apierror.StepNotFoundError()); err != nil {
+		return errtrace.Wrap(err)
+	}
+	if err := checkIfMatch(in.IfMatch, s.ETag()); err != nil {
+		return errtrace.Wrap(err)
 	}
 
-	if err := uc.DB.DeleteStepByID(ctx, s.ID); err != nil {
+	now := clock.Now(ctx)
+	if err := uc.DB.TrashStepByID(ctx, s.ID, now); err != nil {
+		return errtrace.Wrap(err)
+	}
+	if err := recordHistory(ctx, uc.DB, domain.NewStepHistoryEntry(user.ID, s, nil, now)); err != nil {
 		return errtrace.Wrap(err)
 	}
 	return nil
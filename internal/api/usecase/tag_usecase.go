@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/minguu42/harmattan/internal/api/apierror"
+	"github.com/minguu42/harmattan/internal/cursor"
 	"github.com/minguu42/harmattan/internal/database"
 	"github.com/minguu42/harmattan/internal/domain"
 	"github.com/minguu42/harmattan/internal/lib/clock"
@@ -13,7 +14,8 @@ import (
 )
 
 type Tag struct {
-	DB *database.Client
+	Cursor *cursor.Codec
+	DB     *database.Client
 }
 
 type TagOutput struct {
@@ -24,12 +26,18 @@ type CreateTagInput struct {
 	Name string
 }
 
-func (uc *Tag) CreateTag(ctx context.Context, in *CreateTagInput) (*TagOutput, error) {
+func (uc *Tag) CreateTag(ctx context.Context, in *CreateTagInput) (_ *TagOutput, err error) {
 	user, err := domain.UserFromContext(ctx)
 	if err != nil {
 		return nil, errtrace.Wrap(err)
 	}
 
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
 	count, err := uc.DB.CountTags(ctx, user.ID)
 	if err != nil {
 		return nil, errtrace.Wrap(err)
@@ -43,23 +51,29 @@ func (uc *Tag) CreateTag(ctx context.Context, in *CreateTagInput) (*TagOutput, e
 		ID:        domain.TagID(idgen.ULID(ctx)),
 		UserID:    user.ID,
 		Name:      in.Name,
+		Version:   1,
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
 	if err := uc.DB.CreateTag(ctx, &t); err != nil {
 		return nil, errtrace.Wrap(err)
 	}
+	if err := recordHistory(ctx, uc.DB, domain.NewTagHistoryEntry(user.ID, nil, &t, now)); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
 	return &TagOutput{Tag: &t}, nil
 }
 
 type ListTagsInput struct {
 	Limit  int
 	Offset int
+	Cursor string
 }
 
 type ListTagsOutput struct {
-	Tags    domain.Tags
-	HasNext bool
+	Tags       domain.Tags
+	HasNext    bool
+	NextCursor string
 }
 
 func (uc *Tag) ListTags(ctx context.Context, in *ListTagsInput) (*ListTagsOutput, error) {
@@ -68,22 +82,34 @@ func (uc *Tag) ListTags(ctx context.Context, in *ListTagsInput) (*ListTagsOutput
 		return nil, errtrace.Wrap(err)
 	}
 
-	ts, err := uc.DB.ListTags(ctx, user.ID, in.Limit+1, in.Offset)
+	after, err := decodeCursor(uc.Cursor, in.Cursor, "tags")
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	ts, err := uc.DB.ListTags(ctx, user.ID, after, in.Limit+1, in.Offset)
 	if err != nil {
 		return nil, errtrace.Wrap(err)
 	}
 
 	hasNext := false
+	var nextCursor string
 	if len(ts) == in.Limit+1 {
 		ts = ts[:in.Limit]
 		hasNext = true
+		nextCursor, err = encodeCursor(uc.Cursor, database.TagCursor(&ts[len(ts)-1]), "tags")
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
 	}
-	return &ListTagsOutput{Tags: ts, HasNext: hasNext}, nil
+	return &ListTagsOutput{Tags: ts, HasNext: hasNext, NextCursor: nextCursor}, nil
 }
 
 type UpdateTagInput struct {
 	ID   domain.TagID
 	Name Option[string]
+	// IfMatch が空でない場合は、タグのエンティティタグと一致するときのみ更新する
+	IfMatch string
 }
 
 func (uc *Tag) UpdateTag(ctx context.Context, in *UpdateTagInput) (_ *TagOutput, err error) {
@@ -108,12 +134,20 @@ func (uc *Tag) UpdateTag(ctx context.Context, in *UpdateTagInput) (_ *TagOutput,
 	if !user.HasTag(t) {
 		return nil, errtrace.Wrap(apierror.TagNotFoundError())
 	}
+	if err := checkIfMatch(in.IfMatch, t.ETag()); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
 
+	before := *t
 	if in.Name.Valid {
 		t.Name = in.Name.V
 	}
+	t.Version++
 	t.UpdatedAt = clock.Now(ctx)
 	if err := uc.DB.UpdateTag(ctx, t); err != nil {
+		return nil, errtrace.Wrap(convertVersionConflict(err, in.IfMatch))
+	}
+	if err := recordHistory(ctx, uc.DB, domain.NewTagHistoryEntry(user.ID, &before, t, t.UpdatedAt)); err != nil {
 		return nil, errtrace.Wrap(err)
 	}
 	return &TagOutput{Tag: t}, nil
@@ -145,6 +179,8 @@ func (uc *Tag) GetTag(ctx context.Context, in *GetTagInput) (*TagOutput, error)
 
 type DeleteTagInput struct {
 	ID domain.TagID
+	// IfMatch が空でない場合は、タグのエンティティタグと一致するときのみ削除する
+	IfMatch string
 }
 
 func (uc *Tag) DeleteTag(ctx context.Context, in *DeleteTagInput) (err error) {
@@ -169,8 +205,15 @@ func (uc *Tag) DeleteTag(ctx context.Context, in *DeleteTagInput) (err error) {
 	if !user.HasTag(t) {
 		return errtrace.Wrap(apierror.TagNotFoundError())
 	}
+	if err := checkIfMatch(in.IfMatch, t.ETag()); err != nil {
+		return errtrace.Wrap(err)
+	}
 
-	if err := uc.DB.DeleteTagByID(ctx, t.ID); err != nil {
+	now := clock.Now(ctx)
+	if err := uc.DB.TrashTagByID(ctx, t.ID, now); err != nil {
+		return errtrace.Wrap(err)
+	}
+	if err := recordHistory(ctx, uc.DB, domain.NewTagHistoryEntry(user.ID, t, nil, now)); err != nil {
 		return errtrace.Wrap(err)
 	}
 	return nil
@@ -3,9 +3,11 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/minguu42/harmattan/internal/api/apierror"
+	"github.com/minguu42/harmattan/internal/cursor"
 	"github.com/minguu42/harmattan/internal/database"
 	"github.com/minguu42/harmattan/internal/domain"
 	"github.com/minguu42/harmattan/internal/lib/clock"
@@ -15,7 +17,8 @@ import (
 )
 
 type Task struct {
-	DB *database.Client
+	Cursor *cursor.Codec
+	DB     *database.Client
 }
 
 type TaskOutput struct {
@@ -24,9 +27,11 @@ type TaskOutput struct {
 }
 
 type CreateTaskInput struct {
-	ProjectID domain.ProjectID
-	Name      string
-	Priority  int
+	ProjectID  domain.ProjectID
+	AssigneeID *domain.UserID
+	Name       string
+	Priority   int
+	Recurrence *domain.RecurrenceRule
 }
 
 func (uc *Task) CreateTask(ctx context.Context, in *CreateTaskInput) (_ *TaskOutput, err error) {
@@ -41,16 +46,10 @@ func (uc *Task) CreateTask(ctx context.Context, in *CreateTaskInput) (_ *TaskOut
 	}
 	defer commitOrRollback(&err)
 
-	p, err := uc.DB.GetProjectByID(ctx, in.ProjectID)
+	p, err := authorizeProject(ctx, uc.DB, user, in.ProjectID, domain.PermissionWrite, apierror.ProjectNotFoundError())
 	if err != nil {
-		if errors.Is(err, database.ErrNotFound) {
-			return nil, errtrace.Wrap(apierror.ProjectNotFoundError())
-		}
 		return nil, errtrace.Wrap(err)
 	}
-	if !user.HasProject(p) {
-		return nil, errtrace.Wrap(apierror.ProjectNotFoundError())
-	}
 
 	count, err := uc.DB.CountTasks(ctx, in.ProjectID)
 	if err != nil {
@@ -59,20 +58,40 @@ func (uc *Task) CreateTask(ctx context.Context, in *CreateTaskInput) (_ *TaskOut
 	if count >= domain.MaxTasksPerProject {
 		return nil, errtrace.Wrap(apierror.TooManyTasksError())
 	}
+	if in.AssigneeID != nil {
+		if err := validateAssignee(ctx, uc.DB, p, *in.AssigneeID); err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+	}
 
+	// タスクの所有者はプロジェクトのオーナーとし、作成したユーザは変更履歴に残す
 	now := clock.Now(ctx)
 	t := domain.Task{
-		ID:        domain.TaskID(idgen.ULID(ctx)),
-		UserID:    user.ID,
-		ProjectID: in.ProjectID,
-		Name:      in.Name,
-		Priority:  in.Priority,
-		CreatedAt: now,
-		UpdatedAt: now,
+		ID:         domain.TaskID(idgen.ULID(ctx)),
+		UserID:     p.UserID,
+		ProjectID:  in.ProjectID,
+		AssigneeID: in.AssigneeID,
+		Name:       in.Name,
+		Priority:   in.Priority,
+		Recurrence: in.Recurrence,
+		Version:    1,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	entries, err := uc.DB.ListTaskPositions(ctx, in.ProjectID)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	t.Position, err = appendPosition(ctx, entries, t.ID, uc.DB.UpdateTaskPositions)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
 	}
 	if err := uc.DB.CreateTask(ctx, &t); err != nil {
 		return nil, errtrace.Wrap(err)
 	}
+	if err := recordHistory(ctx, uc.DB, domain.NewTaskHistoryEntry(user.ID, nil, &t, now)); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
 	return &TaskOutput{Task: &t}, nil
 }
 
@@ -80,13 +99,25 @@ type ListTasksInput struct {
 	ProjectID     domain.ProjectID
 	Limit         int
 	Offset        int
+	Cursor        string
 	ShowCompleted bool
+	MinPriority   *int
+	MaxPriority   *int
+	TagIDs        []domain.TagID
+	MatchAllTags  bool
+	AssigneeID    *domain.UserID
+	DueFrom       *plain.Date
+	DueTo         *plain.Date
+	HasDueDate    *bool
+	SortKey       domain.TaskSortKey
+	Descending    bool
 }
 
 type ListTasksOutput struct {
-	Tasks   domain.Tasks
-	Tags    domain.Tags
-	HasNext bool
+	Tasks      domain.Tasks
+	Tags       domain.Tags
+	HasNext    bool
+	NextCursor string
 }
 
 func (uc *Task) ListTasks(ctx context.Context, in *ListTasksInput) (*ListTasksOutput, error) {
@@ -95,33 +126,172 @@ func (uc *Task) ListTasks(ctx context.Context, in *ListTasksInput) (*ListTasksOu
 		return nil, errtrace.Wrap(err)
 	}
 
-	p, err := uc.DB.GetProjectByID(ctx, in.ProjectID)
+	if _, err := authorizeProject(ctx, uc.DB, user, in.ProjectID, domain.PermissionRead, apierror.ProjectNotFoundError()); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	order := "asc"
+	if in.Descending {
+		order = "desc"
+	}
+	scope := fmt.Sprintf("tasks:%s:%s:%s", in.ProjectID, in.SortKey, order)
+	after, err := decodeCursor(uc.Cursor, in.Cursor, scope)
 	if err != nil {
-		if errors.Is(err, database.ErrNotFound) {
-			return nil, errtrace.Wrap(apierror.ProjectNotFoundError())
+		return nil, errtrace.Wrap(err)
+	}
+
+	ts, err := uc.DB.ListTasks(ctx, in.ProjectID, &database.ListTasksOptions{
+		ShowCompleted: in.ShowCompleted,
+		MinPriority:   in.MinPriority,
+		MaxPriority:   in.MaxPriority,
+		TagIDs:        in.TagIDs,
+		MatchAllTags:  in.MatchAllTags,
+		AssigneeID:    in.AssigneeID,
+		DueFrom:       in.DueFrom,
+		DueTo:         in.DueTo,
+		HasDueDate:    in.HasDueDate,
+		SortKey:       in.SortKey,
+		Descending:    in.Descending,
+		After:         after,
+	}, in.Limit+1, in.Offset)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	hasNext := false
+	var nextCursor string
+	if len(ts) == in.Limit+1 {
+		ts = ts[:in.Limit]
+		hasNext = true
+		nextCursor, err = encodeCursor(uc.Cursor, database.TaskCursor(&ts[len(ts)-1], in.SortKey), scope)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
 		}
+	}
+
+	tags, err := uc.DB.GetTagsByIDs(ctx, ts.TagIDs())
+	if err != nil {
 		return nil, errtrace.Wrap(err)
 	}
-	if !user.HasProject(p) {
-		return nil, errtrace.Wrap(apierror.ProjectNotFoundError())
+	return &ListTasksOutput{Tasks: ts, Tags: tags, HasNext: hasNext, NextCursor: nextCursor}, nil
+}
+
+type ListTodayTasksInput struct {
+	Limit    int
+	Offset   int
+	Cursor   string
+	Location *time.Location
+}
+
+// ListTodayTasks はすべてのプロジェクトから今日が期日の未完了のタスクを返す
+func (uc *Task) ListTodayTasks(ctx context.Context, in *ListTodayTasksInput) (*ListTasksOutput, error) {
+	today := plain.DateOf(clock.Now(ctx).In(in.Location))
+	return uc.listDueTasks(ctx, fmt.Sprintf("today:%s", in.Location), &today, &today, in.Cursor, in.Limit, in.Offset)
+}
+
+type ListUpcomingTasksInput struct {
+	Limit    int
+	Offset   int
+	Cursor   string
+	Days     int
+	Location *time.Location
+}
+
+// ListUpcomingTasks はすべてのプロジェクトから明日から Days 日後までが期日の未完了のタスクを返す
+func (uc *Task) ListUpcomingTasks(ctx context.Context, in *ListUpcomingTasksInput) (*ListTasksOutput, error) {
+	today := plain.DateOf(clock.Now(ctx).In(in.Location))
+	from, to := today.AddDate(0, 0, 1), today.AddDate(0, 0, in.Days)
+	return uc.listDueTasks(ctx, fmt.Sprintf("upcoming:%s:%d", in.Location, in.Days), &from, &to, in.Cursor, in.Limit, in.Offset)
+}
+
+type ListOverdueTasksInput struct {
+	Limit    int
+	Offset   int
+	Cursor   string
+	Location *time.Location
+}
+
+// ListOverdueTasks はすべてのプロジェクトから期日を過ぎた未完了のタスクを返す
+func (uc *Task) ListOverdueTasks(ctx context.Context, in *ListOverdueTasksInput) (*ListTasksOutput, error) {
+	yesterday := plain.DateOf(clock.Now(ctx).In(in.Location)).AddDate(0, 0, -1)
+	return uc.listDueTasks(ctx, fmt.Sprintf("overdue:%s", in.Location), nil, &yesterday, in.Cursor, in.Limit, in.Offset)
+}
+
+// listDueTasks は期日が from から to までの未完了のタスクを返す
+// 期日の範囲はタイムゾーンと日数によって変わるため、scope にはそれらを含めて異なる条件で発行したカーソルを拒否する
+func (uc *Task) listDueTasks(ctx context.Context, scope string, from, to *plain.Date, cursor string, limit, offset int) (*ListTasksOutput, error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
 	}
 
-	ts, err := uc.DB.ListTasks(ctx, in.ProjectID, in.Limit+1, in.Offset, in.ShowCompleted)
+	after, err := decodeCursor(uc.Cursor, cursor, scope)
 	if err != nil {
 		return nil, errtrace.Wrap(err)
 	}
 
+	ts, err := uc.DB.ListDueTasks(ctx, user.ID, from, to, after, limit+1, offset)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	hasNext := false
+	var nextCursor string
+	if len(ts) == limit+1 {
+		ts = ts[:limit]
+		hasNext = true
+		nextCursor, err = encodeCursor(uc.Cursor, database.TaskCursor(&ts[len(ts)-1], domain.TaskSortKeyDueOn), scope)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+	}
+
 	tags, err := uc.DB.GetTagsByIDs(ctx, ts.TagIDs())
 	if err != nil {
 		return nil, errtrace.Wrap(err)
 	}
+	return &ListTasksOutput{Tasks: ts, Tags: tags, HasNext: hasNext, NextCursor: nextCursor}, nil
+}
+
+type ListAssignedTasksInput struct {
+	Limit  int
+	Offset int
+	Cursor string
+}
+
+// ListAssignedTasks はすべてのプロジェクトからユーザが担当者である未完了のタスクを返す
+func (uc *Task) ListAssignedTasks(ctx context.Context, in *ListAssignedTasksInput) (*ListTasksOutput, error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	after, err := decodeCursor(uc.Cursor, in.Cursor, "assigned")
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	ts, err := uc.DB.ListAssignedTasks(ctx, user.ID, after, in.Limit+1, in.Offset)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
 
 	hasNext := false
+	var nextCursor string
 	if len(ts) == in.Limit+1 {
 		ts = ts[:in.Limit]
 		hasNext = true
+		nextCursor, err = encodeCursor(uc.Cursor, database.TaskCursor(&ts[len(ts)-1], domain.TaskSortKeyDueOn), "assigned")
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+	}
+
+	tags, err := uc.DB.GetTagsByIDs(ctx, ts.TagIDs())
+	if err != nil {
+		return nil, errtrace.Wrap(err)
 	}
-	return &ListTasksOutput{Tasks: ts, Tags: tags, HasNext: hasNext}, nil
+	return &ListTasksOutput{Tasks: ts, Tags: tags, HasNext: hasNext, NextCursor: nextCursor}, nil
 }
 
 type GetTaskInput struct {
@@ -134,16 +304,10 @@ func (uc *Task) GetTask(ctx context.Context, in *GetTaskInput) (*TaskOutput, err
 		return nil, errtrace.Wrap(err)
 	}
 
-	task, err := uc.DB.GetTaskByID(ctx, in.ID)
+	task, err := authorizeTask(ctx, uc.DB, user, in.ID, domain.PermissionRead, apierror.TaskNotFoundError())
 	if err != nil {
-		if errors.Is(err, database.ErrNotFound) {
-			return nil, errtrace.Wrap(apierror.TaskNotFoundError())
-		}
 		return nil, errtrace.Wrap(err)
 	}
-	if !user.HasTask(task) {
-		return nil, errtrace.Wrap(apierror.TaskNotFoundError())
-	}
 
 	tags, err := uc.DB.GetTagsByIDs(ctx, task.TagIDs)
 	if err != nil {
@@ -154,12 +318,17 @@ func (uc *Task) GetTask(ctx context.Context, in *GetTaskInput) (*TaskOutput, err
 
 type UpdateTaskInput struct {
 	ID          domain.TaskID
+	ProjectID   Option[domain.ProjectID]
+	AssigneeID  Option[*domain.UserID]
 	Name        Option[string]
 	TagIDs      Option[[]domain.TagID]
 	Content     Option[string]
 	Priority    Option[int]
 	DueOn       Option[*plain.Date]
+	Recurrence  Option[*domain.RecurrenceRule]
 	CompletedAt Option[*time.Time]
+	// IfMatch が空でない場合は、タスクのエンティティタグと一致するときのみ更新する
+	IfMatch string
 }
 
 func (uc *Task) UpdateTask(ctx context.Context, in *UpdateTaskInput) (_ *TaskOutput, err error) {
@@ -174,34 +343,85 @@ func (uc *Task) UpdateTask(ctx context.Context, in *UpdateTaskInput) (_ *TaskOut
 	}
 	defer commitOrRollback(&err)
 
-	task, err := uc.DB.GetTaskByID(ctx, in.ID)
+	task, err := authorizeTask(ctx, uc.DB, user, in.ID, domain.PermissionWrite, apierror.TaskNotFoundError())
 	if err != nil {
-		if errors.Is(err, database.ErrNotFound) {
-			return nil, errtrace.Wrap(apierror.TaskNotFoundError())
-		}
 		return nil, errtrace.Wrap(err)
 	}
-	if !user.HasTask(task) {
-		return nil, errtrace.Wrap(apierror.TaskNotFoundError())
+	if err := checkTaskIfMatch(ctx, uc.DB, task, in.IfMatch); err != nil {
+		return nil, errtrace.Wrap(err)
 	}
 
+	before := *task
+
+	// 別のプロジェクトに移動する場合は移動先のプロジェクトの末尾に置く
+	// タグはタスクの所有者のものであるため、移動先は所有者が同じプロジェクトに限る
+	if in.ProjectID.Valid && in.ProjectID.V != task.ProjectID {
+		p, err := authorizeProject(ctx, uc.DB, user, in.ProjectID.V, domain.PermissionWrite, apierror.ProjectNotFoundError())
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		if p.UserID != task.UserID {
+			return nil, errtrace.Wrap(apierror.ProjectNotFoundError())
+		}
+
+		count, err := uc.DB.CountTasks(ctx, p.ID)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		if count >= domain.MaxTasksPerProject {
+			return nil, errtrace.Wrap(apierror.TooManyTasksError())
+		}
+
+		entries, err := uc.DB.ListTaskPositions(ctx, p.ID)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		task.Position, err = appendPosition(ctx, entries, task.ID, uc.DB.UpdateTaskPositions)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		task.ProjectID = p.ID
+	}
+	if in.AssigneeID.Valid {
+		task.AssigneeID = in.AssigneeID.V
+	}
+	// 担当者を変更した場合と別のプロジェクトに移動した場合は、担当者がタスクのプロジェクトに参加しているかを確認する
+	if task.AssigneeID != nil && (in.AssigneeID.Valid || task.ProjectID != before.ProjectID) {
+		p, err := uc.DB.GetProjectByID(ctx, task.ProjectID)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		if err := validateAssignee(ctx, uc.DB, p, *task.AssigneeID); err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+	}
 	if in.Name.Valid {
 		task.Name = in.Name.V
 	}
 	var tags domain.Tags
 	if in.TagIDs.Valid {
-		tags, err = uc.DB.GetTagsByIDs(ctx, in.TagIDs.V)
+		// 共有プロジェクトの編集者もオーナーのタグを付け外しできるように、タスクの所有者のタグかで検証する
+		// 所有者以外のタグは指定の対象外のため、既に付いているものは残す
+		currentTags, err := uc.DB.GetTagsByIDs(ctx, task.TagIDs)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		requestedTags, err := uc.DB.GetTagsByIDs(ctx, in.TagIDs.V)
 		if err != nil {
 			return nil, errtrace.Wrap(err)
 		}
-		validTags := make(domain.Tags, 0, len(tags))
-		for _, t := range tags {
-			if user.HasTag(&t) {
-				validTags = append(validTags, t)
+		tags = make(domain.Tags, 0, len(currentTags)+len(requestedTags))
+		for _, t := range currentTags {
+			if !task.CanHaveTag(&t) {
+				tags = append(tags, t)
+			}
+		}
+		for _, t := range requestedTags {
+			if task.CanHaveTag(&t) {
+				tags = append(tags, t)
 			}
 		}
-		tags = validTags
-		task.TagIDs = validTags.IDs()
+		task.TagIDs = tags.IDs()
 	} else {
 		tags, err = uc.DB.GetTagsByIDs(ctx, task.TagIDs)
 		if err != nil {
@@ -217,11 +437,117 @@ func (uc *Task) UpdateTask(ctx context.Context, in *UpdateTaskInput) (_ *TaskOut
 	if in.DueOn.Valid {
 		task.DueOn = in.DueOn.V
 	}
+	if in.Recurrence.Valid {
+		task.Recurrence = in.Recurrence.V
+	}
+	wasCompleted := task.CompletedAt != nil
 	if in.CompletedAt.Valid {
 		task.CompletedAt = in.CompletedAt.V
 	}
-	task.UpdatedAt = clock.Now(ctx)
+	now := clock.Now(ctx)
+	task.Version++
+	task.UpdatedAt = now
+
+	// 繰り返しタスクを完了した場合は次回分のタスクを作成する
+	// 作成した次回分のタスクのIDを記録し、未完了に戻して再度完了しても重複して作成されないようにする
+	// 完了できなくならないように、プロジェクトのタスク数が上限に達している場合は次回分のタスクを作成しない
+	var next *domain.Task
+	if !wasCompleted && task.CompletedAt != nil && task.Recurrence != nil && task.NextOccurrenceID == nil {
+		count, err := uc.DB.CountTasks(ctx, task.ProjectID)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		if task.ProjectID != before.ProjectID {
+			count++
+		}
+		if count < domain.MaxTasksPerProject {
+			next, err = task.NextOccurrence(domain.TaskID(idgen.ULID(ctx)), plain.DateOf(*task.CompletedAt), now)
+			if err != nil {
+				return nil, errtrace.Wrap(err)
+			}
+			task.NextOccurrenceID = &next.ID
+		}
+	}
+
 	if err := uc.DB.UpdateTask(ctx, task); err != nil {
+		return nil, errtrace.Wrap(convertVersionConflict(err, in.IfMatch))
+	}
+	if err := recordHistory(ctx, uc.DB, domain.NewTaskHistoryEntry(user.ID, &before, task, now)); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	// 次回分のタスクは、移動した場合も含めて更新後のタスクと同じプロジェクトに作成する
+	if next != nil {
+		entries, err := uc.DB.ListTaskPositions(ctx, next.ProjectID)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		next.Position, err = appendPosition(ctx, entries, next.ID, uc.DB.UpdateTaskPositions)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		if err := uc.DB.CreateTask(ctx, next); err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		if err := recordHistory(ctx, uc.DB, domain.NewTaskHistoryEntry(user.ID, nil, next, now)); err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+	}
+	return &TaskOutput{Task: task, Tags: tags}, nil
+}
+
+type MoveTaskInput struct {
+	ID       domain.TaskID
+	BeforeID domain.TaskID
+	AfterID  domain.TaskID
+	// IfMatch が空でない場合は、タスクのエンティティタグと一致するときのみ移動する
+	IfMatch string
+}
+
+func (uc *Task) MoveTask(ctx context.Context, in *MoveTaskInput) (_ *TaskOutput, err error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
+	task, err := authorizeTask(ctx, uc.DB, user, in.ID, domain.PermissionWrite, apierror.TaskNotFoundError())
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if err := checkTaskIfMatch(ctx, uc.DB, task, in.IfMatch); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	before := *task
+	entries, err := uc.DB.ListTaskPositions(ctx, task.ProjectID)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	task.Position, err = movePosition(ctx, entries, task.ID, in.BeforeID, in.AfterID, uc.DB.UpdateTaskPositions)
+	if err != nil {
+		// 基準のタスクが同じプロジェクトに含まれない場合は存在しないものとして扱う
+		if errors.Is(err, domain.ErrPositionAnchorNotFound) {
+			return nil, errtrace.Wrap(apierror.TaskNotFoundError())
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	// 並び替えは内容の更新ではないため更新日時は変更せず、同時に行われた更新を検出できるように版数のみ上げる
+	task.Version++
+	if err := uc.DB.UpdateTask(ctx, task); err != nil {
+		return nil, errtrace.Wrap(convertVersionConflict(err, in.IfMatch))
+	}
+	if err := recordHistory(ctx, uc.DB, domain.NewTaskHistoryEntry(user.ID, &before, task, clock.Now(ctx))); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	tags, err := uc.DB.GetTagsByIDs(ctx, task.TagIDs)
+	if err != nil {
 		return nil, errtrace.Wrap(err)
 	}
 	return &TaskOutput{Task: task, Tags: tags}, nil
@@ -229,6 +555,8 @@ func (uc *Task) UpdateTask(ctx context.Context, in *UpdateTaskInput) (_ *TaskOut
 
 type DeleteTaskInput struct {
 	ID domain.TaskID
+	// IfMatch が空でない場合は、タスクのエンティティタグと一致するときのみ削除する
+	IfMatch string
 }
 
 func (uc *Task) DeleteTask(ctx context.Context, in *DeleteTaskInput) (err error) {
@@ -243,19 +571,33 @@ func (uc *Task) DeleteTask(ctx context.Context, in *DeleteTaskInput) (err error)
 	}
 	defer commitOrRollback(&err)
 
-	task, err := uc.DB.GetTaskByID(ctx, in.ID)
+	task, err := authorizeTask(ctx, uc.DB, user, in.ID, domain.PermissionWrite, apierror.TaskNotFoundError())
 	if err != nil {
-		if errors.Is(err, database.ErrNotFound) {
-			return errtrace.Wrap(apierror.TaskNotFoundError())
-		}
 		return errtrace.Wrap(err)
 	}
-	if !user.HasTask(task) {
-		return errtrace.Wrap(apierror.TaskNotFoundError())
+	if err := checkTaskIfMatch(ctx, uc.DB, task, in.IfMatch); err != nil {
+		return errtrace.Wrap(err)
 	}
 
-	if err := uc.DB.DeleteTaskByID(ctx, task.ID); err != nil {
+	now := clock.Now(ctx)
+	if err := uc.DB.TrashTaskByID(ctx, task.ID, now); err != nil {
+		return errtrace.Wrap(err)
+	}
+	if err := recordHistory(ctx, uc.DB, domain.NewTaskHistoryEntry(user.ID, task, nil, now)); err != nil {
 		return errtrace.Wrap(err)
 	}
 	return nil
 }
+
+// checkTaskIfMatch は If-Match ヘッダの値 ifMatch がタスクの現在のエンティティタグと一致することを確認する
+// タスクのエンティティタグはタグの版数も含むため、条件が指定されている場合のみタグを取得する
+func checkTaskIfMatch(ctx context.Context, db *database.Client, task *domain.Task, ifMatch string) error {
+	if ifMatch == "" {
+		return nil
+	}
+	tags, err := db.GetTagsByIDs(ctx, task.TagIDs)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	return errtrace.Wrap(checkIfMatch(ifMatch, task.ETag(tags)))
+}
@@ -0,0 +1,220 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/api/apierror"
+	"github.com/minguu42/harmattan/internal/database"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/clock"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+type Trash struct {
+	DB *database.Client
+	// Retention はゴミ箱に入れた項目を完全に削除するまでの保持期間である
+	Retention time.Duration
+}
+
+type TrashItemOutput struct {
+	Item *domain.TrashItem
+}
+
+type ListTrashInput struct {
+	Limit  int
+	Offset int
+}
+
+type ListTrashOutput struct {
+	Items   domain.TrashItems
+	HasNext bool
+}
+
+func (uc *Trash) ListTrash(ctx context.Context, in *ListTrashInput) (*ListTrashOutput, error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	items, err := uc.DB.ListTrashItems(ctx, user.ID, in.Limit+1, in.Offset)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	hasNext := false
+	if len(items) == in.Limit+1 {
+		items = items[:in.Limit]
+		hasNext = true
+	}
+	return &ListTrashOutput{Items: items, HasNext: hasNext}, nil
+}
+
+type RestoreTrashItemInput struct {
+	ID string
+}
+
+// RestoreTrashItem はゴミ箱に入っている項目を復元する
+// 項目と一緒にゴミ箱に入った子の項目も復元し、復元した項目は兄弟要素の末尾に置く
+func (uc *Trash) RestoreTrashItem(ctx context.Context, in *RestoreTrashItemInput) (_ *TrashItemOutput, err error) {
+	user, err := domain.UserFromContext(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	ctx, commitOrRollback, err := uc.DB.Begin(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
+	item, err := uc.DB.GetTrashItemByID(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, errtrace.Wrap(apierror.TrashItemNotFoundError())
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	if err := authorizeTrashItem(ctx, uc.DB, user, item); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	switch item.Type {
+	case domain.TrashItemTypeProject:
+		err = uc.restoreProject(ctx, user.ID, domain.ProjectID(item.ID), item.DeletedAt)
+	case domain.TrashItemTypeTask:
+		err = uc.restoreTask(ctx, domain.ProjectID(item.ParentID), domain.TaskID(item.ID), item.DeletedAt)
+	case domain.TrashItemTypeStep:
+		err = uc.restoreStep(ctx, domain.TaskID(item.ParentID), domain.StepID(item.ID))
+	case domain.TrashItemTypeTag:
+		err = uc.restoreTag(ctx, user.ID, domain.TagID(item.ID))
+	}
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if err := recordHistory(ctx, uc.DB, domain.NewRestoreHistoryEntry(user.ID, item, clock.Now(ctx))); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &TrashItemOutput{Item: item}, nil
+}
+
+// authorizeTrashItem はユーザがゴミ箱の項目を復元できることを確認する
+// タスクとステップは共有されたプロジェクトの編集者も削除できるため、親に対する編集の権限で確認する
+func authorizeTrashItem(ctx context.Context, db *database.Client, user *domain.User, item *domain.TrashItem) error {
+	switch item.Type {
+	case domain.TrashItemTypeTask:
+		_, err := authorizeProject(ctx, db, user, domain.ProjectID(item.ParentID), domain.PermissionWrite, apierror.TrashItemNotFoundError())
+		return errtrace.Wrap(err)
+	case domain.TrashItemTypeStep:
+		_, err := authorizeTask(ctx, db, user, domain.TaskID(item.ParentID), domain.PermissionWrite, apierror.TrashItemNotFoundError())
+		return errtrace.Wrap(err)
+	default:
+		if !user.HasTrashItem(item) {
+			return errtrace.Wrap(apierror.TrashItemNotFoundError())
+		}
+		return nil
+	}
+}
+
+func (uc *Trash) restoreProject(ctx context.Context, userID domain.UserID, id domain.ProjectID, deletedAt time.Time) error {
+	count, err := uc.DB.CountProjects(ctx, userID)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	if count >= domain.MaxProjectsPerUser {
+		return errtrace.Wrap(apierror.TooManyProjectsError())
+	}
+
+	entries, err := uc.DB.ListProjectPositions(ctx, userID)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	if err := uc.DB.RestoreProject(ctx, id, deletedAt); err != nil {
+		return errtrace.Wrap(err)
+	}
+	if err := restorePosition(ctx, entries, id, projectPositionUpdater(uc.DB, userID)); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func (uc *Trash) restoreTask(ctx context.Context, projectID domain.ProjectID, id domain.TaskID, deletedAt time.Time) error {
+	count, err := uc.DB.CountTasks(ctx, projectID)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	if count >= domain.MaxTasksPerProject {
+		return errtrace.Wrap(apierror.TooManyTasksError())
+	}
+
+	entries, err := uc.DB.ListTaskPositions(ctx, projectID)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	if err := uc.DB.RestoreTask(ctx, id, deletedAt); err != nil {
+		return errtrace.Wrap(err)
+	}
+	if err := restorePosition(ctx, entries, id, uc.DB.UpdateTaskPositions); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func (uc *Trash) restoreStep(ctx context.Context, taskID domain.TaskID, id domain.StepID) error {
+	count, err := uc.DB.CountSteps(ctx, taskID)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	if count >= domain.MaxStepsPerTask {
+		return errtrace.Wrap(apierror.TooManyStepsError())
+	}
+
+	entries, err := uc.DB.ListStepPositions(ctx, taskID)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	if err := uc.DB.RestoreStep(ctx, id); err != nil {
+		return errtrace.Wrap(err)
+	}
+	if err := restorePosition(ctx, entries, id, uc.DB.UpdateStepPositions); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func (uc *Trash) restoreTag(ctx context.Context, userID domain.UserID, id domain.TagID) error {
+	count, err := uc.DB.CountTags(ctx, userID)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	if count >= domain.MaxTagsPerUser {
+		return errtrace.Wrap(apierror.TooManyTagsError())
+	}
+
+	if err := uc.DB.RestoreTag(ctx, id); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// restorePosition は復元した要素 id を兄弟要素 entries の末尾に置く
+// ゴミ箱に入っている間に兄弟要素が並び替えられ、元の位置が他の要素と重複している可能性があるため位置を振り直す
+func restorePosition[ID ~string](ctx context.Context, entries []domain.PositionEntry[ID], id ID, update func(context.Context, map[ID]string) error) error {
+	p, err := appendPosition(ctx, entries, id, update)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	if err := update(ctx, map[ID]string{id: p}); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// PurgeTrash は保持期間を過ぎたゴミ箱の項目を完全に削除する
+func (uc *Trash) PurgeTrash(ctx context.Context) error {
+	if err := uc.DB.PurgeTrash(ctx, clock.Now(ctx).Add(-uc.Retention)); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
@@ -93,6 +93,16 @@ func ContextWithTracedLogger(ctx context.Context) context.Context {
 	})
 }
 
+// TraceID は ctx のスパンのトレースIDを返す
+// 有効なスパンがない場合は空文字列を返す
+func TraceID(ctx context.Context) string {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return ""
+	}
+	return spanContext.TraceID().String()
+}
+
 func EventLog(ctx context.Context, message string) {
 	logger(ctx).base.Log(ctx, slog.LevelInfo, message)
 }
@@ -2,7 +2,14 @@ package auth
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"strings"
+	"testing"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -11,56 +18,246 @@ import (
 	"github.com/minguu42/harmattan/internal/lib/errtrace"
 )
 
-func NewAuthenticator(idTokenSecret string, idTokenExpiration time.Duration) (*Authenticator, error) {
-	if idTokenSecret == "" {
-		return nil, errtrace.Wrap(errors.New("id token secret is required"))
+// NewAuthenticator は keys のうち activeKeyID の鍵でIDトークンに署名し、keys のいずれかの鍵で署名されたIDトークンを受け付ける Authenticator を返す
+// 鍵をローテーションする場合は、新しい鍵を追加して署名に使う鍵を切り替え、古い鍵で署名したIDトークンの有効期限が切れるまで古い鍵を検証用に残す
+// legacySecret は kid を持たないHS256のIDトークンの検証のみに使う共通鍵であり、空の場合はそのようなIDトークンを受け付けない
+func NewAuthenticator(keys []Key, activeKeyID, legacySecret string, idTokenExpiration, refreshTokenExpiration time.Duration) (*Authenticator, error) {
+	keyByID := make(map[string]Key, len(keys))
+	for _, k := range keys {
+		if _, ok := keyByID[k.ID]; ok {
+			return nil, errtrace.Wrap(fmt.Errorf("duplicate key id %q", k.ID))
+		}
+		keyByID[k.ID] = k
+	}
+	activeKey, ok := keyByID[activeKeyID]
+	if !ok {
+		return nil, errtrace.Wrap(fmt.Errorf("active key %q is not found", activeKeyID))
+	}
+	if !activeKey.CanSign() {
+		return nil, errtrace.Wrap(fmt.Errorf("active key %q must be a private key", activeKeyID))
 	}
 	if idTokenExpiration == 0 {
 		return nil, errtrace.Wrap(errors.New("id token expiration is required"))
 	}
+	if refreshTokenExpiration == 0 {
+		return nil, errtrace.Wrap(errors.New("refresh token expiration is required"))
+	}
 	return &Authenticator{
-		idTokenSecret:     idTokenSecret,
-		idTokenExpiration: idTokenExpiration,
+		keys:                   keys,
+		keyByID:                keyByID,
+		activeKey:              activeKey,
+		legacySecret:           []byte(legacySecret),
+		idTokenExpiration:      idTokenExpiration,
+		refreshTokenExpiration: refreshTokenExpiration,
 	}, nil
 }
 
 type Authenticator struct {
-	idTokenSecret     string
-	idTokenExpiration time.Duration
+	keys                   []Key
+	keyByID                map[string]Key
+	activeKey              Key
+	legacySecret           []byte
+	idTokenExpiration      time.Duration
+	refreshTokenExpiration time.Duration
+}
+
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	AuthTime *jwt.NumericDate `json:"auth_time,omitempty"`
 }
 
-func (a *Authenticator) CreateIDToken(ctx context.Context, id domain.UserID) (string, error) {
+// CreateIDToken はユーザ id のIDトークンを発行する
+// authTime はユーザがパスワードなどで認証した日時であり、リフレッシュトークンで再発行する場合も最初に認証した日時を渡す
+func (a *Authenticator) CreateIDToken(ctx context.Context, id domain.UserID, authTime time.Time) (string, error) {
 	now := clock.Now(ctx)
-	claims := jwt.RegisteredClaims{
-		Subject:   string(id),
-		ExpiresAt: jwt.NewNumericDate(now.Add(a.idTokenExpiration)),
-		IssuedAt:  jwt.NewNumericDate(now),
+	claims := idTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   string(id),
+			ExpiresAt: jwt.NewNumericDate(now.Add(a.idTokenExpiration)),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+	}
+	if !authTime.IsZero() {
+		claims.AuthTime = jwt.NewNumericDate(authTime)
 	}
-	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(a.idTokenSecret))
+	t := jwt.NewWithClaims(a.activeKey.method, claims)
+	t.Header["kid"] = a.activeKey.ID
+	token, err := t.SignedString(a.activeKey.privateKey)
 	if err != nil {
 		return "", errtrace.Wrap(err)
 	}
 	return token, nil
 }
 
-func (a *Authenticator) ParseIDToken(ctx context.Context, tokenString string) (domain.UserID, error) {
+// IDTokenClaims はIDトークンから取り出したクレームである
+type IDTokenClaims struct {
+	UserID   domain.UserID
+	IssuedAt time.Time
+	// AuthTime はユーザが認証した日時であり、IDトークンに含まれない場合はゼロ値である
+	AuthTime time.Time
+}
+
+// ParseIDToken はIDトークンを検証し、クレームを返す
+func (a *Authenticator) ParseIDToken(ctx context.Context, tokenString string) (*IDTokenClaims, error) {
+	methods := []string{jwt.SigningMethodEdDSA.Alg(), jwt.SigningMethodRS256.Alg()}
+	if len(a.legacySecret) > 0 {
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
 	parser := jwt.NewParser(
-		jwt.WithValidMethods([]string{"HS256"}),
+		jwt.WithValidMethods(methods),
 		jwt.WithExpirationRequired(),
 		jwt.WithIssuedAt(),
 		jwt.WithTimeFunc(func() time.Time { return clock.Now(ctx) }),
 	)
 
-	token, err := parser.Parse(tokenString, func(t *jwt.Token) (any, error) {
-		return []byte(a.idTokenSecret), nil
-	})
+	var claims idTokenClaims
+	if _, err := parser.ParseWithClaims(tokenString, &claims, a.verificationKey); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	if claims.Subject == "" {
+		return nil, errtrace.Wrap(errors.New("missing or empty sub claim"))
+	}
+	if claims.IssuedAt == nil {
+		return nil, errtrace.Wrap(errors.New("missing iat claim"))
+	}
+	c := &IDTokenClaims{UserID: domain.UserID(claims.Subject), IssuedAt: claims.IssuedAt.Time}
+	if claims.AuthTime != nil {
+		c.AuthTime = claims.AuthTime.Time
+	}
+	return c, nil
+}
+
+// verificationKey はIDトークンのヘッダの kid が指す鍵の公開鍵を返す
+// 鍵のアルゴリズムとヘッダの alg が異なる場合は、別のアルゴリズムの鍵として検証されないように拒否する
+func (a *Authenticator) verificationKey(t *jwt.Token) (any, error) {
+	kid, ok := t.Header["kid"].(string)
+	if !ok {
+		// 鍵のローテーションに対応する前に共通鍵で署名したIDトークンは kid を持たない
+		if len(a.legacySecret) > 0 && t.Method.Alg() == jwt.SigningMethodHS256.Alg() {
+			return a.legacySecret, nil
+		}
+		return nil, errtrace.Wrap(errors.New("missing kid header"))
+	}
+	k, ok := a.keyByID[kid]
+	if !ok {
+		return nil, errtrace.Wrap(fmt.Errorf("unknown key id %q", kid))
+	}
+	if t.Method.Alg() != k.method.Alg() {
+		return nil, errtrace.Wrap(fmt.Errorf("algorithm %q does not match key %q", t.Method.Alg(), kid))
+	}
+	return k.publicKey, nil
+}
+
+// JWKs は検証に使うすべての鍵の公開鍵を返す
+func (a *Authenticator) JWKs() []JWK {
+	jwks := make([]JWK, 0, len(a.keys))
+	for _, k := range a.keys {
+		jwks = append(jwks, k.JWK())
+	}
+	return jwks
+}
+
+type refreshTokenKey struct{}
+
+// CreateRefreshToken はリフレッシュトークンの平文と有効期限を返す
+func (a *Authenticator) CreateRefreshToken(ctx context.Context) (string, time.Time, error) {
+	expiresAt := clock.Now(ctx).Add(a.refreshTokenExpiration)
+	if testing.Testing() {
+		if v, ok := ctx.Value(refreshTokenKey{}).(string); ok {
+			return v, expiresAt, nil
+		}
+	}
+
+	token, err := randomToken()
+	if err != nil {
+		return "", time.Time{}, errtrace.Wrap(err)
+	}
+	return token, expiresAt, nil
+}
+
+func WithFixedRefreshToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, refreshTokenKey{}, token)
+}
+
+// HashRefreshToken はリフレッシュトークンのハッシュ値を返す
+func HashRefreshToken(token string) string {
+	return hashToken(token)
+}
+
+type oneTimeTokenKey struct{}
+
+// CreateOneTimeToken はパスワードの再設定やメールアドレスの確認のためにメールで送る1回限りのトークンの平文を返す
+func CreateOneTimeToken(ctx context.Context) (string, error) {
+	if testing.Testing() {
+		if v, ok := ctx.Value(oneTimeTokenKey{}).(string); ok {
+			return v, nil
+		}
+	}
+
+	token, err := randomToken()
 	if err != nil {
 		return "", errtrace.Wrap(err)
 	}
+	return token, nil
+}
+
+func WithFixedOneTimeToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, oneTimeTokenKey{}, token)
+}
+
+// HashOneTimeToken は1回限りのトークンのハッシュ値を返す
+func HashOneTimeToken(token string) string {
+	return hashToken(token)
+}
+
+// PersonalAccessTokenPrefix はパーソナルアクセストークンの接頭辞であり、IDトークンと区別するために使う
+const PersonalAccessTokenPrefix = "hmt_pat_"
 
-	claims := token.Claims.(jwt.MapClaims)
-	if id, ok := claims["sub"].(string); ok && id != "" {
-		return domain.UserID(id), nil
+type personalAccessTokenKey struct{}
+
+// CreatePersonalAccessToken はパーソナルアクセストークンの平文を返す
+func CreatePersonalAccessToken(ctx context.Context) (string, error) {
+	if testing.Testing() {
+		if v, ok := ctx.Value(personalAccessTokenKey{}).(string); ok {
+			return v, nil
+		}
 	}
-	return "", errtrace.Wrap(errors.New("missing or empty sub claim"))
+
+	token, err := randomToken()
+	if err != nil {
+		return "", errtrace.Wrap(err)
+	}
+	return PersonalAccessTokenPrefix + token, nil
+}
+
+func WithFixedPersonalAccessToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, personalAccessTokenKey{}, token)
+}
+
+// IsPersonalAccessToken はトークンがパーソナルアクセストークンの形式かを返す
+func IsPersonalAccessToken(token string) bool {
+	return strings.HasPrefix(token, PersonalAccessTokenPrefix)
+}
+
+// HashPersonalAccessToken はパーソナルアクセストークンのハッシュ値を返す
+func HashPersonalAccessToken(token string) string {
+	return hashToken(token)
+}
+
+// randomToken は256ビットの乱数をURLにそのまま含められる文字列で返す
+func randomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errtrace.Wrap(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashToken はトークンのSHA-256ハッシュ値を16進数で返す
+// トークンの平文はクライアントかメールにのみ渡し、データベースにはこのハッシュ値を保存する
+// トークンは十分な長さの乱数であるため、パスワードと異なりソルトやストレッチングは行わない
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
 }
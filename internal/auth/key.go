@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+// Key はIDトークンの署名・検証に使う鍵であり、kid で識別する
+// 秘密鍵を持つ鍵は署名と検証に、公開鍵のみを持つ鍵は検証のみに使う
+type Key struct {
+	ID         string
+	method     jwt.SigningMethod
+	privateKey any
+	publicKey  any
+}
+
+// ParseKey は"<kid>:<base64エンコードしたDER形式の鍵>"の形式の文字列を鍵として解析する
+// 鍵はPKCS#8形式の秘密鍵かPKIX形式の公開鍵であり、Ed25519とRSAに対応する
+// 秘密鍵は `openssl genpkey -algorithm ed25519 -outform DER | base64` などで生成できる
+func ParseKey(s string) (Key, error) {
+	id, encoded, ok := strings.Cut(s, ":")
+	if !ok || id == "" {
+		return Key{}, errtrace.Wrap(errors.New("key must be in the form of <kid>:<base64 encoded DER>"))
+	}
+	der, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return Key{}, errtrace.Wrap(fmt.Errorf("failed to decode key %q: %w", id, err))
+	}
+
+	if privateKey, err := x509.ParsePKCS8PrivateKey(der); err == nil {
+		switch k := privateKey.(type) {
+		case ed25519.PrivateKey:
+			return Key{ID: id, method: jwt.SigningMethodEdDSA, privateKey: k, publicKey: k.Public()}, nil
+		case *rsa.PrivateKey:
+			return Key{ID: id, method: jwt.SigningMethodRS256, privateKey: k, publicKey: &k.PublicKey}, nil
+		default:
+			return Key{}, errtrace.Wrap(fmt.Errorf("unsupported private key type %T for key %q", privateKey, id))
+		}
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return Key{}, errtrace.Wrap(fmt.Errorf("key %q is neither a PKCS#8 private key nor a PKIX public key", id))
+	}
+	switch k := publicKey.(type) {
+	case ed25519.PublicKey:
+		return Key{ID: id, method: jwt.SigningMethodEdDSA, publicKey: k}, nil
+	case *rsa.PublicKey:
+		return Key{ID: id, method: jwt.SigningMethodRS256, publicKey: k}, nil
+	default:
+		return Key{}, errtrace.Wrap(fmt.Errorf("unsupported public key type %T for key %q", publicKey, id))
+	}
+}
+
+// ParseKeys は ParseKey の形式の文字列をまとめて鍵として解析する
+func ParseKeys(ss []string) ([]Key, error) {
+	keys := make([]Key, 0, len(ss))
+	for _, s := range ss {
+		k, err := ParseKey(s)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		keys = append(keys, k)
+	}
+	return keys, nil
+}
+
+// CanSign は鍵が秘密鍵を持ち、署名に使えるかを返す
+func (k *Key) CanSign() bool {
+	return k.privateKey != nil
+}
+
+// JWK は公開鍵をRFC 7517のJSON Web Keyの形式で表したもの
+type JWK struct {
+	KeyType   string
+	KeyID     string
+	Algorithm string
+	Use       string
+	// Curve と X はOKP(Ed25519)の鍵のみが持つ
+	Curve string
+	X     string
+	// N と E はRSAの鍵のみが持つ
+	N string
+	E string
+}
+
+// JWK は鍵の公開鍵をJSON Web Keyとして返す
+func (k *Key) JWK() JWK {
+	jwk := JWK{KeyID: k.ID, Algorithm: k.method.Alg(), Use: "sig"}
+	switch pub := k.publicKey.(type) {
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	}
+	return jwk
+}
@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+	"github.com/minguu42/harmattan/internal/lib/totp"
+)
+
+// RecoveryCodeCount は2段階認証を有効にしたときに発行するリカバリーコードの数である
+const RecoveryCodeCount = 10
+
+type (
+	totpSecretKey    struct{}
+	recoveryCodesKey struct{}
+)
+
+// CreateTOTPSecret はTOTPの160ビットの秘密鍵をパディングなしのBase32でエンコードして返す
+func CreateTOTPSecret(ctx context.Context) (string, error) {
+	if testing.Testing() {
+		if v, ok := ctx.Value(totpSecretKey{}).(string); ok {
+			return v, nil
+		}
+	}
+
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", errtrace.Wrap(err)
+	}
+	return totp.EncodeSecret(b), nil
+}
+
+func WithFixedTOTPSecret(ctx context.Context, secret string) context.Context {
+	return context.WithValue(ctx, totpSecretKey{}, secret)
+}
+
+// CreateRecoveryCodes は RecoveryCodeCount 個のリカバリーコードの平文を返す
+// 各リカバリーコードは80ビットの乱数をBase32でエンコードし、読みやすいように4文字ずつハイフンで区切ったものである
+// 平文はクライアントにのみ渡し、データベースには HashRecoveryCode で求めたハッシュ値を保存する
+func CreateRecoveryCodes(ctx context.Context) ([]string, error) {
+	if testing.Testing() {
+		if v, ok := ctx.Value(recoveryCodesKey{}).([]string); ok {
+			return slices.Clone(v), nil
+		}
+	}
+
+	codes := make([]string, 0, RecoveryCodeCount)
+	for range RecoveryCodeCount {
+		b := make([]byte, 10)
+		if _, err := rand.Read(b); err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		s := base32.StdEncoding.EncodeToString(b)
+		codes = append(codes, s[0:4]+"-"+s[4:8]+"-"+s[8:12]+"-"+s[12:16])
+	}
+	return codes, nil
+}
+
+func WithFixedRecoveryCodes(ctx context.Context, codes []string) context.Context {
+	return context.WithValue(ctx, recoveryCodesKey{}, codes)
+}
+
+// HashRecoveryCode はリカバリーコードのSHA-256ハッシュ値を16進数で返す
+// 入力の揺れを許容するため、ハイフンと空白を取り除いて大文字に揃えてからハッシュ値を求める
+func HashRecoveryCode(code string) string {
+	normalized := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	return hashToken(normalized)
+}
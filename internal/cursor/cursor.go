@@ -0,0 +1,71 @@
+// Package cursor はページネーションに使う署名付きのカーソルを扱う
+package cursor
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+// ErrInvalid はカーソルの形式が正しくないか、署名の検証に失敗したことを表す
+var ErrInvalid = errors.New("invalid cursor")
+
+func NewCodec(secret string) (*Codec, error) {
+	if secret == "" {
+		return nil, errtrace.Wrap(errors.New("cursor secret is required"))
+	}
+	return &Codec{secret: []byte(secret)}, nil
+}
+
+// Codec は任意の値とクライアントにとって不透明なカーソル文字列を相互に変換する
+// カーソルには署名が付与されるため、クライアントによる改ざんを検出できる
+type Codec struct {
+	secret []byte
+}
+
+// Encode は v をJSONに変換して署名し、URLにそのまま含められる文字列を返す
+func (c *Codec) Encode(v any) (string, error) {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return "", errtrace.Wrap(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(c.sign(payload)), nil
+}
+
+// Decode は Encode で作成したカーソル s の署名を検証し、その内容を v に格納する
+func (c *Codec) Decode(s string, v any) error {
+	encodedPayload, encodedSignature, ok := strings.Cut(s, ".")
+	if !ok {
+		return errtrace.Wrap(ErrInvalid)
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return errtrace.Wrap(ErrInvalid)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return errtrace.Wrap(ErrInvalid)
+	}
+	if !hmac.Equal(signature, c.sign(payload)) {
+		return errtrace.Wrap(ErrInvalid)
+	}
+
+	d := json.NewDecoder(bytes.NewReader(payload))
+	d.DisallowUnknownFields()
+	if err := d.Decode(v); err != nil {
+		return errtrace.Wrap(ErrInvalid)
+	}
+	return nil
+}
+
+func (c *Codec) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
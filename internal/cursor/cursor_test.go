@@ -0,0 +1,67 @@
+package cursor_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/minguu42/harmattan/internal/cursor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type payload struct {
+	Key string `json:"key"`
+	ID  string `json:"id"`
+}
+
+func TestCodec(t *testing.T) {
+	t.Parallel()
+
+	c, err := cursor.NewCodec("VbMmhbxmw2XgDS0bqyHkzDF3Qy7JHqsS")
+	require.NoError(t, err)
+	other, err := cursor.NewCodec("kX6bhbd3nVnA49mW3FGBGR5spVSkgg0U")
+	require.NoError(t, err)
+
+	s, err := c.Encode(payload{Key: "2025-01-01T00:00:01+09:00", ID: "TASK-000000000000000000001"})
+	require.NoError(t, err)
+	tampered, err := c.Encode(payload{Key: "2025-01-01T00:00:01+09:00", ID: "TASK-000000000000000000002"})
+	require.NoError(t, err)
+	unknownField, err := c.Encode(map[string]string{"key": "a", "id": "b", "unknown": "c"})
+	require.NoError(t, err)
+
+	t.Run("round_trip", func(t *testing.T) {
+		t.Parallel()
+
+		var got payload
+		require.NoError(t, c.Decode(s, &got))
+		assert.Equal(t, payload{Key: "2025-01-01T00:00:01+09:00", ID: "TASK-000000000000000000001"}, got)
+	})
+
+	tests := []struct {
+		name  string
+		codec *cursor.Codec
+		s     string
+	}{
+		{name: "other_secret", codec: other, s: s},
+		{name: "tampered_payload", codec: c, s: tampered[:strings.Index(tampered, ".")] + s[strings.Index(s, "."):]},
+		{name: "missing_signature", codec: c, s: s[:strings.Index(s, ".")]},
+		{name: "not_base64", codec: c, s: "!!!.!!!"},
+		{name: "empty", codec: c, s: ""},
+		{name: "unknown_field", codec: c, s: unknownField},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got payload
+			assert.ErrorIs(t, tt.codec.Decode(tt.s, &got), cursor.ErrInvalid)
+		})
+	}
+}
+
+func TestNewCodec(t *testing.T) {
+	t.Parallel()
+
+	_, err := cursor.NewCodec("")
+	assert.Error(t, err)
+}
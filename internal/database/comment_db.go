@@ -0,0 +1,132 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+	"gorm.io/gorm"
+)
+
+type Comment struct {
+	ID        domain.CommentID
+	UserID    *domain.UserID
+	TaskID    domain.TaskID
+	Content   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (c *Comment) ToDomain() *domain.Comment {
+	return &domain.Comment{
+		ID:        c.ID,
+		UserID:    c.UserID,
+		TaskID:    c.TaskID,
+		Content:   c.Content,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+type Comments []Comment
+
+func (cs Comments) ToDomain() domain.Comments {
+	comments := make(domain.Comments, 0, len(cs))
+	for _, c := range cs {
+		comments = append(comments, *c.ToDomain())
+	}
+	return comments
+}
+
+func (c *Client) CreateComment(ctx context.Context, comment *domain.Comment) error {
+	if err := c.db(ctx).Create(&Comment{
+		ID:        comment.ID,
+		UserID:    comment.UserID,
+		TaskID:    comment.TaskID,
+		Content:   comment.Content,
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+	}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func (c *Client) CountComments(ctx context.Context, taskID domain.TaskID) (int, error) {
+	var count int64
+	if err := c.db(ctx).Model(Comment{}).Where("task_id = ?", taskID).Count(&count).Error; err != nil {
+		return 0, errtrace.Wrap(err)
+	}
+	return int(count), nil
+}
+
+// countCommentsByTaskID はタスクごとのコメント数を返す
+// コメントが付いていないタスクは結果に含まない
+func (c *Client) countCommentsByTaskID(ctx context.Context, taskIDs []domain.TaskID) (map[domain.TaskID]int, error) {
+	var rows []struct {
+		TaskID domain.TaskID
+		Count  int
+	}
+	if err := c.db(ctx).Model(Comment{}).Select("task_id", "COUNT(*) AS count").Where("task_id IN ?", taskIDs).Group("task_id").Find(&rows).Error; err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	counts := make(map[domain.TaskID]int, len(rows))
+	for _, r := range rows {
+		counts[r.TaskID] = r.Count
+	}
+	return counts, nil
+}
+
+// ListComments はタスクのコメントを作成日時の昇順で返す
+// after が nil でない場合は after が指すコメントより後ろのコメントを返す
+func (c *Client) ListComments(ctx context.Context, taskID domain.TaskID, after *Cursor, limit, offset int) (domain.Comments, error) {
+	var cs Comments
+	q := c.db(ctx).Where("task_id = ?", taskID)
+	if after != nil {
+		createdAt, err := parseCursorTime(after.Key)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		q = whereAfter(q, "created_at", createdAt, after.ID, false)
+	}
+	if err := q.Order("created_at").Order("id").Limit(limit).Offset(offset).Find(&cs).Error; err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return cs.ToDomain(), nil
+}
+
+// CommentCursor は ListComments で comment の次から取得するためのカーソルを返す
+func CommentCursor(comment *domain.Comment) *Cursor {
+	return &Cursor{Key: formatCursorTime(comment.CreatedAt), ID: string(comment.ID)}
+}
+
+func (c *Client) GetCommentByID(ctx context.Context, id domain.CommentID) (*domain.Comment, error) {
+	var comment Comment
+	if err := c.db(ctx).Where("id = ?", id).Take(&comment).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errtrace.Wrap(ErrNotFound)
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	return comment.ToDomain(), nil
+}
+
+func (c *Client) UpdateComment(ctx context.Context, comment *domain.Comment) error {
+	if err := c.db(ctx).Model(Comment{}).Where("id = ?", comment.ID).Updates(map[string]any{
+		"content":    comment.Content,
+		"updated_at": comment.UpdatedAt,
+	}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func (c *Client) DeleteCommentByID(ctx context.Context, id domain.CommentID) error {
+	if err := c.db(ctx).Where("id = ?", id).Delete(Comment{}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,263 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/database"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateComment(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Comments{},
+	}))
+
+	err := c.CreateComment(t.Context(), &domain.Comment{
+		ID:        "comment01",
+		UserID:    new(domain.UserID("user01")),
+		TaskID:    "task01",
+		Content:   "コメント1",
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst),
+		UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst),
+	})
+	require.NoError(t, err)
+
+	tdb.Assert(t, []any{
+		database.Comments{
+			{ID: "comment01", UserID: new(domain.UserID("user01")), TaskID: "task01", Content: "コメント1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+	})
+}
+
+func TestClient_CountComments(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "task02", UserID: "user01", ProjectID: "project01", Name: "タスク2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.Comments{
+			{ID: "comment01", UserID: new(domain.UserID("user01")), TaskID: "task01", Content: "コメント1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "comment02", UserID: new(domain.UserID("user01")), TaskID: "task01", Content: "コメント2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "comment03", UserID: new(domain.UserID("user01")), TaskID: "task02", Content: "コメント3", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+	}))
+
+	tests := []struct {
+		name   string
+		taskID domain.TaskID
+		want   int
+	}{
+		{
+			name:   "multiple",
+			taskID: "task01",
+			want:   2,
+		},
+		{
+			name:   "no_match",
+			taskID: "task99",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.CountComments(t.Context(), tt.taskID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_ListComments(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "task02", UserID: "user01", ProjectID: "project01", Name: "タスク2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.Comments{
+			{ID: "comment01", UserID: new(domain.UserID("user01")), TaskID: "task01", Content: "コメント1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "comment02", UserID: new(domain.UserID("user01")), TaskID: "task01", Content: "コメント2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "comment03", UserID: new(domain.UserID("user01")), TaskID: "task01", Content: "コメント3", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+			{ID: "comment04", UserID: new(domain.UserID("user01")), TaskID: "task02", Content: "コメント4", CreatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst)},
+		},
+	}))
+
+	tests := []struct {
+		name   string
+		taskID domain.TaskID
+		after  *database.Cursor
+		limit  int
+		offset int
+		want   domain.Comments
+	}{
+		{
+			name:   "multiple",
+			taskID: "task01",
+			limit:  10,
+			offset: 0,
+			want: domain.Comments{
+				{ID: "comment01", UserID: new(domain.UserID("user01")), TaskID: "task01", Content: "コメント1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+				{ID: "comment02", UserID: new(domain.UserID("user01")), TaskID: "task01", Content: "コメント2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+				{ID: "comment03", UserID: new(domain.UserID("user01")), TaskID: "task01", Content: "コメント3", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+			},
+		},
+		{
+			name:   "no_match",
+			taskID: "task99",
+			limit:  10,
+			offset: 0,
+			want:   domain.Comments{},
+		},
+		{
+			name:   "pagination",
+			taskID: "task01",
+			limit:  1,
+			offset: 1,
+			want: domain.Comments{
+				{ID: "comment02", UserID: new(domain.UserID("user01")), TaskID: "task01", Content: "コメント2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			},
+		},
+		{
+			name:   "cursor",
+			taskID: "task01",
+			after:  &database.Cursor{Key: "2025-01-01T00:00:02+09:00", ID: "comment02"},
+			limit:  10,
+			want: domain.Comments{
+				{ID: "comment03", UserID: new(domain.UserID("user01")), TaskID: "task01", Content: "コメント3", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.ListComments(t.Context(), tt.taskID, tt.after, tt.limit, tt.offset)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_GetCommentByID(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Comments{
+			{ID: "comment01", UserID: new(domain.UserID("user01")), TaskID: "task01", Content: "コメント1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+	}))
+
+	tests := []struct {
+		name    string
+		id      domain.CommentID
+		want    *domain.Comment
+		wantErr error
+	}{
+		{
+			name: "found",
+			id:   "comment01",
+			want: &domain.Comment{ID: "comment01", UserID: new(domain.UserID("user01")), TaskID: "task01", Content: "コメント1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		{
+			name:    "not_found",
+			id:      "comment99",
+			wantErr: database.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.GetCommentByID(t.Context(), tt.id)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_UpdateComment(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Comments{
+			{ID: "comment01", UserID: new(domain.UserID("user01")), TaskID: "task01", Content: "コメント1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+	}))
+
+	err := c.UpdateComment(t.Context(), &domain.Comment{
+		ID:        "comment01",
+		Content:   "更新後コメント",
+		UpdatedAt: time.Date(2025, 2, 1, 0, 0, 0, 0, jst),
+	})
+	require.NoError(t, err)
+
+	tdb.Assert(t, []any{
+		database.Comments{
+			{ID: "comment01", UserID: new(domain.UserID("user01")), TaskID: "task01", Content: "更新後コメント", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 2, 1, 0, 0, 0, 0, jst)},
+		},
+	})
+}
+
+func TestClient_DeleteCommentByID(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Comments{
+			{ID: "comment01", UserID: new(domain.UserID("user01")), TaskID: "task01", Content: "コメント1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "comment02", UserID: new(domain.UserID("user01")), TaskID: "task01", Content: "コメント2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+	}))
+
+	err := c.DeleteCommentByID(t.Context(), "comment01")
+	require.NoError(t, err)
+
+	tdb.Assert(t, []any{
+		database.Comments{
+			{ID: "comment02", UserID: new(domain.UserID("user01")), TaskID: "task01", Content: "コメント2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+	})
+}
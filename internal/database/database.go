@@ -17,7 +17,11 @@ import (
 	"gorm.io/plugin/opentelemetry/tracing"
 )
 
-var ErrNotFound = errors.New("model not found")
+var (
+	ErrNotFound = errors.New("model not found")
+	// ErrVersionConflict は更新しようとした行の版数が、読み込んだ後に他の更新によって変わっていたことを表す
+	ErrVersionConflict = errors.New("version conflict")
+)
 
 type Client struct {
 	gormDB *gorm.DB
@@ -101,7 +105,10 @@ func (c *Client) Ping(ctx context.Context) error {
 	return errtrace.Wrap(db.PingContext(ctx))
 }
 
-type txKey struct{}
+type (
+	txKey          struct{}
+	afterCommitKey struct{}
+)
 
 func (c *Client) db(ctx context.Context) *gorm.DB {
 	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
@@ -123,7 +130,10 @@ func (c *Client) Begin(ctx context.Context) (context.Context, func(*error), erro
 		return ctx, nil, errtrace.Wrap(err)
 	}
 
-	return context.WithValue(ctx, txKey{}, tx), func(errp *error) {
+	var afterCommit []func()
+	ctx = context.WithValue(ctx, txKey{}, tx)
+	ctx = context.WithValue(ctx, afterCommitKey{}, &afterCommit)
+	return ctx, func(errp *error) {
 		if errp == nil {
 			panic("database: commitOrRollback called with nil error pointer")
 		}
@@ -135,6 +145,34 @@ func (c *Client) Begin(ctx context.Context) (context.Context, func(*error), erro
 		}
 		if err := tx.Commit().Error; err != nil {
 			*errp = errtrace.Wrap(err)
+			return
+		}
+		for _, f := range afterCommit {
+			f()
 		}
 	}, nil
 }
+
+// AfterCommit は ctx のトランザクションをコミットした後に f を実行する
+// トランザクションの外で呼んだ場合はすぐに実行し、ロールバックした場合は実行しない
+func (c *Client) AfterCommit(ctx context.Context, f func()) {
+	if afterCommit, ok := ctx.Value(afterCommitKey{}).(*[]func()); ok {
+		*afterCommit = append(*afterCommit, f)
+		return
+	}
+	f()
+}
+
+// updateVersioned は model の行のうち、主キーが id で版数が version-1 のものを columns で更新し、版数を version にする
+// 該当する行がない場合は、読み込んだ後に他の更新が行われたとみなして ErrVersionConflict を返す
+func (c *Client) updateVersioned(ctx context.Context, model any, id any, version int, columns map[string]any) error {
+	columns["version"] = version
+	result := c.db(ctx).Model(model).Where("id = ? AND version = ?", id, version-1).Updates(columns)
+	if result.Error != nil {
+		return errtrace.Wrap(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errtrace.Wrap(ErrVersionConflict)
+	}
+	return nil
+}
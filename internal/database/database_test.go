@@ -184,3 +184,73 @@ func TestClient_Begin(t *testing.T) {
 		assert.Panics(t, func() { commitOrRollback(nil) })
 	})
 }
+
+func TestClient_AfterCommit(t *testing.T) {
+	t.Run("commit", func(t *testing.T) {
+		var calls []string
+		err := func(ctx context.Context) (err error) {
+			ctx, commitOrRollback, err := c.Begin(ctx)
+			if err != nil {
+				return err
+			}
+			defer commitOrRollback(&err)
+
+			c.AfterCommit(ctx, func() { calls = append(calls, "f1") })
+			c.AfterCommit(ctx, func() { calls = append(calls, "f2") })
+			assert.Empty(t, calls)
+			return nil
+		}(t.Context())
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"f1", "f2"}, calls)
+	})
+	t.Run("rollback", func(t *testing.T) {
+		var calls []string
+		err := func(ctx context.Context) (err error) {
+			ctx, commitOrRollback, err := c.Begin(ctx)
+			if err != nil {
+				return err
+			}
+			defer commitOrRollback(&err)
+
+			c.AfterCommit(ctx, func() { calls = append(calls, "f1") })
+			return errors.New("some error")
+		}(t.Context())
+
+		assert.Error(t, err)
+		assert.Empty(t, calls)
+	})
+	t.Run("nested_begin", func(t *testing.T) {
+		var calls []string
+		err := func(ctx context.Context) (err error) {
+			ctx, commitOrRollback, err := c.Begin(ctx)
+			if err != nil {
+				return err
+			}
+			defer commitOrRollback(&err)
+
+			if err := func(ctx context.Context) (err error) {
+				ctx, commitOrRollback, err := c.Begin(ctx)
+				if err != nil {
+					return err
+				}
+				defer commitOrRollback(&err)
+
+				c.AfterCommit(ctx, func() { calls = append(calls, "f1") })
+				return nil
+			}(ctx); err != nil {
+				return err
+			}
+			assert.Empty(t, calls)
+			return nil
+		}(t.Context())
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"f1"}, calls)
+	})
+	t.Run("outside_transaction", func(t *testing.T) {
+		var calls []string
+		c.AfterCommit(t.Context(), func() { calls = append(calls, "f1") })
+		assert.Equal(t, []string{"f1"}, calls)
+	})
+}
@@ -208,7 +208,8 @@ func (c *Client) Assert(t *testing.T, data []any) {
 		}
 
 		gotPointer := reflect.New(reflect.SliceOf(rv.Type().Elem())).Interface()
-		err := c.gormDB.Find(gotPointer).Error
+		// ゴミ箱に入っている行も検証できるよう、論理削除による絞り込みを無効にする
+		err := c.gormDB.Unscoped().Find(gotPointer).Error
 		require.NoError(t, err)
 
 		got := reflect.ValueOf(gotPointer).Elem().Interface()
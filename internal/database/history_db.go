@@ -0,0 +1,144 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+type HistoryEntry struct {
+	ID         uint64
+	UserID     *domain.UserID
+	EntityType domain.HistoryEntityType
+	EntityID   string
+	ProjectID  *domain.ProjectID
+	TaskID     *domain.TaskID
+	Action     domain.HistoryAction
+	Changes    FieldChanges
+	CreatedAt  time.Time
+}
+
+func (e *HistoryEntry) ToDomain() *domain.HistoryEntry {
+	changes := make([]domain.FieldChange, 0, len(e.Changes))
+	for _, c := range e.Changes {
+		changes = append(changes, domain.FieldChange{Field: c.Field, Before: c.Before, After: c.After})
+	}
+	return &domain.HistoryEntry{
+		ID:         e.ID,
+		UserID:     e.UserID,
+		EntityType: e.EntityType,
+		EntityID:   e.EntityID,
+		ProjectID:  e.ProjectID,
+		TaskID:     e.TaskID,
+		Action:     e.Action,
+		Changes:    changes,
+		CreatedAt:  e.CreatedAt,
+	}
+}
+
+type HistoryEntries []HistoryEntry
+
+func (es HistoryEntries) ToDomain() domain.HistoryEntries {
+	entries := make(domain.HistoryEntries, 0, len(es))
+	for _, e := range es {
+		entries = append(entries, *e.ToDomain())
+	}
+	return entries
+}
+
+type FieldChange struct {
+	Field  string `json:"field"`
+	Before any    `json:"before"`
+	After  any    `json:"after"`
+}
+
+// FieldChanges はJSON型の列に保存するフィールドの変更の一覧である
+type FieldChanges []FieldChange
+
+func (cs FieldChanges) Value() (driver.Value, error) {
+	b, err := json.Marshal(cs)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return string(b), nil
+}
+
+func (cs *FieldChanges) Scan(src any) error {
+	switch v := src.(type) {
+	case []byte:
+		return errtrace.Wrap(json.Unmarshal(v, cs))
+	case string:
+		return errtrace.Wrap(json.Unmarshal([]byte(v), cs))
+	default:
+		return errtrace.Wrap(fmt.Errorf("unsupported type: %T", src))
+	}
+}
+
+func (c *Client) CreateHistoryEntry(ctx context.Context, e *domain.HistoryEntry) error {
+	changes := make(FieldChanges, 0, len(e.Changes))
+	for _, c := range e.Changes {
+		changes = append(changes, FieldChange{Field: c.Field, Before: c.Before, After: c.After})
+	}
+	entry := HistoryEntry{
+		UserID:     e.UserID,
+		EntityType: e.EntityType,
+		EntityID:   e.EntityID,
+		ProjectID:  e.ProjectID,
+		TaskID:     e.TaskID,
+		Action:     e.Action,
+		Changes:    changes,
+		CreatedAt:  e.CreatedAt,
+	}
+	if err := c.db(ctx).Create(&entry).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	e.ID = entry.ID
+	return nil
+}
+
+// listHistoryEntries は column の値が value である履歴を新しい順に返す
+// after が nil でない場合は after が指す履歴より古い履歴を返す
+func (c *Client) listHistoryEntries(ctx context.Context, column string, value any, after *Cursor, limit, offset int) (domain.HistoryEntries, error) {
+	var es HistoryEntries
+	q := c.db(ctx).Where(column+" = ?", value)
+	if after != nil {
+		id, err := strconv.ParseUint(after.ID, 10, 64)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		q = q.Where("id < ?", id)
+	}
+	if err := q.Order("id DESC").Limit(limit).Offset(offset).Find(&es).Error; err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return es.ToDomain(), nil
+}
+
+// ListProjectHistoryEntries はプロジェクトとそのタスクの履歴を新しい順に返す
+func (c *Client) ListProjectHistoryEntries(ctx context.Context, projectID domain.ProjectID, after *Cursor, limit, offset int) (domain.HistoryEntries, error) {
+	es, err := c.listHistoryEntries(ctx, "project_id", projectID, after, limit, offset)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return es, nil
+}
+
+// ListTaskHistoryEntries はタスクとそのステップの履歴を新しい順に返す
+func (c *Client) ListTaskHistoryEntries(ctx context.Context, taskID domain.TaskID, after *Cursor, limit, offset int) (domain.HistoryEntries, error) {
+	es, err := c.listHistoryEntries(ctx, "task_id", taskID, after, limit, offset)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return es, nil
+}
+
+// HistoryEntryCursor は履歴の一覧で e の次から取得するためのカーソルを返す
+func HistoryEntryCursor(e *domain.HistoryEntry) *Cursor {
+	return &Cursor{ID: strconv.FormatUint(e.ID, 10)}
+}
@@ -0,0 +1,153 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/database"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateHistoryEntry(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.HistoryEntries{},
+	}))
+
+	e := domain.HistoryEntry{
+		UserID:     new(domain.UserID("user01")),
+		EntityType: domain.HistoryEntityTypeProject,
+		EntityID:   "project01",
+		ProjectID:  new(domain.ProjectID("project01")),
+		Action:     domain.HistoryActionUpdate,
+		Changes: []domain.FieldChange{
+			{Field: "name", Before: "プロジェクト", After: "プロジェクト1"},
+			{Field: "is_archived", Before: false, After: true},
+		},
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst),
+	}
+	err := c.CreateHistoryEntry(t.Context(), &e)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), e.ID)
+
+	tdb.Assert(t, []any{
+		database.HistoryEntries{
+			{
+				ID:         1,
+				UserID:     new(domain.UserID("user01")),
+				EntityType: domain.HistoryEntityTypeProject,
+				EntityID:   "project01",
+				ProjectID:  new(domain.ProjectID("project01")),
+				Action:     domain.HistoryActionUpdate,
+				Changes: database.FieldChanges{
+					{Field: "name", Before: "プロジェクト", After: "プロジェクト1"},
+					{Field: "is_archived", Before: false, After: true},
+				},
+				CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst),
+			},
+		},
+	})
+}
+
+func TestClient_ListTaskHistoryEntries(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "task02", UserID: "user01", ProjectID: "project01", Name: "タスク2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.HistoryEntries{
+			{ID: 1, UserID: new(domain.UserID("user01")), EntityType: domain.HistoryEntityTypeTask, EntityID: "task01", ProjectID: new(domain.ProjectID("project01")), TaskID: new(domain.TaskID("task01")), Action: domain.HistoryActionCreate, Changes: database.FieldChanges{{Field: "name", After: "タスク1"}}, CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: 2, UserID: new(domain.UserID("user01")), EntityType: domain.HistoryEntityTypeTask, EntityID: "task02", ProjectID: new(domain.ProjectID("project01")), TaskID: new(domain.TaskID("task02")), Action: domain.HistoryActionCreate, Changes: database.FieldChanges{{Field: "name", After: "タスク2"}}, CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: 3, UserID: new(domain.UserID("user01")), EntityType: domain.HistoryEntityTypeStep, EntityID: "step01", TaskID: new(domain.TaskID("task01")), Action: domain.HistoryActionCreate, Changes: database.FieldChanges{{Field: "name", After: "ステップ1"}}, CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+			{ID: 4, UserID: new(domain.UserID("user01")), EntityType: domain.HistoryEntityTypeTask, EntityID: "task01", ProjectID: new(domain.ProjectID("project01")), TaskID: new(domain.TaskID("task01")), Action: domain.HistoryActionUpdate, Changes: database.FieldChanges{{Field: "name", Before: "タスク1", After: "タスク"}}, CreatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst)},
+		},
+	}))
+
+	tests := []struct {
+		name    string
+		taskID  domain.TaskID
+		after   *database.Cursor
+		limit   int
+		offset  int
+		wantIDs []uint64
+	}{
+		{
+			name:    "newest_first",
+			taskID:  "task01",
+			limit:   10,
+			wantIDs: []uint64{4, 3, 1},
+		},
+		{
+			name:    "no_match",
+			taskID:  "task99",
+			limit:   10,
+			wantIDs: []uint64{},
+		},
+		{
+			name:    "pagination",
+			taskID:  "task01",
+			limit:   1,
+			offset:  1,
+			wantIDs: []uint64{3},
+		},
+		{
+			name:    "cursor",
+			taskID:  "task01",
+			after:   &database.Cursor{ID: "3"},
+			limit:   10,
+			wantIDs: []uint64{1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.ListTaskHistoryEntries(t.Context(), tt.taskID, tt.after, tt.limit, tt.offset)
+			require.NoError(t, err)
+
+			ids := make([]uint64, 0, len(got))
+			for _, e := range got {
+				ids = append(ids, e.ID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+		})
+	}
+}
+
+func TestClient_ListProjectHistoryEntries(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.HistoryEntries{
+			{ID: 1, UserID: new(domain.UserID("user01")), EntityType: domain.HistoryEntityTypeProject, EntityID: "project01", ProjectID: new(domain.ProjectID("project01")), Action: domain.HistoryActionCreate, Changes: database.FieldChanges{{Field: "name", After: "プロジェクト1"}}, CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: 2, UserID: new(domain.UserID("user01")), EntityType: domain.HistoryEntityTypeTask, EntityID: "task01", ProjectID: new(domain.ProjectID("project01")), TaskID: new(domain.TaskID("task01")), Action: domain.HistoryActionCreate, Changes: database.FieldChanges{{Field: "name", After: "タスク1"}}, CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: 3, UserID: new(domain.UserID("user01")), EntityType: domain.HistoryEntityTypeStep, EntityID: "step01", TaskID: new(domain.TaskID("task01")), Action: domain.HistoryActionCreate, Changes: database.FieldChanges{{Field: "name", After: "ステップ1"}}, CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+			{ID: 4, UserID: new(domain.UserID("user01")), EntityType: domain.HistoryEntityTypeTag, EntityID: "tag01", Action: domain.HistoryActionCreate, Changes: database.FieldChanges{{Field: "name", After: "タグ1"}}, CreatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst)},
+		},
+	}))
+
+	got, err := c.ListProjectHistoryEntries(t.Context(), "project01", nil, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, domain.HistoryEntries{
+		{ID: 2, UserID: new(domain.UserID("user01")), EntityType: domain.HistoryEntityTypeTask, EntityID: "task01", ProjectID: new(domain.ProjectID("project01")), TaskID: new(domain.TaskID("task01")), Action: domain.HistoryActionCreate, Changes: []domain.FieldChange{{Field: "name", After: "タスク1"}}, CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		{ID: 1, UserID: new(domain.UserID("user01")), EntityType: domain.HistoryEntityTypeProject, EntityID: "project01", ProjectID: new(domain.ProjectID("project01")), Action: domain.HistoryActionCreate, Changes: []domain.FieldChange{{Field: "name", After: "プロジェクト1"}}, CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+	}, got)
+}
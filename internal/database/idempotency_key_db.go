@@ -0,0 +1,94 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IdempotencyKey struct {
+	UserID      domain.UserID
+	Key         string
+	RequestHash string
+	Response    []byte
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+}
+
+func (k *IdempotencyKey) ToDomain() *domain.IdempotencyKey {
+	return &domain.IdempotencyKey{
+		UserID:      k.UserID,
+		Key:         k.Key,
+		RequestHash: k.RequestHash,
+		Response:    k.Response,
+		ExpiresAt:   k.ExpiresAt,
+		CreatedAt:   k.CreatedAt,
+	}
+}
+
+type IdempotencyKeys []IdempotencyKey
+
+// ReserveIdempotencyKey は処理中のキーを保存し、保存できたかを返す
+// 同じキーが既に保存されている場合は保存せず false を返すため、同時に送られた同じキーのリクエストのうち処理できるのはいずれか1つのみである
+// 有効期限を過ぎた同じキーと、abandonedBefore 以前から処理中のままの同じキーは削除してから保存する
+func (c *Client) ReserveIdempotencyKey(ctx context.Context, k *domain.IdempotencyKey, abandonedBefore time.Time) (bool, error) {
+	if err := c.db(ctx).
+		Where("user_id = ? AND `key` = ?", k.UserID, k.Key).
+		Where("expires_at <= ? OR (response IS NULL AND created_at <= ?)", k.CreatedAt, abandonedBefore).
+		Delete(IdempotencyKey{}).Error; err != nil {
+		return false, errtrace.Wrap(err)
+	}
+
+	result := c.db(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&IdempotencyKey{
+		UserID:      k.UserID,
+		Key:         k.Key,
+		RequestHash: k.RequestHash,
+		ExpiresAt:   k.ExpiresAt,
+		CreatedAt:   k.CreatedAt,
+	})
+	if result.Error != nil {
+		return false, errtrace.Wrap(result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (c *Client) GetIdempotencyKey(ctx context.Context, userID domain.UserID, key string) (*domain.IdempotencyKey, error) {
+	var k IdempotencyKey
+	if err := c.db(ctx).Where("user_id = ? AND `key` = ?", userID, key).Take(&k).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errtrace.Wrap(ErrNotFound)
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	return k.ToDomain(), nil
+}
+
+// CompleteIdempotencyKey は処理中のキーにレスポンスボディを保存し、処理を完了したことにする
+func (c *Client) CompleteIdempotencyKey(ctx context.Context, userID domain.UserID, key string, response []byte) error {
+	if err := c.db(ctx).Model(IdempotencyKey{}).
+		Where("user_id = ? AND `key` = ?", userID, key).
+		Update("response", response).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func (c *Client) DeleteIdempotencyKey(ctx context.Context, userID domain.UserID, key string) error {
+	if err := c.db(ctx).Where("user_id = ? AND `key` = ?", userID, key).Delete(IdempotencyKey{}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// DeleteExpiredIdempotencyKeys は now の時点で有効期限を過ぎたキーを削除する
+func (c *Client) DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) error {
+	if err := c.db(ctx).Where("expires_at <= ?", now).Delete(IdempotencyKey{}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
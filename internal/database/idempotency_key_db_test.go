@@ -0,0 +1,149 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/database"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ReserveIdempotencyKey(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "user02", Email: "user02@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.IdempotencyKeys{
+			{UserID: "user01", Key: "key01", RequestHash: "hash01", Response: []byte(`{"id":"task01"}`), ExpiresAt: time.Date(2025, 1, 2, 0, 0, 0, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, jst)},
+			{UserID: "user01", Key: "key02", RequestHash: "hash02", Response: []byte(`{"id":"task02"}`), ExpiresAt: time.Date(2025, 1, 1, 0, 5, 0, 0, jst), CreatedAt: time.Date(2024, 12, 31, 0, 5, 0, 0, jst)},
+			{UserID: "user01", Key: "key04", RequestHash: "hash04", ExpiresAt: time.Date(2025, 1, 2, 0, 9, 0, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 9, 0, 0, jst)},
+			{UserID: "user01", Key: "key05", RequestHash: "hash05", ExpiresAt: time.Date(2025, 1, 2, 0, 9, 30, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 9, 30, 0, jst)},
+		},
+	}))
+
+	tests := []struct {
+		name   string
+		userID domain.UserID
+		key    string
+		want   bool
+	}{
+		{name: "new_key", userID: "user01", key: "key03", want: true},
+		{name: "reserved_key", userID: "user01", key: "key03", want: false},
+		{name: "completed_key", userID: "user01", key: "key01", want: false},
+		{name: "expired_key", userID: "user01", key: "key02", want: true},
+		{name: "other_user_key", userID: "user02", key: "key01", want: true},
+		{name: "abandoned_key", userID: "user01", key: "key04", want: true},
+		{name: "in_progress_key", userID: "user01", key: "key05", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.ReserveIdempotencyKey(t.Context(), &domain.IdempotencyKey{
+				UserID:      tt.userID,
+				Key:         tt.key,
+				RequestHash: "hash03",
+				ExpiresAt:   time.Date(2025, 1, 2, 0, 10, 0, 0, jst),
+				CreatedAt:   time.Date(2025, 1, 1, 0, 10, 0, 0, jst),
+			}, time.Date(2025, 1, 1, 0, 9, 0, 0, jst))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	tdb.Assert(t, []any{
+		database.IdempotencyKeys{
+			{UserID: "user01", Key: "key01", RequestHash: "hash01", Response: []byte(`{"id":"task01"}`), ExpiresAt: time.Date(2025, 1, 2, 0, 0, 0, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, jst)},
+			{UserID: "user01", Key: "key02", RequestHash: "hash03", ExpiresAt: time.Date(2025, 1, 2, 0, 10, 0, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 10, 0, 0, jst)},
+			{UserID: "user01", Key: "key03", RequestHash: "hash03", ExpiresAt: time.Date(2025, 1, 2, 0, 10, 0, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 10, 0, 0, jst)},
+			{UserID: "user01", Key: "key04", RequestHash: "hash03", ExpiresAt: time.Date(2025, 1, 2, 0, 10, 0, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 10, 0, 0, jst)},
+			{UserID: "user01", Key: "key05", RequestHash: "hash05", ExpiresAt: time.Date(2025, 1, 2, 0, 9, 30, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 9, 30, 0, jst)},
+			{UserID: "user02", Key: "key01", RequestHash: "hash03", ExpiresAt: time.Date(2025, 1, 2, 0, 10, 0, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 10, 0, 0, jst)},
+		},
+	})
+}
+
+func TestClient_GetIdempotencyKey(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.IdempotencyKeys{
+			{UserID: "user01", Key: "key01", RequestHash: "hash01", Response: []byte(`{"id":"task01"}`), ExpiresAt: time.Date(2025, 1, 2, 0, 0, 0, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, jst)},
+		},
+	}))
+
+	tests := []struct {
+		name    string
+		userID  domain.UserID
+		key     string
+		want    *domain.IdempotencyKey
+		wantErr error
+	}{
+		{
+			name:   "found",
+			userID: "user01",
+			key:    "key01",
+			want:   &domain.IdempotencyKey{UserID: "user01", Key: "key01", RequestHash: "hash01", Response: []byte(`{"id":"task01"}`), ExpiresAt: time.Date(2025, 1, 2, 0, 0, 0, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, jst)},
+		},
+		{
+			name:    "not_found",
+			userID:  "user01",
+			key:     "key02",
+			wantErr: database.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.GetIdempotencyKey(t.Context(), tt.userID, tt.key)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_CompleteIdempotencyKey(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.IdempotencyKeys{
+			{UserID: "user01", Key: "key01", RequestHash: "hash01", ExpiresAt: time.Date(2025, 1, 2, 0, 0, 0, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, jst)},
+		},
+	}))
+
+	err := c.CompleteIdempotencyKey(t.Context(), "user01", "key01", []byte(`{"id":"task01"}`))
+	require.NoError(t, err)
+
+	tdb.Assert(t, []any{
+		database.IdempotencyKeys{
+			{UserID: "user01", Key: "key01", RequestHash: "hash01", Response: []byte(`{"id":"task01"}`), ExpiresAt: time.Date(2025, 1, 2, 0, 0, 0, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, jst)},
+		},
+	})
+}
+
+func TestClient_DeleteExpiredIdempotencyKeys(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.IdempotencyKeys{
+			{UserID: "user01", Key: "key01", RequestHash: "hash01", ExpiresAt: time.Date(2025, 1, 2, 0, 0, 0, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, jst)},
+			{UserID: "user01", Key: "key02", RequestHash: "hash02", ExpiresAt: time.Date(2025, 1, 1, 0, 10, 0, 0, jst), CreatedAt: time.Date(2024, 12, 31, 0, 10, 0, 0, jst)},
+		},
+	}))
+
+	err := c.DeleteExpiredIdempotencyKeys(t.Context(), time.Date(2025, 1, 1, 0, 10, 0, 0, jst))
+	require.NoError(t, err)
+
+	tdb.Assert(t, []any{
+		database.IdempotencyKeys{
+			{UserID: "user01", Key: "key01", RequestHash: "hash01", ExpiresAt: time.Date(2025, 1, 2, 0, 0, 0, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, jst)},
+		},
+	})
+}
@@ -0,0 +1,238 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+	"gorm.io/gorm"
+)
+
+type ProjectMember struct {
+	ProjectID domain.ProjectID
+	UserID    domain.UserID
+	Email     string `gorm:"->"`
+	Role      domain.ProjectRole
+	Position  string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (m *ProjectMember) ToDomain() *domain.ProjectMember {
+	return &domain.ProjectMember{
+		ProjectID: m.ProjectID,
+		UserID:    m.UserID,
+		Email:     m.Email,
+		Role:      m.Role,
+		Position:  m.Position,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+type ProjectMembers []ProjectMember
+
+func (ms ProjectMembers) ToDomain() domain.ProjectMembers {
+	members := make(domain.ProjectMembers, 0, len(ms))
+	for _, m := range ms {
+		members = append(members, *m.ToDomain())
+	}
+	return members
+}
+
+type ProjectInvitation struct {
+	ID          domain.ProjectInvitationID
+	ProjectID   domain.ProjectID
+	ProjectName string `gorm:"->"`
+	Email       string
+	Role        domain.ProjectRole
+	InvitedBy   domain.UserID
+	CreatedAt   time.Time
+}
+
+func (i *ProjectInvitation) ToDomain() *domain.ProjectInvitation {
+	return &domain.ProjectInvitation{
+		ID:          i.ID,
+		ProjectID:   i.ProjectID,
+		ProjectName: i.ProjectName,
+		Email:       i.Email,
+		Role:        i.Role,
+		InvitedBy:   i.InvitedBy,
+		CreatedAt:   i.CreatedAt,
+	}
+}
+
+type ProjectInvitations []ProjectInvitation
+
+func (is ProjectInvitations) ToDomain() domain.ProjectInvitations {
+	invitations := make(domain.ProjectInvitations, 0, len(is))
+	for _, i := range is {
+		invitations = append(invitations, *i.ToDomain())
+	}
+	return invitations
+}
+
+// accessibleProjectIDs はユーザがオーナーまたはメンバーであるプロジェクトのIDを返すサブクエリである
+func (c *Client) accessibleProjectIDs(ctx context.Context, userID domain.UserID) *gorm.DB {
+	members := c.db(ctx).Model(ProjectMember{}).Select("project_id").Where("user_id = ?", userID)
+	return c.db(ctx).Model(Project{}).Select("id").Where("user_id = ? OR id IN (?)", userID, members)
+}
+
+// writableProjectIDs はユーザがオーナーまたは編集者であるプロジェクトのIDを返すサブクエリである
+func (c *Client) writableProjectIDs(ctx context.Context, userID domain.UserID) *gorm.DB {
+	editors := c.db(ctx).Model(ProjectMember{}).Select("project_id").Where("user_id = ? AND role = ?", userID, domain.ProjectRoleEditor)
+	return c.db(ctx).Model(Project{}).Select("id").Where("user_id = ? OR id IN (?)", userID, editors)
+}
+
+// members はメンバーにユーザのメールアドレスを付与して取得するクエリである
+func (c *Client) members(ctx context.Context) *gorm.DB {
+	return c.db(ctx).Model(ProjectMember{}).
+		Select("project_members.*, users.email").
+		Joins("JOIN users ON users.id = project_members.user_id")
+}
+
+func (c *Client) CreateProjectMember(ctx context.Context, m *domain.ProjectMember) error {
+	if err := c.db(ctx).Create(&ProjectMember{
+		ProjectID: m.ProjectID,
+		UserID:    m.UserID,
+		Role:      m.Role,
+		Position:  m.Position,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// CountProjectMembers はプロジェクトのオーナーを除くメンバー数と招待中の数の合計を返す
+func (c *Client) CountProjectMembers(ctx context.Context, projectID domain.ProjectID) (int, error) {
+	var members, invitations int64
+	if err := c.db(ctx).Model(ProjectMember{}).Where("project_id = ?", projectID).Count(&members).Error; err != nil {
+		return 0, errtrace.Wrap(err)
+	}
+	if err := c.db(ctx).Model(ProjectInvitation{}).Where("project_id = ?", projectID).Count(&invitations).Error; err != nil {
+		return 0, errtrace.Wrap(err)
+	}
+	return int(members + invitations), nil
+}
+
+// ListProjectMembers はプロジェクトのオーナーを除くメンバーを参加日時の昇順で返す
+func (c *Client) ListProjectMembers(ctx context.Context, projectID domain.ProjectID) (domain.ProjectMembers, error) {
+	var ms ProjectMembers
+	if err := c.members(ctx).
+		Where("project_members.project_id = ?", projectID).
+		Order("project_members.created_at").
+		Order("project_members.user_id").
+		Find(&ms).Error; err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return ms.ToDomain(), nil
+}
+
+func (c *Client) GetProjectMember(ctx context.Context, projectID domain.ProjectID, userID domain.UserID) (*domain.ProjectMember, error) {
+	var m ProjectMember
+	if err := c.members(ctx).
+		Where("project_members.project_id = ? AND project_members.user_id = ?", projectID, userID).
+		Take(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errtrace.Wrap(ErrNotFound)
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	return m.ToDomain(), nil
+}
+
+func (c *Client) UpdateProjectMember(ctx context.Context, m *domain.ProjectMember) error {
+	if err := c.db(ctx).Model(ProjectMember{}).Where("project_id = ? AND user_id = ?", m.ProjectID, m.UserID).Updates(map[string]any{
+		"role":       m.Role,
+		"updated_at": m.UpdatedAt,
+	}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func (c *Client) DeleteProjectMember(ctx context.Context, projectID domain.ProjectID, userID domain.UserID) error {
+	if err := c.db(ctx).Where("project_id = ? AND user_id = ?", projectID, userID).Delete(ProjectMember{}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// invitations は招待にプロジェクト名を付与して取得するクエリである
+// ゴミ箱に入っているプロジェクトへの招待は含まない
+func (c *Client) invitations(ctx context.Context) *gorm.DB {
+	return c.db(ctx).Model(ProjectInvitation{}).
+		Select("project_invitations.*, projects.name AS project_name").
+		Joins("JOIN projects ON projects.id = project_invitations.project_id AND projects.deleted_at IS NULL")
+}
+
+func (c *Client) CreateProjectInvitation(ctx context.Context, i *domain.ProjectInvitation) error {
+	if err := c.db(ctx).Create(&ProjectInvitation{
+		ID:        i.ID,
+		ProjectID: i.ProjectID,
+		Email:     i.Email,
+		Role:      i.Role,
+		InvitedBy: i.InvitedBy,
+		CreatedAt: i.CreatedAt,
+	}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// ListProjectInvitations はプロジェクトへの招待を招待日時の昇順で返す
+func (c *Client) ListProjectInvitations(ctx context.Context, projectID domain.ProjectID) (domain.ProjectInvitations, error) {
+	var is ProjectInvitations
+	if err := c.invitations(ctx).
+		Where("project_invitations.project_id = ?", projectID).
+		Order("project_invitations.created_at").
+		Order("project_invitations.id").
+		Find(&is).Error; err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return is.ToDomain(), nil
+}
+
+// ListProjectInvitationsByEmail はメールアドレス宛ての招待を招待日時の昇順で返す
+func (c *Client) ListProjectInvitationsByEmail(ctx context.Context, email string) (domain.ProjectInvitations, error) {
+	var is ProjectInvitations
+	if err := c.invitations(ctx).
+		Where("project_invitations.email = ?", email).
+		Order("project_invitations.created_at").
+		Order("project_invitations.id").
+		Find(&is).Error; err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return is.ToDomain(), nil
+}
+
+func (c *Client) GetProjectInvitationByID(ctx context.Context, id domain.ProjectInvitationID) (*domain.ProjectInvitation, error) {
+	var i ProjectInvitation
+	if err := c.invitations(ctx).Where("project_invitations.id = ?", id).Take(&i).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errtrace.Wrap(ErrNotFound)
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	return i.ToDomain(), nil
+}
+
+// ExistsProjectInvitation はプロジェクトにメールアドレス宛ての招待が存在するかを返す
+func (c *Client) ExistsProjectInvitation(ctx context.Context, projectID domain.ProjectID, email string) (bool, error) {
+	var count int64
+	if err := c.db(ctx).Model(ProjectInvitation{}).Where("project_id = ? AND email = ?", projectID, email).Count(&count).Error; err != nil {
+		return false, errtrace.Wrap(err)
+	}
+	return count > 0, nil
+}
+
+func (c *Client) DeleteProjectInvitationByID(ctx context.Context, id domain.ProjectInvitationID) error {
+	if err := c.db(ctx).Where("id = ?", id).Delete(ProjectInvitation{}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/database"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestClient_CountProjectMembers(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "user02", Email: "user02@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "project02", UserID: "user02", Name: "プロジェクト2", Color: "gray", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.ProjectMembers{
+			{ProjectID: "project01", UserID: "user02", Role: domain.ProjectRoleEditor, CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+		database.ProjectInvitations{
+			{ID: "invitation01", ProjectID: "project01", Email: "user03@dummy.invalid", Role: domain.ProjectRoleViewer, InvitedBy: "user01", CreatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst)},
+		},
+	}))
+
+	tests := []struct {
+		name      string
+		projectID domain.ProjectID
+		want      int
+	}{
+		{
+			name:      "members_and_invitations",
+			projectID: "project01",
+			want:      2,
+		},
+		{
+			name:      "owner_only",
+			projectID: "project02",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.CountProjectMembers(t.Context(), tt.projectID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_ListProjectMembers(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "user02", Email: "user02@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "user03", Email: "user03@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "project02", UserID: "user02", Name: "プロジェクト2", Color: "gray", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.ProjectMembers{
+			{ProjectID: "project01", UserID: "user03", Role: domain.ProjectRoleViewer, CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+			{ProjectID: "project01", UserID: "user02", Role: domain.ProjectRoleEditor, CreatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst)},
+			{ProjectID: "project02", UserID: "user01", Role: domain.ProjectRoleEditor, CreatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst)},
+		},
+	}))
+
+	got, err := c.ListProjectMembers(t.Context(), "project01")
+	require.NoError(t, err)
+
+	want := domain.ProjectMembers{
+		{ProjectID: "project01", UserID: "user03", Email: "user03@dummy.invalid", Role: domain.ProjectRoleViewer, CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		{ProjectID: "project01", UserID: "user02", Email: "user02@dummy.invalid", Role: domain.ProjectRoleEditor, CreatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst)},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestClient_ListProjectInvitationsByEmail(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "project02", UserID: "user01", Name: "プロジェクト2", Color: "gray", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "project03", UserID: "user01", Name: "プロジェクト3", Color: "red", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 1, 1, 0, 0, 4, 0, jst), Valid: true}},
+		},
+		database.ProjectInvitations{
+			{ID: "invitation01", ProjectID: "project02", Email: "user02@dummy.invalid", Role: domain.ProjectRoleViewer, InvitedBy: "user01", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "invitation02", ProjectID: "project01", Email: "user02@dummy.invalid", Role: domain.ProjectRoleEditor, InvitedBy: "user01", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "invitation03", ProjectID: "project01", Email: "user03@dummy.invalid", Role: domain.ProjectRoleEditor, InvitedBy: "user01", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+			{ID: "invitation04", ProjectID: "project03", Email: "user02@dummy.invalid", Role: domain.ProjectRoleEditor, InvitedBy: "user01", CreatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst)},
+		},
+	}))
+
+	got, err := c.ListProjectInvitationsByEmail(t.Context(), "user02@dummy.invalid")
+	require.NoError(t, err)
+
+	want := domain.ProjectInvitations{
+		{ID: "invitation01", ProjectID: "project02", ProjectName: "プロジェクト2", Email: "user02@dummy.invalid", Role: domain.ProjectRoleViewer, InvitedBy: "user01", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		{ID: "invitation02", ProjectID: "project01", ProjectName: "プロジェクト1", Email: "user02@dummy.invalid", Role: domain.ProjectRoleEditor, InvitedBy: "user01", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+	}
+	assert.Equal(t, want, got)
+}
@@ -0,0 +1,77 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+	"gorm.io/gorm"
+)
+
+type OIDCAuthRequest struct {
+	ID           domain.OIDCAuthRequestID
+	StateHash    string
+	Nonce        string
+	CodeVerifier string
+	ExpiresAt    time.Time
+	UsedAt       *time.Time
+	CreatedAt    time.Time
+}
+
+// TableName はテーブル名を返す。gorm の命名規則では OIDC を o_id_c と変換してしまうため明示する
+func (OIDCAuthRequest) TableName() string {
+	return "oidc_auth_requests"
+}
+
+func (r *OIDCAuthRequest) ToDomain() *domain.OIDCAuthRequest {
+	return &domain.OIDCAuthRequest{
+		ID:           r.ID,
+		StateHash:    r.StateHash,
+		Nonce:        r.Nonce,
+		CodeVerifier: r.CodeVerifier,
+		ExpiresAt:    r.ExpiresAt,
+		UsedAt:       r.UsedAt,
+		CreatedAt:    r.CreatedAt,
+	}
+}
+
+type OIDCAuthRequests []OIDCAuthRequest
+
+func (c *Client) CreateOIDCAuthRequest(ctx context.Context, r *domain.OIDCAuthRequest) error {
+	if err := c.db(ctx).Create(&OIDCAuthRequest{
+		ID:           r.ID,
+		StateHash:    r.StateHash,
+		Nonce:        r.Nonce,
+		CodeVerifier: r.CodeVerifier,
+		ExpiresAt:    r.ExpiresAt,
+		CreatedAt:    r.CreatedAt,
+	}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func (c *Client) GetOIDCAuthRequestByStateHash(ctx context.Context, stateHash string) (*domain.OIDCAuthRequest, error) {
+	var r OIDCAuthRequest
+	if err := c.db(ctx).Where("state_hash = ?", stateHash).Take(&r).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errtrace.Wrap(ErrNotFound)
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	return r.ToDomain(), nil
+}
+
+// MarkOIDCAuthRequestUsed は未使用の認可リクエストを使用済みにし、使用済みにできたかを返す
+// 同じ state で同時にコールバックされた場合も、使用済みにできるのはいずれか1つのリクエストのみである
+func (c *Client) MarkOIDCAuthRequestUsed(ctx context.Context, id domain.OIDCAuthRequestID, usedAt time.Time) (bool, error) {
+	result := c.db(ctx).Model(OIDCAuthRequest{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", usedAt)
+	if result.Error != nil {
+		return false, errtrace.Wrap(result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
@@ -0,0 +1,79 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/database"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateOIDCAuthRequest(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.OIDCAuthRequests{},
+	}))
+
+	err := c.CreateOIDCAuthRequest(t.Context(), &domain.OIDCAuthRequest{
+		ID:           "request01",
+		StateHash:    "hash01",
+		Nonce:        "nonce01",
+		CodeVerifier: "verifier01",
+		ExpiresAt:    time.Date(2025, 1, 1, 0, 20, 0, 0, jst),
+		CreatedAt:    time.Date(2025, 1, 1, 0, 10, 0, 0, jst),
+	})
+	require.NoError(t, err)
+
+	tdb.Assert(t, []any{
+		database.OIDCAuthRequests{
+			{ID: "request01", StateHash: "hash01", Nonce: "nonce01", CodeVerifier: "verifier01", ExpiresAt: time.Date(2025, 1, 1, 0, 20, 0, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 10, 0, 0, jst)},
+		},
+	})
+}
+
+func TestClient_GetOIDCAuthRequestByStateHash(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.OIDCAuthRequests{
+			{ID: "request01", StateHash: "hash01", Nonce: "nonce01", CodeVerifier: "verifier01", ExpiresAt: time.Date(2025, 1, 1, 0, 20, 0, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 10, 0, 0, jst)},
+		},
+	}))
+
+	t.Run("ok", func(t *testing.T) {
+		got, err := c.GetOIDCAuthRequestByStateHash(t.Context(), "hash01")
+		require.NoError(t, err)
+
+		want := &domain.OIDCAuthRequest{ID: "request01", StateHash: "hash01", Nonce: "nonce01", CodeVerifier: "verifier01", ExpiresAt: time.Date(2025, 1, 1, 0, 20, 0, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 10, 0, 0, jst)}
+		assert.Equal(t, want, got)
+	})
+	t.Run("not_found", func(t *testing.T) {
+		_, err := c.GetOIDCAuthRequestByStateHash(t.Context(), "hash02")
+		assert.ErrorIs(t, err, database.ErrNotFound)
+	})
+}
+
+func TestClient_MarkOIDCAuthRequestUsed(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.OIDCAuthRequests{
+			{ID: "request01", StateHash: "hash01", Nonce: "nonce01", CodeVerifier: "verifier01", ExpiresAt: time.Date(2025, 1, 1, 0, 20, 0, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 10, 0, 0, jst)},
+			{ID: "request02", StateHash: "hash02", Nonce: "nonce02", CodeVerifier: "verifier02", ExpiresAt: time.Date(2025, 1, 1, 0, 20, 0, 0, jst), UsedAt: new(time.Date(2025, 1, 1, 0, 5, 0, 0, jst)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, jst)},
+		},
+	}))
+
+	tests := []struct {
+		name string
+		id   domain.OIDCAuthRequestID
+		want bool
+	}{
+		{name: "unused", id: "request01", want: true},
+		{name: "used_twice", id: "request01", want: false},
+		{name: "already_used", id: "request02", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.MarkOIDCAuthRequestUsed(t.Context(), tt.id, time.Date(2025, 1, 1, 0, 10, 0, 0, jst))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
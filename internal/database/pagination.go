@@ -0,0 +1,32 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Cursor はキーセットページネーションにおいて直前のページの最後の行を表す
+// Key は並び替えに使う列の値を文字列にしたものであり、ID はその行のIDである
+type Cursor struct {
+	Key string `json:"key"`
+	ID  string `json:"id"`
+}
+
+// whereAfter は (column, id) の組で並べたときに key と id の組より後ろにある行に絞り込む
+func whereAfter(q *gorm.DB, column string, key any, id string, desc bool) *gorm.DB {
+	op := ">"
+	if desc {
+		op = "<"
+	}
+	return q.Where(fmt.Sprintf("(%[1]s %[2]s ? OR (%[1]s = ? AND id %[2]s ?))", column, op), key, key, id)
+}
+
+func formatCursorTime(t time.Time) string {
+	return t.Format(time.RFC3339Nano)
+}
+
+func parseCursorTime(s string) (time.Time, error) {
+	return time.Parse(time.RFC3339Nano, s)
+}
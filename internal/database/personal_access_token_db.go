@@ -0,0 +1,131 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+	"gorm.io/gorm"
+)
+
+type PersonalAccessToken struct {
+	ID        domain.PersonalAccessTokenID
+	UserID    domain.UserID
+	Name      string
+	TokenHash string
+	// Scopes はスコープをカンマ区切りで連結した文字列である
+	Scopes     string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+}
+
+func (t *PersonalAccessToken) ToDomain() *domain.PersonalAccessToken {
+	var scopes []domain.Scope
+	for s := range strings.SplitSeq(t.Scopes, ",") {
+		scopes = append(scopes, domain.Scope(s))
+	}
+	return &domain.PersonalAccessToken{
+		ID:         t.ID,
+		UserID:     t.UserID,
+		Name:       t.Name,
+		TokenHash:  t.TokenHash,
+		Scopes:     scopes,
+		ExpiresAt:  t.ExpiresAt,
+		LastUsedAt: t.LastUsedAt,
+		CreatedAt:  t.CreatedAt,
+	}
+}
+
+type PersonalAccessTokens []PersonalAccessToken
+
+func (ts PersonalAccessTokens) ToDomain() domain.PersonalAccessTokens {
+	tokens := make(domain.PersonalAccessTokens, 0, len(ts))
+	for _, t := range ts {
+		tokens = append(tokens, *t.ToDomain())
+	}
+	return tokens
+}
+
+func (c *Client) CreatePersonalAccessToken(ctx context.Context, t *domain.PersonalAccessToken) error {
+	scopes := make([]string, 0, len(t.Scopes))
+	for _, s := range t.Scopes {
+		scopes = append(scopes, string(s))
+	}
+	if err := c.db(ctx).Create(&PersonalAccessToken{
+		ID:        t.ID,
+		UserID:    t.UserID,
+		Name:      t.Name,
+		TokenHash: t.TokenHash,
+		Scopes:    strings.Join(scopes, ","),
+		ExpiresAt: t.ExpiresAt,
+		CreatedAt: t.CreatedAt,
+	}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func (c *Client) CountPersonalAccessTokens(ctx context.Context, userID domain.UserID) (int, error) {
+	var count int64
+	if err := c.db(ctx).Model(PersonalAccessToken{}).Where("user_id = ?", userID).Count(&count).Error; err != nil {
+		return 0, errtrace.Wrap(err)
+	}
+	return int(count), nil
+}
+
+// ListPersonalAccessTokens はユーザのパーソナルアクセストークンを作成日時の昇順で返す
+func (c *Client) ListPersonalAccessTokens(ctx context.Context, userID domain.UserID) (domain.PersonalAccessTokens, error) {
+	var ts PersonalAccessTokens
+	if err := c.db(ctx).Where("user_id = ?", userID).Order("created_at").Order("id").Find(&ts).Error; err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return ts.ToDomain(), nil
+}
+
+func (c *Client) GetPersonalAccessTokenByID(ctx context.Context, id domain.PersonalAccessTokenID) (*domain.PersonalAccessToken, error) {
+	var t PersonalAccessToken
+	if err := c.db(ctx).Where("id = ?", id).Take(&t).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errtrace.Wrap(ErrNotFound)
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	return t.ToDomain(), nil
+}
+
+func (c *Client) GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (*domain.PersonalAccessToken, error) {
+	var t PersonalAccessToken
+	if err := c.db(ctx).Where("token_hash = ?", tokenHash).Take(&t).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errtrace.Wrap(ErrNotFound)
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	return t.ToDomain(), nil
+}
+
+func (c *Client) UpdatePersonalAccessTokenLastUsedAt(ctx context.Context, id domain.PersonalAccessTokenID, lastUsedAt time.Time) error {
+	if err := c.db(ctx).Model(PersonalAccessToken{}).Where("id = ?", id).Update("last_used_at", lastUsedAt).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func (c *Client) DeletePersonalAccessToken(ctx context.Context, id domain.PersonalAccessTokenID) error {
+	if err := c.db(ctx).Where("id = ?", id).Delete(PersonalAccessToken{}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// DeleteUserPersonalAccessTokens はユーザのパーソナルアクセストークンをすべて削除する
+func (c *Client) DeleteUserPersonalAccessTokens(ctx context.Context, userID domain.UserID) error {
+	if err := c.db(ctx).Where("user_id = ?", userID).Delete(PersonalAccessToken{}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
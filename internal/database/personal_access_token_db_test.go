@@ -0,0 +1,151 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/database"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreatePersonalAccessToken(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.PersonalAccessTokens{},
+	}))
+
+	err := c.CreatePersonalAccessToken(t.Context(), &domain.PersonalAccessToken{
+		ID:        "token01",
+		UserID:    "user01",
+		Name:      "トークン1",
+		TokenHash: "hash01",
+		Scopes:    []domain.Scope{domain.ScopeProjectsRead, domain.ScopeTasksWrite},
+		ExpiresAt: new(time.Date(2025, 2, 1, 0, 0, 0, 0, jst)),
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst),
+	})
+	require.NoError(t, err)
+
+	tdb.Assert(t, []any{
+		database.PersonalAccessTokens{
+			{ID: "token01", UserID: "user01", Name: "トークン1", TokenHash: "hash01", Scopes: "projects:read,tasks:write", ExpiresAt: new(time.Date(2025, 2, 1, 0, 0, 0, 0, jst)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+	})
+}
+
+func TestClient_ListPersonalAccessTokens(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "user02", Email: "user02@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.PersonalAccessTokens{
+			{ID: "token01", UserID: "user01", Name: "トークン1", TokenHash: "hash01", Scopes: "tags:read", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "token02", UserID: "user01", Name: "トークン2", TokenHash: "hash02", Scopes: "projects:read,tasks:write", LastUsedAt: new(time.Date(2025, 1, 1, 0, 5, 0, 0, jst)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "token03", UserID: "user02", Name: "トークン3", TokenHash: "hash03", Scopes: "tags:read", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+	}))
+
+	got, err := c.ListPersonalAccessTokens(t.Context(), "user01")
+	require.NoError(t, err)
+
+	want := domain.PersonalAccessTokens{
+		{ID: "token02", UserID: "user01", Name: "トークン2", TokenHash: "hash02", Scopes: []domain.Scope{domain.ScopeProjectsRead, domain.ScopeTasksWrite}, LastUsedAt: new(time.Date(2025, 1, 1, 0, 5, 0, 0, jst)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		{ID: "token01", UserID: "user01", Name: "トークン1", TokenHash: "hash01", Scopes: []domain.Scope{domain.ScopeTagsRead}, CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestClient_GetPersonalAccessTokenByHash(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.PersonalAccessTokens{
+			{ID: "token01", UserID: "user01", Name: "トークン1", TokenHash: "hash01", Scopes: "tasks:read", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+	}))
+
+	tests := []struct {
+		name      string
+		tokenHash string
+		want      *domain.PersonalAccessToken
+		wantErr   error
+	}{
+		{
+			name:      "found",
+			tokenHash: "hash01",
+			want: &domain.PersonalAccessToken{
+				ID:        "token01",
+				UserID:    "user01",
+				Name:      "トークン1",
+				TokenHash: "hash01",
+				Scopes:    []domain.Scope{domain.ScopeTasksRead},
+				CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst),
+			},
+		},
+		{
+			name:      "not_found",
+			tokenHash: "hash99",
+			wantErr:   database.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.GetPersonalAccessTokenByHash(t.Context(), tt.tokenHash)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_UpdatePersonalAccessTokenLastUsedAt(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.PersonalAccessTokens{
+			{ID: "token01", UserID: "user01", Name: "トークン1", TokenHash: "hash01", Scopes: "tasks:read", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "token02", UserID: "user01", Name: "トークン2", TokenHash: "hash02", Scopes: "tasks:read", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+	}))
+
+	require.NoError(t, c.UpdatePersonalAccessTokenLastUsedAt(t.Context(), "token01", time.Date(2025, 1, 1, 0, 10, 0, 0, jst)))
+
+	tdb.Assert(t, []any{
+		database.PersonalAccessTokens{
+			{ID: "token01", UserID: "user01", Name: "トークン1", TokenHash: "hash01", Scopes: "tasks:read", LastUsedAt: new(time.Date(2025, 1, 1, 0, 10, 0, 0, jst)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "token02", UserID: "user01", Name: "トークン2", TokenHash: "hash02", Scopes: "tasks:read", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+	})
+}
+
+func TestClient_DeleteUserPersonalAccessTokens(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "user02", Email: "user02@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.PersonalAccessTokens{
+			{ID: "token01", UserID: "user01", Name: "トークン1", TokenHash: "hash01", Scopes: "tasks:read", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "token02", UserID: "user01", Name: "トークン2", TokenHash: "hash02", Scopes: "tasks:read", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "token03", UserID: "user02", Name: "トークン3", TokenHash: "hash03", Scopes: "tasks:read", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+	}))
+
+	require.NoError(t, c.DeleteUserPersonalAccessTokens(t.Context(), "user01"))
+
+	tdb.Assert(t, []any{
+		database.PersonalAccessTokens{
+			{ID: "token03", UserID: "user02", Name: "トークン3", TokenHash: "hash03", Scopes: "tasks:read", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+	})
+}
@@ -3,6 +3,8 @@ package database
 import (
 	"context"
 	"errors"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/minguu42/harmattan/internal/domain"
@@ -16,8 +18,11 @@ type Project struct {
 	Name       string
 	Color      domain.ProjectColor
 	IsArchived bool
+	Position   string
+	Version    int
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
+	DeletedAt  gorm.DeletedAt
 }
 
 func (p *Project) ToDomain() *domain.Project {
@@ -27,6 +32,8 @@ func (p *Project) ToDomain() *domain.Project {
 		Name:       p.Name,
 		Color:      p.Color,
 		IsArchived: p.IsArchived,
+		Position:   p.Position,
+		Version:    p.Version,
 		CreatedAt:  p.CreatedAt,
 		UpdatedAt:  p.UpdatedAt,
 	}
@@ -49,6 +56,8 @@ func (c *Client) CreateProject(ctx context.Context, p *domain.Project) error {
 		Name:       p.Name,
 		Color:      p.Color,
 		IsArchived: p.IsArchived,
+		Position:   p.Position,
+		Version:    p.Version,
 		CreatedAt:  p.CreatedAt,
 		UpdatedAt:  p.UpdatedAt,
 	}).Error; err != nil {
@@ -65,14 +74,55 @@ func (c *Client) CountProjects(ctx context.Context, id domain.UserID) (int, erro
 	return int(count), nil
 }
 
-func (c *Client) ListProjects(ctx context.Context, id domain.UserID, limit, offset int) (domain.Projects, error) {
+// viewerPosition は ListProjects などで閲覧するユーザから見たプロジェクトの位置を表す式である
+// 共有されたプロジェクトの位置はオーナーと混ざらないよう、メンバーごとに project_members に保存している
+const viewerPosition = "COALESCE(project_members.position, projects.position)"
+
+// viewedProjects はユーザがオーナーまたはメンバーであるプロジェクトを取得するクエリである
+func (c *Client) viewedProjects(ctx context.Context, userID domain.UserID) *gorm.DB {
+	return c.db(ctx).Model(Project{}).
+		Joins("LEFT JOIN project_members ON project_members.project_id = projects.id AND project_members.user_id = ?", userID).
+		Where("projects.user_id = ? OR project_members.user_id IS NOT NULL", userID)
+}
+
+// ListProjects はユーザがオーナーまたはメンバーであるプロジェクトをユーザから見た位置の昇順で返す
+// after が nil でない場合は after が指すプロジェクトより後ろのプロジェクトを返す
+func (c *Client) ListProjects(ctx context.Context, id domain.UserID, after *Cursor, limit, offset int) (domain.Projects, error) {
 	var ps Projects
-	if err := c.db(ctx).Where("user_id = ?", id).Limit(limit).Offset(offset).Find(&ps).Error; err != nil {
+	q := c.viewedProjects(ctx, id).Select("projects.id, projects.user_id, projects.name, projects.color, projects.is_archived, " +
+		viewerPosition + " AS position, projects.version, projects.created_at, projects.updated_at")
+	if after != nil {
+		q = whereAfter(q, viewerPosition, after.Key, after.ID, false)
+	}
+	if err := q.Order(viewerPosition).Order("projects.id").Limit(limit).Offset(offset).Find(&ps).Error; err != nil {
 		return nil, errtrace.Wrap(err)
 	}
 	return ps.ToDomain(), nil
 }
 
+// ProjectCursor は ListProjects で p の次から取得するためのカーソルを返す
+func ProjectCursor(p *domain.Project) *Cursor {
+	return &Cursor{Key: p.Position, ID: string(p.ID)}
+}
+
+// ListProjectPositions はユーザがオーナーまたはメンバーであるすべてのプロジェクトのユーザから見た位置を位置の昇順で返す
+func (c *Client) ListProjectPositions(ctx context.Context, id domain.UserID) ([]domain.PositionEntry[domain.ProjectID], error) {
+	var ps Projects
+	if err := c.viewedProjects(ctx, id).
+		Select("projects.id, " + viewerPosition + " AS position").
+		Order(viewerPosition).
+		Order("projects.id").
+		Find(&ps).Error; err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	entries := make([]domain.PositionEntry[domain.ProjectID], 0, len(ps))
+	for _, p := range ps {
+		entries = append(entries, domain.PositionEntry[domain.ProjectID]{ID: p.ID, Position: p.Position})
+	}
+	return entries, nil
+}
+
 func (c *Client) GetProjectByID(ctx context.Context, id domain.ProjectID) (*domain.Project, error) {
 	var p Project
 	if err := c.db(ctx).Where("id = ?", id).Take(&p).Error; err != nil {
@@ -84,20 +134,71 @@ func (c *Client) GetProjectByID(ctx context.Context, id domain.ProjectID) (*doma
 	return p.ToDomain(), nil
 }
 
+// UpdateProject はプロジェクトを更新し、版数を p.Version にする
+// 更新前の版数が p.Version-1 でない場合は ErrVersionConflict を返す
 func (c *Client) UpdateProject(ctx context.Context, p *domain.Project) error {
-	if err := c.db(ctx).Model(Project{}).Where("id = ?", p.ID).Updates(map[string]any{
+	if err := c.updateVersioned(ctx, Project{}, p.ID, p.Version, map[string]any{
 		"name":        p.Name,
 		"color":       p.Color,
 		"is_archived": p.IsArchived,
 		"updated_at":  p.UpdatedAt,
-	}).Error; err != nil {
+	}); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// UpdateProjectPositions はユーザから見たプロジェクトの位置を更新する
+// ユーザがオーナーのプロジェクトは projects の位置を、メンバーのプロジェクトは project_members の位置を更新する
+// 並び替えは内容の更新ではないため、更新日時は変更しない
+func (c *Client) UpdateProjectPositions(ctx context.Context, userID domain.UserID, positions map[domain.ProjectID]string) error {
+	for _, id := range slices.Sorted(maps.Keys(positions)) {
+		columns := map[string]any{
+			"position":   positions[id],
+			"updated_at": gorm.Expr("updated_at"),
+		}
+		result := c.db(ctx).Model(Project{}).Where("id = ? AND user_id = ?", id, userID).UpdateColumns(columns)
+		if result.Error != nil {
+			return errtrace.Wrap(result.Error)
+		}
+		if result.RowsAffected > 0 {
+			continue
+		}
+		if err := c.db(ctx).Model(ProjectMember{}).Where("project_id = ? AND user_id = ?", id, userID).UpdateColumns(columns).Error; err != nil {
+			return errtrace.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// TrashProjectByID はプロジェクトをゴミ箱に入れる
+// プロジェクトのタスクとステップも同じ削除日時でゴミ箱に入れ、RestoreProject でまとめて復元できるようにする
+func (c *Client) TrashProjectByID(ctx context.Context, id domain.ProjectID, deletedAt time.Time) error {
+	// タスクをゴミ箱に入れるとサブクエリで参照できなくなるため、ステップを先にゴミ箱に入れる
+	tasks := c.db(ctx).Model(Task{}).Select("id").Where("project_id = ?", id)
+	if err := c.db(ctx).Model(Step{}).Where("task_id IN (?)", tasks).UpdateColumns(trashColumns(deletedAt)).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	if err := c.db(ctx).Model(Task{}).Where("project_id = ?", id).UpdateColumns(trashColumns(deletedAt)).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	if err := c.db(ctx).Model(Project{}).Where("id = ?", id).UpdateColumns(trashColumns(deletedAt)).Error; err != nil {
 		return errtrace.Wrap(err)
 	}
 	return nil
 }
 
-func (c *Client) DeleteProjectByID(ctx context.Context, id domain.ProjectID) error {
-	if err := c.db(ctx).Where("id = ?", id).Delete(Project{}).Error; err != nil {
+// RestoreProject はゴミ箱に入っているプロジェクトを復元する
+// プロジェクトと一緒にゴミ箱に入ったタスクとステップも復元し、それより前に個別にゴミ箱に入れたものはゴミ箱に残す
+func (c *Client) RestoreProject(ctx context.Context, id domain.ProjectID, deletedAt time.Time) error {
+	tasks := c.db(ctx).Unscoped().Model(Task{}).Select("id").Where("project_id = ?", id)
+	if err := c.db(ctx).Unscoped().Model(Step{}).Where("task_id IN (?) AND deleted_at = ?", tasks, deletedAt).UpdateColumns(restoreColumns()).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	if err := c.db(ctx).Unscoped().Model(Task{}).Where("project_id = ? AND deleted_at = ?", id, deletedAt).UpdateColumns(restoreColumns()).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	if err := c.db(ctx).Unscoped().Model(Project{}).Where("id = ?", id).UpdateColumns(restoreColumns()).Error; err != nil {
 		return errtrace.Wrap(err)
 	}
 	return nil
@@ -8,6 +8,7 @@ import (
 	"github.com/minguu42/harmattan/internal/domain"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 )
 
 func TestClient_CreateProject(t *testing.T) {
@@ -77,30 +78,50 @@ func TestClient_ListProjects(t *testing.T) {
 	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
 		database.Users{
 			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "user02", Email: "user02@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "user03", Email: "user03@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
 		},
 		database.Projects{
-			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", IsArchived: false, CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
-			{ID: "project02", UserID: "user01", Name: "プロジェクト2", Color: "red", IsArchived: true, CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
-			{ID: "project03", UserID: "user01", Name: "プロジェクト3", Color: "green", IsArchived: false, CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", IsArchived: false, Position: "r", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "project02", UserID: "user01", Name: "プロジェクト2", Color: "red", IsArchived: true, Position: "9", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "project03", UserID: "user01", Name: "プロジェクト3", Color: "green", IsArchived: false, Position: "i", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+			{ID: "project04", UserID: "user02", Name: "プロジェクト4", Color: "gray", IsArchived: false, Position: "i", CreatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst)},
+			{ID: "project05", UserID: "user02", Name: "プロジェクト5", Color: "pink", IsArchived: false, Position: "r", CreatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst)},
+		},
+		database.ProjectMembers{
+			{ProjectID: "project04", UserID: "user03", Role: domain.ProjectRoleViewer, Position: "w", CreatedAt: time.Date(2025, 1, 1, 0, 0, 6, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 6, 0, jst)},
+			{ProjectID: "project05", UserID: "user01", Role: domain.ProjectRoleEditor, Position: "m", CreatedAt: time.Date(2025, 1, 1, 0, 0, 7, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 7, 0, jst)},
 		},
 	}))
 
 	tests := []struct {
 		name   string
 		userID domain.UserID
+		after  *database.Cursor
 		limit  int
 		offset int
 		want   domain.Projects
 	}{
 		{
+			// 共有されたプロジェクトはメンバーごとの位置で並ぶ
 			name:   "multiple",
 			userID: "user01",
 			limit:  10,
 			offset: 0,
 			want: domain.Projects{
-				{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", IsArchived: false, CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
-				{ID: "project02", UserID: "user01", Name: "プロジェクト2", Color: "red", IsArchived: true, CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
-				{ID: "project03", UserID: "user01", Name: "プロジェクト3", Color: "green", IsArchived: false, CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+				{ID: "project02", UserID: "user01", Name: "プロジェクト2", Color: "red", IsArchived: true, Position: "9", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+				{ID: "project03", UserID: "user01", Name: "プロジェクト3", Color: "green", IsArchived: false, Position: "i", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+				{ID: "project05", UserID: "user02", Name: "プロジェクト5", Color: "pink", IsArchived: false, Position: "m", CreatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst)},
+				{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", IsArchived: false, Position: "r", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			},
+		},
+		{
+			name:   "member",
+			userID: "user03",
+			limit:  10,
+			offset: 0,
+			want: domain.Projects{
+				{ID: "project04", UserID: "user02", Name: "プロジェクト4", Color: "gray", IsArchived: false, Position: "w", CreatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst)},
 			},
 		},
 		{
@@ -116,14 +137,79 @@ func TestClient_ListProjects(t *testing.T) {
 			limit:  2,
 			offset: 1,
 			want: domain.Projects{
-				{ID: "project02", UserID: "user01", Name: "プロジェクト2", Color: "red", IsArchived: true, CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
-				{ID: "project03", UserID: "user01", Name: "プロジェクト3", Color: "green", IsArchived: false, CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+				{ID: "project03", UserID: "user01", Name: "プロジェクト3", Color: "green", IsArchived: false, Position: "i", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+				{ID: "project05", UserID: "user02", Name: "プロジェクト5", Color: "pink", IsArchived: false, Position: "m", CreatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst)},
+			},
+		},
+		{
+			name:   "cursor",
+			userID: "user01",
+			after:  &database.Cursor{Key: "9", ID: "project02"},
+			limit:  10,
+			want: domain.Projects{
+				{ID: "project03", UserID: "user01", Name: "プロジェクト3", Color: "green", IsArchived: false, Position: "i", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+				{ID: "project05", UserID: "user02", Name: "プロジェクト5", Color: "pink", IsArchived: false, Position: "m", CreatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst)},
+				{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", IsArchived: false, Position: "r", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.ListProjects(t.Context(), tt.userID, tt.after, tt.limit, tt.offset)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_ListProjectPositions(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "user02", Email: "user02@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", Position: "r", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "project02", UserID: "user01", Name: "プロジェクト2", Color: "red", Position: "9", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "project03", UserID: "user01", Name: "プロジェクト3", Color: "green", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+			{ID: "project04", UserID: "user02", Name: "プロジェクト4", Color: "gray", Position: "i", CreatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst)},
+		},
+		database.ProjectMembers{
+			{ProjectID: "project04", UserID: "user01", Role: domain.ProjectRoleViewer, Position: "m", CreatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst)},
+		},
+	}))
+
+	tests := []struct {
+		name   string
+		userID domain.UserID
+		want   []domain.PositionEntry[domain.ProjectID]
+	}{
+		{
+			name:   "multiple",
+			userID: "user01",
+			want: []domain.PositionEntry[domain.ProjectID]{
+				{ID: "project03", Position: ""},
+				{ID: "project02", Position: "9"},
+				{ID: "project04", Position: "m"},
+				{ID: "project01", Position: "r"},
+			},
+		},
+		{
+			name:   "member",
+			userID: "user02",
+			want: []domain.PositionEntry[domain.ProjectID]{
+				{ID: "project04", Position: "i"},
 			},
 		},
+		{
+			name:   "no_match",
+			userID: "user99",
+			want:   []domain.PositionEntry[domain.ProjectID]{},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := c.ListProjects(t.Context(), tt.userID, tt.limit, tt.offset)
+			got, err := c.ListProjectPositions(t.Context(), tt.userID)
 			require.NoError(t, err)
 			assert.Equal(t, tt.want, got)
 		})
@@ -137,6 +223,7 @@ func TestClient_GetProjectByID(t *testing.T) {
 		},
 		database.Projects{
 			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", IsArchived: false, CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "project02", UserID: "user01", Name: "プロジェクト2", Color: "red", IsArchived: false, CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
 		},
 	}))
 
@@ -159,6 +246,11 @@ func TestClient_GetProjectByID(t *testing.T) {
 				UpdatedAt:  time.Date(2025, 1, 1, 0, 0, 1, 0, jst),
 			},
 		},
+		{
+			name:    "trashed",
+			id:      "project02",
+			wantErr: database.ErrNotFound,
+		},
 		{
 			name:    "not_found",
 			id:      "project99",
@@ -185,7 +277,7 @@ func TestClient_UpdateProject(t *testing.T) {
 			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
 		},
 		database.Projects{
-			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", IsArchived: false, CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", IsArchived: false, Version: 1, CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
 		},
 	}))
 
@@ -194,34 +286,132 @@ func TestClient_UpdateProject(t *testing.T) {
 		Name:       "更新後プロジェクト",
 		Color:      "red",
 		IsArchived: true,
+		Version:    2,
 		UpdatedAt:  time.Date(2025, 2, 1, 0, 0, 0, 0, jst),
 	})
 	require.NoError(t, err)
 
+	// 更新前の版数が一致しない場合は、他の更新が先に行われたとみなして更新しない
+	err = c.UpdateProject(t.Context(), &domain.Project{ID: "project01", Name: "競合プロジェクト", Version: 2})
+	assert.ErrorIs(t, err, database.ErrVersionConflict)
+
 	tdb.Assert(t, []any{
 		database.Projects{
-			{ID: "project01", UserID: "user01", Name: "更新後プロジェクト", Color: "red", IsArchived: true, CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 2, 1, 0, 0, 0, 0, jst)},
+			{ID: "project01", UserID: "user01", Name: "更新後プロジェクト", Color: "red", IsArchived: true, Version: 2, CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 2, 1, 0, 0, 0, 0, jst)},
 		},
 	})
 }
 
-func TestClient_DeleteProjectByID(t *testing.T) {
+func TestClient_UpdateProjectPositions(t *testing.T) {
 	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
 		database.Users{
 			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "user02", Email: "user02@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
 		},
 		database.Projects{
-			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", IsArchived: false, CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
-			{ID: "project02", UserID: "user01", Name: "プロジェクト2", Color: "red", IsArchived: false, CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", Position: "i", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "project02", UserID: "user01", Name: "プロジェクト2", Color: "red", Position: "r", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "project03", UserID: "user01", Name: "プロジェクト3", Color: "green", Position: "u", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+			{ID: "project04", UserID: "user02", Name: "プロジェクト4", Color: "gray", Position: "i", CreatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst)},
+		},
+		database.ProjectMembers{
+			{ProjectID: "project04", UserID: "user01", Role: domain.ProjectRoleEditor, Position: "m", CreatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst)},
 		},
 	}))
 
-	err := c.DeleteProjectByID(t.Context(), "project01")
+	// メンバーであるプロジェクトはオーナーの位置を変えず、メンバーの位置のみを更新する
+	err := c.UpdateProjectPositions(t.Context(), "user01", map[domain.ProjectID]string{"project01": "w", "project02": "9", "project04": "c"})
 	require.NoError(t, err)
 
 	tdb.Assert(t, []any{
 		database.Projects{
-			{ID: "project02", UserID: "user01", Name: "プロジェクト2", Color: "red", IsArchived: false, CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", Position: "w", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "project02", UserID: "user01", Name: "プロジェクト2", Color: "red", Position: "9", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "project03", UserID: "user01", Name: "プロジェクト3", Color: "green", Position: "u", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+			{ID: "project04", UserID: "user02", Name: "プロジェクト4", Color: "gray", Position: "i", CreatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst)},
+		},
+		database.ProjectMembers{
+			{ProjectID: "project04", UserID: "user01", Role: domain.ProjectRoleEditor, Position: "c", CreatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst)},
+		},
+	})
+}
+
+func TestClient_TrashProjectByID(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "project02", UserID: "user01", Name: "プロジェクト2", Color: "red", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "task02", UserID: "user01", ProjectID: "project01", Name: "タスク2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 1, 15, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "task03", UserID: "user01", ProjectID: "project02", Name: "タスク3", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+		database.Steps{
+			{ID: "step01", UserID: "user01", TaskID: "task01", Name: "ステップ1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "step02", UserID: "user01", TaskID: "task03", Name: "ステップ2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+	}))
+
+	err := c.TrashProjectByID(t.Context(), "project01", time.Date(2025, 2, 1, 0, 0, 0, 0, jst))
+	require.NoError(t, err)
+
+	tdb.Assert(t, []any{
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "project02", UserID: "user01", Name: "プロジェクト2", Color: "red", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "task02", UserID: "user01", ProjectID: "project01", Name: "タスク2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 1, 15, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "task03", UserID: "user01", ProjectID: "project02", Name: "タスク3", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+		database.Steps{
+			{ID: "step01", UserID: "user01", TaskID: "task01", Name: "ステップ1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "step02", UserID: "user01", TaskID: "task03", Name: "ステップ2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+	})
+}
+
+func TestClient_RestoreProject(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "project02", UserID: "user01", Name: "プロジェクト2", Color: "red", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+		},
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "task02", UserID: "user01", ProjectID: "project01", Name: "タスク2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 1, 15, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "task03", UserID: "user01", ProjectID: "project02", Name: "タスク3", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+		},
+		database.Steps{
+			{ID: "step01", UserID: "user01", TaskID: "task01", Name: "ステップ1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "step02", UserID: "user01", TaskID: "task03", Name: "ステップ2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+		},
+	}))
+
+	err := c.RestoreProject(t.Context(), "project01", time.Date(2025, 2, 1, 0, 0, 0, 0, jst))
+	require.NoError(t, err)
+
+	tdb.Assert(t, []any{
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "project02", UserID: "user01", Name: "プロジェクト2", Color: "red", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+		},
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "task02", UserID: "user01", ProjectID: "project01", Name: "タスク2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 1, 15, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "task03", UserID: "user01", ProjectID: "project02", Name: "タスク3", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+		},
+		database.Steps{
+			{ID: "step01", UserID: "user01", TaskID: "task01", Name: "ステップ1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "step02", UserID: "user01", TaskID: "task03", Name: "ステップ2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
 		},
 	})
 }
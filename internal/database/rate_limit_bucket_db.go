@@ -0,0 +1,73 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+	"gorm.io/gorm/clause"
+)
+
+type RateLimitBucket struct {
+	Key        string
+	Tokens     float64
+	RefilledAt time.Time
+	// ExpiresAt はバケットが満杯になる日時であり、この日時を過ぎた行は削除しても制限の結果は変わらない
+	ExpiresAt time.Time
+}
+
+func (b *RateLimitBucket) ToDomain() *domain.RateLimitBucket {
+	return &domain.RateLimitBucket{
+		Key:        b.Key,
+		Tokens:     b.Tokens,
+		RefilledAt: b.RefilledAt,
+	}
+}
+
+type RateLimitBuckets []RateLimitBucket
+
+// TakeRateLimitToken は key のバケットから now の時点でトークンを1つ取り出し、取り出せたかと取り出した後のバケットを返す
+// 複数のAPIサーバで同時に取り出してもトークンの数を取りこぼさないように、行をロックしてから更新する
+func (c *Client) TakeRateLimitToken(ctx context.Context, key string, limit domain.RateLimit, now time.Time) (_ *domain.RateLimitBucket, _ bool, err error) {
+	ctx, commitOrRollback, err := c.Begin(ctx)
+	if err != nil {
+		return nil, false, errtrace.Wrap(err)
+	}
+	defer commitOrRollback(&err)
+
+	// 存在しない行はロックできないため、先に満杯のバケットを挿入しておく
+	if err := c.db(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&RateLimitBucket{
+		Key:        key,
+		Tokens:     float64(limit.Requests),
+		RefilledAt: now,
+		ExpiresAt:  now,
+	}).Error; err != nil {
+		return nil, false, errtrace.Wrap(err)
+	}
+
+	var b RateLimitBucket
+	if err := c.db(ctx).Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+		Where("`key` = ?", key).Take(&b).Error; err != nil {
+		return nil, false, errtrace.Wrap(err)
+	}
+	bucket := b.ToDomain()
+	allowed := bucket.Take(limit, now)
+
+	if err := c.db(ctx).Model(RateLimitBucket{}).Where("`key` = ?", key).Updates(map[string]any{
+		"tokens":      bucket.Tokens,
+		"refilled_at": bucket.RefilledAt,
+		"expires_at":  bucket.FullAt(limit),
+	}).Error; err != nil {
+		return nil, false, errtrace.Wrap(err)
+	}
+	return bucket, allowed, nil
+}
+
+// DeleteExpiredRateLimitBuckets は now の時点で満杯になっているバケットを削除する
+func (c *Client) DeleteExpiredRateLimitBuckets(ctx context.Context, now time.Time) error {
+	if err := c.db(ctx).Where("expires_at <= ?", now).Delete(RateLimitBucket{}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/database"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_TakeRateLimitToken(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.RateLimitBuckets{
+			{Key: "ListTasks:user:user01", Tokens: 0.5, RefilledAt: time.Date(2025, 1, 1, 0, 9, 59, 0, jst), ExpiresAt: time.Date(2025, 1, 1, 0, 10, 8, 500000000, jst)},
+			{Key: "ListTasks:user:user02", Tokens: 0.5, RefilledAt: time.Date(2025, 1, 1, 0, 10, 0, 0, jst), ExpiresAt: time.Date(2025, 1, 1, 0, 10, 9, 500000000, jst)},
+		},
+	}))
+
+	limit := domain.RateLimit{Requests: 10, Period: 10 * time.Second}
+	now := time.Date(2025, 1, 1, 0, 10, 0, 0, jst)
+	tests := []struct {
+		name        string
+		key         string
+		want        *domain.RateLimitBucket
+		wantAllowed bool
+	}{
+		{
+			name:        "new_bucket",
+			key:         "ListTasks:ip:192.0.2.1",
+			want:        &domain.RateLimitBucket{Key: "ListTasks:ip:192.0.2.1", Tokens: 9, RefilledAt: now},
+			wantAllowed: true,
+		},
+		{
+			name:        "refilled",
+			key:         "ListTasks:user:user01",
+			want:        &domain.RateLimitBucket{Key: "ListTasks:user:user01", Tokens: 0.5, RefilledAt: now},
+			wantAllowed: true,
+		},
+		{
+			name:        "empty",
+			key:         "ListTasks:user:user02",
+			want:        &domain.RateLimitBucket{Key: "ListTasks:user:user02", Tokens: 0.5, RefilledAt: now},
+			wantAllowed: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, allowed, err := c.TakeRateLimitToken(t.Context(), tt.key, limit, now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantAllowed, allowed)
+		})
+	}
+
+	tdb.Assert(t, []any{
+		database.RateLimitBuckets{
+			{Key: "ListTasks:ip:192.0.2.1", Tokens: 9, RefilledAt: now, ExpiresAt: time.Date(2025, 1, 1, 0, 10, 1, 0, jst)},
+			{Key: "ListTasks:user:user01", Tokens: 0.5, RefilledAt: now, ExpiresAt: time.Date(2025, 1, 1, 0, 10, 9, 500000000, jst)},
+			{Key: "ListTasks:user:user02", Tokens: 0.5, RefilledAt: now, ExpiresAt: time.Date(2025, 1, 1, 0, 10, 9, 500000000, jst)},
+		},
+	})
+}
+
+func TestClient_DeleteExpiredRateLimitBuckets(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.RateLimitBuckets{
+			{Key: "ListTasks:user:user01", Tokens: 10, RefilledAt: time.Date(2025, 1, 1, 0, 9, 0, 0, jst), ExpiresAt: time.Date(2025, 1, 1, 0, 10, 0, 0, jst)},
+			{Key: "ListTasks:user:user02", Tokens: 0, RefilledAt: time.Date(2025, 1, 1, 0, 9, 55, 0, jst), ExpiresAt: time.Date(2025, 1, 1, 0, 10, 5, 0, jst)},
+		},
+	}))
+
+	err := c.DeleteExpiredRateLimitBuckets(t.Context(), time.Date(2025, 1, 1, 0, 10, 0, 0, jst))
+	require.NoError(t, err)
+
+	tdb.Assert(t, []any{
+		database.RateLimitBuckets{
+			{Key: "ListTasks:user:user02", Tokens: 0, RefilledAt: time.Date(2025, 1, 1, 0, 9, 55, 0, jst), ExpiresAt: time.Date(2025, 1, 1, 0, 10, 5, 0, jst)},
+		},
+	})
+}
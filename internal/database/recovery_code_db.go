@@ -0,0 +1,52 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+type RecoveryCode struct {
+	UserID    domain.UserID
+	CodeHash  string
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+type RecoveryCodes []RecoveryCode
+
+// ReplaceRecoveryCodes はユーザのリカバリーコードをすべて削除し、codes に置き換える
+func (c *Client) ReplaceRecoveryCodes(ctx context.Context, userID domain.UserID, codes []domain.RecoveryCode) error {
+	if err := c.db(ctx).Where("user_id = ?", userID).Delete(RecoveryCode{}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	if len(codes) == 0 {
+		return nil
+	}
+
+	rows := make(RecoveryCodes, 0, len(codes))
+	for _, code := range codes {
+		rows = append(rows, RecoveryCode{
+			UserID:    code.UserID,
+			CodeHash:  code.CodeHash,
+			CreatedAt: code.CreatedAt,
+		})
+	}
+	if err := c.db(ctx).Create(&rows).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// MarkRecoveryCodeUsed はユーザの未使用のリカバリーコードのうちハッシュ値が codeHash のものを使用済みにし、使用済みにできたかを返す
+func (c *Client) MarkRecoveryCodeUsed(ctx context.Context, userID domain.UserID, codeHash string, usedAt time.Time) (bool, error) {
+	result := c.db(ctx).Model(RecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		Update("used_at", usedAt)
+	if result.Error != nil {
+		return false, errtrace.Wrap(result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
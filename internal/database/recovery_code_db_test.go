@@ -0,0 +1,72 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/database"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ReplaceRecoveryCodes(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "user02", Email: "user02@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.RecoveryCodes{
+			{UserID: "user01", CodeHash: "hash01", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{UserID: "user01", CodeHash: "hash02", UsedAt: new(time.Date(2025, 1, 1, 0, 5, 0, 0, jst)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{UserID: "user02", CodeHash: "hash03", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+	}))
+
+	now := time.Date(2025, 1, 1, 0, 10, 0, 0, jst)
+	require.NoError(t, c.ReplaceRecoveryCodes(t.Context(), "user01", []domain.RecoveryCode{
+		{UserID: "user01", CodeHash: "hash11", CreatedAt: now},
+		{UserID: "user01", CodeHash: "hash12", CreatedAt: now},
+	}))
+
+	tdb.Assert(t, []any{
+		database.RecoveryCodes{
+			{UserID: "user01", CodeHash: "hash11", CreatedAt: now},
+			{UserID: "user01", CodeHash: "hash12", CreatedAt: now},
+			{UserID: "user02", CodeHash: "hash03", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+	})
+}
+
+func TestClient_MarkRecoveryCodeUsed(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "user02", Email: "user02@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.RecoveryCodes{
+			{UserID: "user01", CodeHash: "hash01", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{UserID: "user01", CodeHash: "hash02", UsedAt: new(time.Date(2025, 1, 1, 0, 5, 0, 0, jst)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{UserID: "user02", CodeHash: "hash03", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+	}))
+
+	tests := []struct {
+		name     string
+		userID   domain.UserID
+		codeHash string
+		want     bool
+	}{
+		{name: "unused", userID: "user01", codeHash: "hash01", want: true},
+		{name: "used_twice", userID: "user01", codeHash: "hash01", want: false},
+		{name: "already_used", userID: "user01", codeHash: "hash02", want: false},
+		{name: "other_user", userID: "user01", codeHash: "hash03", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.MarkRecoveryCodeUsed(t.Context(), tt.userID, tt.codeHash, time.Date(2025, 1, 1, 0, 10, 0, 0, jst))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
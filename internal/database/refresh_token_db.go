@@ -0,0 +1,97 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+	"gorm.io/gorm"
+)
+
+type RefreshToken struct {
+	ID              domain.RefreshTokenID
+	FamilyID        domain.RefreshTokenID
+	UserID          domain.UserID
+	TokenHash       string
+	ExpiresAt       time.Time
+	UsedAt          *time.Time
+	RevokedAt       *time.Time
+	AuthenticatedAt *time.Time
+	CreatedAt       time.Time
+}
+
+func (t *RefreshToken) ToDomain() *domain.RefreshToken {
+	return &domain.RefreshToken{
+		ID:              t.ID,
+		FamilyID:        t.FamilyID,
+		UserID:          t.UserID,
+		TokenHash:       t.TokenHash,
+		ExpiresAt:       t.ExpiresAt,
+		UsedAt:          t.UsedAt,
+		RevokedAt:       t.RevokedAt,
+		AuthenticatedAt: t.AuthenticatedAt,
+		CreatedAt:       t.CreatedAt,
+	}
+}
+
+type RefreshTokens []RefreshToken
+
+func (c *Client) CreateRefreshToken(ctx context.Context, t *domain.RefreshToken) error {
+	if err := c.db(ctx).Create(&RefreshToken{
+		ID:              t.ID,
+		FamilyID:        t.FamilyID,
+		UserID:          t.UserID,
+		TokenHash:       t.TokenHash,
+		ExpiresAt:       t.ExpiresAt,
+		AuthenticatedAt: t.AuthenticatedAt,
+		CreatedAt:       t.CreatedAt,
+	}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func (c *Client) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	var t RefreshToken
+	if err := c.db(ctx).Where("token_hash = ?", tokenHash).Take(&t).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errtrace.Wrap(ErrNotFound)
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	return t.ToDomain(), nil
+}
+
+// MarkRefreshTokenUsed は未使用かつ失効していないトークンを使用済みにし、使用済みにできたかを返す
+// 同じトークンで同時にリクエストされた場合も、使用済みにできるのはいずれか1つのリクエストのみである
+func (c *Client) MarkRefreshTokenUsed(ctx context.Context, id domain.RefreshTokenID, usedAt time.Time) (bool, error) {
+	result := c.db(ctx).Model(RefreshToken{}).
+		Where("id = ? AND used_at IS NULL AND revoked_at IS NULL", id).
+		Update("used_at", usedAt)
+	if result.Error != nil {
+		return false, errtrace.Wrap(result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// RevokeRefreshTokenFamily はファミリーのトークンのうち失効していないものをすべて失効させる
+func (c *Client) RevokeRefreshTokenFamily(ctx context.Context, familyID domain.RefreshTokenID, revokedAt time.Time) error {
+	if err := c.db(ctx).Model(RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", revokedAt).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// RevokeUserRefreshTokens はユーザのトークンのうち失効していないものをすべて失効させる
+func (c *Client) RevokeUserRefreshTokens(ctx context.Context, userID domain.UserID, revokedAt time.Time) error {
+	if err := c.db(ctx).Model(RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", revokedAt).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/database"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_MarkRefreshTokenUsed(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.RefreshTokens{
+			{ID: "token01", FamilyID: "token01", UserID: "user01", TokenHash: "hash01", ExpiresAt: time.Date(2025, 1, 31, 0, 0, 1, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "token02", FamilyID: "token02", UserID: "user01", TokenHash: "hash02", ExpiresAt: time.Date(2025, 1, 31, 0, 0, 2, 0, jst), UsedAt: new(time.Date(2025, 1, 1, 0, 5, 0, 0, jst)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "token03", FamilyID: "token03", UserID: "user01", TokenHash: "hash03", ExpiresAt: time.Date(2025, 1, 31, 0, 0, 3, 0, jst), RevokedAt: new(time.Date(2025, 1, 1, 0, 5, 0, 0, jst)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+	}))
+
+	tests := []struct {
+		name string
+		id   domain.RefreshTokenID
+		want bool
+	}{
+		{name: "unused", id: "token01", want: true},
+		{name: "used_twice", id: "token01", want: false},
+		{name: "already_used", id: "token02", want: false},
+		{name: "revoked", id: "token03", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.MarkRefreshTokenUsed(t.Context(), tt.id, time.Date(2025, 1, 1, 0, 10, 0, 0, jst))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_RevokeRefreshTokenFamily(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.RefreshTokens{
+			{ID: "token01", FamilyID: "token01", UserID: "user01", TokenHash: "hash01", ExpiresAt: time.Date(2025, 1, 31, 0, 0, 1, 0, jst), UsedAt: new(time.Date(2025, 1, 1, 0, 5, 0, 0, jst)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "token02", FamilyID: "token01", UserID: "user01", TokenHash: "hash02", ExpiresAt: time.Date(2025, 1, 31, 0, 5, 0, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 5, 0, 0, jst)},
+			{ID: "token03", FamilyID: "token03", UserID: "user01", TokenHash: "hash03", ExpiresAt: time.Date(2025, 1, 31, 0, 0, 3, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+	}))
+
+	revokedAt := time.Date(2025, 1, 1, 0, 10, 0, 0, jst)
+	require.NoError(t, c.RevokeRefreshTokenFamily(t.Context(), "token01", revokedAt))
+
+	tdb.Assert(t, []any{
+		database.RefreshTokens{
+			{ID: "token01", FamilyID: "token01", UserID: "user01", TokenHash: "hash01", ExpiresAt: time.Date(2025, 1, 31, 0, 0, 1, 0, jst), UsedAt: new(time.Date(2025, 1, 1, 0, 5, 0, 0, jst)), RevokedAt: &revokedAt, CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "token02", FamilyID: "token01", UserID: "user01", TokenHash: "hash02", ExpiresAt: time.Date(2025, 1, 31, 0, 5, 0, 0, jst), RevokedAt: &revokedAt, CreatedAt: time.Date(2025, 1, 1, 0, 5, 0, 0, jst)},
+			{ID: "token03", FamilyID: "token03", UserID: "user01", TokenHash: "hash03", ExpiresAt: time.Date(2025, 1, 31, 0, 0, 3, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+	})
+}
+
+func TestClient_RevokeUserRefreshTokens(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "user02", Email: "user02@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.RefreshTokens{
+			{ID: "token01", FamilyID: "token01", UserID: "user01", TokenHash: "hash01", ExpiresAt: time.Date(2025, 1, 31, 0, 0, 1, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "token02", FamilyID: "token02", UserID: "user01", TokenHash: "hash02", ExpiresAt: time.Date(2025, 1, 31, 0, 0, 2, 0, jst), RevokedAt: new(time.Date(2025, 1, 1, 0, 5, 0, 0, jst)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "token03", FamilyID: "token03", UserID: "user02", TokenHash: "hash03", ExpiresAt: time.Date(2025, 1, 31, 0, 0, 3, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+	}))
+
+	revokedAt := time.Date(2025, 1, 1, 0, 10, 0, 0, jst)
+	require.NoError(t, c.RevokeUserRefreshTokens(t.Context(), "user01", revokedAt))
+
+	tdb.Assert(t, []any{
+		database.RefreshTokens{
+			{ID: "token01", FamilyID: "token01", UserID: "user01", TokenHash: "hash01", ExpiresAt: time.Date(2025, 1, 31, 0, 0, 1, 0, jst), RevokedAt: &revokedAt, CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "token02", FamilyID: "token02", UserID: "user01", TokenHash: "hash02", ExpiresAt: time.Date(2025, 1, 31, 0, 0, 2, 0, jst), RevokedAt: new(time.Date(2025, 1, 1, 0, 5, 0, 0, jst)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "token03", FamilyID: "token03", UserID: "user02", TokenHash: "hash03", ExpiresAt: time.Date(2025, 1, 31, 0, 0, 3, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+	})
+}
@@ -0,0 +1,133 @@
+package database
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+// ngramTokenSize はFULLTEXTインデックスのngramパーサが分割するトークンの文字数であり、MySQLの ngram_token_size の設定値と一致させる
+const ngramTokenSize = 2
+
+type SearchResult struct {
+	Type      domain.SearchResultType
+	ID        string
+	ProjectID domain.ProjectID
+	TaskID    domain.TaskID
+	Name      string
+	Content   string
+	Score     float64
+}
+
+func (r *SearchResult) ToDomain() *domain.SearchResult {
+	return &domain.SearchResult{
+		Type:      r.Type,
+		ID:        r.ID,
+		ProjectID: r.ProjectID,
+		TaskID:    r.TaskID,
+		Name:      r.Name,
+		Content:   r.Content,
+	}
+}
+
+type SearchResults []SearchResult
+
+func (rs SearchResults) ToDomain() domain.SearchResults {
+	results := make(domain.SearchResults, 0, len(rs))
+	for _, r := range rs {
+		results = append(results, *r.ToDomain())
+	}
+	return results
+}
+
+// SearchOptions は全文検索の絞り込み条件を表す
+// ProjectID を指定した場合はプロジェクトとそのタスク・ステップに、TagIDs を指定した場合はタグが付いたタスクとそのステップに絞り込み、いずれの場合もタグは検索しない
+type SearchOptions struct {
+	ShowCompleted bool
+	ProjectID     *domain.ProjectID
+	// MatchAllTags が true の場合はすべてのタグが付いたタスクに絞り込む
+	TagIDs       []domain.TagID
+	MatchAllTags bool
+}
+
+// booleanQuery は検索語 terms をすべて含む行に一致する BOOLEAN MODE の検索式を返す
+// 検索式の演算子として解釈される文字は取り除き、ngramのトークンより短い検索語はその文字で始まるトークンに一致させる
+func booleanQuery(terms []string) string {
+	parts := make([]string, 0, len(terms))
+	for _, t := range terms {
+		t = strings.Map(func(r rune) rune {
+			if strings.ContainsRune(`+-<>()~*"@`, r) {
+				return -1
+			}
+			return r
+		}, t)
+		switch {
+		case t == "":
+		case utf8.RuneCountInString(t) < ngramTokenSize:
+			parts = append(parts, "+"+t+"*")
+		default:
+			parts = append(parts, `+"`+t+`"`)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// Search はユーザがオーナーまたはメンバーであるプロジェクトとそのタスク・ステップ、ユーザのタグのうち検索語 terms をすべて含むものを関連度の降順で返す
+func (c *Client) Search(ctx context.Context, userID domain.UserID, terms []string, opts *SearchOptions, limit, offset int) (domain.SearchResults, error) {
+	query := booleanQuery(terms)
+	if query == "" {
+		return domain.SearchResults{}, nil
+	}
+	taskScoped := opts.ProjectID != nil || len(opts.TagIDs) > 0
+
+	tasks := c.db(ctx).Model(Task{}).
+		Select("'task' AS type, id, project_id, '' AS task_id, name, content, MATCH (name, content) AGAINST (? IN BOOLEAN MODE) AS score", query).
+		Where("project_id IN (?)", c.accessibleProjectIDs(ctx, userID)).
+		Where("MATCH (name, content) AGAINST (? IN BOOLEAN MODE)", query)
+	steps := c.db(ctx).Model(Step{}).
+		Select("'step' AS type, steps.id, tasks.project_id, steps.task_id, steps.name, '' AS content, MATCH (steps.name) AGAINST (? IN BOOLEAN MODE) AS score", query).
+		Joins("JOIN tasks ON tasks.id = steps.task_id AND tasks.deleted_at IS NULL").
+		Where("tasks.project_id IN (?)", c.accessibleProjectIDs(ctx, userID)).
+		Where("MATCH (steps.name) AGAINST (? IN BOOLEAN MODE)", query)
+	if !opts.ShowCompleted {
+		tasks = tasks.Where("completed_at IS NULL")
+		steps = steps.Where("steps.completed_at IS NULL AND tasks.completed_at IS NULL")
+	}
+	if opts.ProjectID != nil {
+		tasks = tasks.Where("project_id = ?", *opts.ProjectID)
+		steps = steps.Where("tasks.project_id = ?", *opts.ProjectID)
+	}
+	if len(opts.TagIDs) > 0 {
+		tasks = tasks.Where("id IN (?)", c.taggedTaskIDs(ctx, opts.TagIDs, opts.MatchAllTags))
+		steps = steps.Where("steps.task_id IN (?)", c.taggedTaskIDs(ctx, opts.TagIDs, opts.MatchAllTags))
+	}
+	subqueries := []any{tasks, steps}
+
+	if len(opts.TagIDs) == 0 {
+		projects := c.db(ctx).Model(Project{}).
+			Select("'project' AS type, id, id AS project_id, '' AS task_id, name, '' AS content, MATCH (name) AGAINST (? IN BOOLEAN MODE) AS score", query).
+			Where("id IN (?)", c.accessibleProjectIDs(ctx, userID)).
+			Where("MATCH (name) AGAINST (? IN BOOLEAN MODE)", query)
+		if opts.ProjectID != nil {
+			projects = projects.Where("id = ?", *opts.ProjectID)
+		}
+		subqueries = append(subqueries, projects)
+	}
+	if !taskScoped {
+		tags := c.db(ctx).Model(Tag{}).
+			Select("'tag' AS type, id, '' AS project_id, '' AS task_id, name, '' AS content, MATCH (name) AGAINST (? IN BOOLEAN MODE) AS score", query).
+			Where("user_id = ?", userID).
+			Where("MATCH (name) AGAINST (? IN BOOLEAN MODE)", query)
+		subqueries = append(subqueries, tags)
+	}
+
+	var rs SearchResults
+	union := "(" + strings.Repeat("? UNION ALL ", len(subqueries)-1) + "?) AS results"
+	if err := c.db(ctx).Table(union, subqueries...).Order("score DESC").Order("id").Limit(limit).Offset(offset).Find(&rs).Error; err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return rs.ToDomain(), nil
+}
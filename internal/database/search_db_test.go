@@ -0,0 +1,139 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/database"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestClient_Search(t *testing.T) {
+	completedAt := time.Date(2025, 1, 10, 0, 0, 0, 0, jst)
+
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "user02", Email: "user02@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "資料プロジェクト", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "project02", UserID: "user01", Name: "買い物", Color: "red", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "project03", UserID: "user02", Name: "会議資料", Color: "gray", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "週次会議", Content: "会議の議事録を書く", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "task02", UserID: "user01", ProjectID: "project01", Name: "会議室の予約", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "task03", UserID: "user01", ProjectID: "project02", Name: "牛乳を買う", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+			{ID: "task04", UserID: "user01", ProjectID: "project02", Name: "資料の印刷", CompletedAt: &completedAt, CreatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst)},
+			{ID: "task05", UserID: "user01", ProjectID: "project01", Name: "資料の整理", CreatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 1, 15, 0, 0, 0, 0, jst), Valid: true}},
+		},
+		database.Steps{
+			{ID: "step01", UserID: "user01", TaskID: "task03", Name: "資料を集める", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "step02", UserID: "user01", TaskID: "task03", Name: "牛乳", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "step03", UserID: "user01", TaskID: "task04", Name: "資料の確認", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+		database.Tags{
+			{ID: "tag01", UserID: "user01", Name: "資料", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "tag02", UserID: "user01", Name: "買い物", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.TaskTags{
+			{TaskID: "task03", TagID: "tag02", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+		database.ProjectMembers{},
+	}))
+
+	var (
+		project01 = domain.SearchResult{Type: domain.SearchResultTypeProject, ID: "project01", ProjectID: "project01", Name: "資料プロジェクト"}
+		task01    = domain.SearchResult{Type: domain.SearchResultTypeTask, ID: "task01", ProjectID: "project01", Name: "週次会議", Content: "会議の議事録を書く"}
+		task02    = domain.SearchResult{Type: domain.SearchResultTypeTask, ID: "task02", ProjectID: "project01", Name: "会議室の予約"}
+		task04    = domain.SearchResult{Type: domain.SearchResultTypeTask, ID: "task04", ProjectID: "project02", Name: "資料の印刷"}
+		step01    = domain.SearchResult{Type: domain.SearchResultTypeStep, ID: "step01", ProjectID: "project02", TaskID: "task03", Name: "資料を集める"}
+		step03    = domain.SearchResult{Type: domain.SearchResultTypeStep, ID: "step03", ProjectID: "project02", TaskID: "task04", Name: "資料の確認"}
+		tag01     = domain.SearchResult{Type: domain.SearchResultTypeTag, ID: "tag01", Name: "資料"}
+	)
+	tests := []struct {
+		name   string
+		terms  []string
+		opts   *database.SearchOptions
+		limit  int
+		offset int
+		want   domain.SearchResults
+		// ordered が true の場合は並び順も検証する
+		ordered bool
+	}{
+		{
+			name:    "ranked_by_relevance",
+			terms:   []string{"会議"},
+			opts:    &database.SearchOptions{},
+			limit:   10,
+			want:    domain.SearchResults{task01, task02},
+			ordered: true,
+		},
+		{
+			name:    "limit_offset",
+			terms:   []string{"会議"},
+			opts:    &database.SearchOptions{},
+			limit:   1,
+			offset:  1,
+			want:    domain.SearchResults{task02},
+			ordered: true,
+		},
+		{
+			name:  "all_types",
+			terms: []string{"資料"},
+			opts:  &database.SearchOptions{},
+			limit: 10,
+			want:  domain.SearchResults{project01, step01, tag01},
+		},
+		{
+			name:  "show_completed",
+			terms: []string{"資料"},
+			opts:  &database.SearchOptions{ShowCompleted: true},
+			limit: 10,
+			want:  domain.SearchResults{project01, task04, step01, step03, tag01},
+		},
+		{
+			name:  "project",
+			terms: []string{"資料"},
+			opts:  &database.SearchOptions{ShowCompleted: true, ProjectID: new(domain.ProjectID("project02"))},
+			limit: 10,
+			want:  domain.SearchResults{task04, step01, step03},
+		},
+		{
+			name:  "tags",
+			terms: []string{"資料"},
+			opts:  &database.SearchOptions{TagIDs: []domain.TagID{"tag02"}},
+			limit: 10,
+			want:  domain.SearchResults{step01},
+		},
+		{
+			name:  "all_terms",
+			terms: []string{"資料", "集める"},
+			opts:  &database.SearchOptions{},
+			limit: 10,
+			want:  domain.SearchResults{step01},
+		},
+		{
+			name:  "only_operators",
+			terms: []string{`"*"`},
+			opts:  &database.SearchOptions{},
+			limit: 10,
+			want:  domain.SearchResults{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.Search(t.Context(), "user01", tt.terms, tt.opts, tt.limit, tt.offset)
+			require.NoError(t, err)
+			if tt.ordered {
+				assert.Equal(t, tt.want, got)
+			} else {
+				assert.ElementsMatch(t, tt.want, got)
+			}
+		})
+	}
+}
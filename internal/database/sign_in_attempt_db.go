@@ -0,0 +1,67 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SignInAttempt struct {
+	Subject      string
+	Failures     int
+	LastFailedAt time.Time
+}
+
+func (a *SignInAttempt) ToDomain() *domain.SignInAttempt {
+	return &domain.SignInAttempt{
+		Subject:      a.Subject,
+		Failures:     a.Failures,
+		LastFailedAt: a.LastFailedAt,
+	}
+}
+
+type SignInAttempts []SignInAttempt
+
+// GetSignInAttempt は主体の失敗の記録を返し、記録がない場合は失敗回数が0の記録を返す
+func (c *Client) GetSignInAttempt(ctx context.Context, subject string) (*domain.SignInAttempt, error) {
+	var a SignInAttempt
+	if err := c.db(ctx).Where("subject = ?", subject).Take(&a).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &domain.SignInAttempt{Subject: subject}, nil
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	return a.ToDomain(), nil
+}
+
+// RecordSignInFailure は主体の失敗回数を1増やし、更新後の記録を返す
+// 最後の失敗から resetAfter 以上経過している場合は、失敗回数を1からやり直す
+// 複数のAPIサーバで同時に失敗した場合も回数を取りこぼさないように、1つのクエリで挿入か更新を行う
+func (c *Client) RecordSignInFailure(ctx context.Context, subject string, failedAt time.Time, resetAfter time.Duration) (*domain.SignInAttempt, error) {
+	if err := c.db(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.Set{
+			{Column: clause.Column{Name: "failures"}, Value: gorm.Expr("if(last_failed_at <= ?, 1, failures + 1)", failedAt.Add(-resetAfter))},
+			{Column: clause.Column{Name: "last_failed_at"}, Value: failedAt},
+		},
+	}).Create(&SignInAttempt{Subject: subject, Failures: 1, LastFailedAt: failedAt}).Error; err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	a, err := c.GetSignInAttempt(ctx, subject)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return a, nil
+}
+
+func (c *Client) DeleteSignInAttempt(ctx context.Context, subject string) error {
+	if err := c.db(ctx).Where("subject = ?", subject).Delete(SignInAttempt{}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/database"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetSignInAttempt(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.SignInAttempts{
+			{Subject: "email:user01@dummy.invalid", Failures: 3, LastFailedAt: time.Date(2025, 1, 1, 0, 5, 0, 0, jst)},
+		},
+	}))
+
+	tests := []struct {
+		name    string
+		subject string
+		want    *domain.SignInAttempt
+	}{
+		{
+			name:    "found",
+			subject: "email:user01@dummy.invalid",
+			want:    &domain.SignInAttempt{Subject: "email:user01@dummy.invalid", Failures: 3, LastFailedAt: time.Date(2025, 1, 1, 0, 5, 0, 0, jst)},
+		},
+		{
+			name:    "not_found",
+			subject: "email:user02@dummy.invalid",
+			want:    &domain.SignInAttempt{Subject: "email:user02@dummy.invalid"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.GetSignInAttempt(t.Context(), tt.subject)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_RecordSignInFailure(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.SignInAttempts{
+			{Subject: "email:user01@dummy.invalid", Failures: 3, LastFailedAt: time.Date(2025, 1, 1, 0, 5, 0, 0, jst)},
+			{Subject: "email:user02@dummy.invalid", Failures: 3, LastFailedAt: time.Date(2024, 12, 31, 23, 10, 0, 0, jst)},
+		},
+	}))
+
+	failedAt := time.Date(2025, 1, 1, 0, 10, 0, 0, jst)
+	tests := []struct {
+		name    string
+		subject string
+		want    *domain.SignInAttempt
+	}{
+		{
+			name:    "first_failure",
+			subject: "ip:192.0.2.1",
+			want:    &domain.SignInAttempt{Subject: "ip:192.0.2.1", Failures: 1, LastFailedAt: failedAt},
+		},
+		{
+			name:    "consecutive_failure",
+			subject: "email:user01@dummy.invalid",
+			want:    &domain.SignInAttempt{Subject: "email:user01@dummy.invalid", Failures: 4, LastFailedAt: failedAt},
+		},
+		{
+			name:    "after_reset",
+			subject: "email:user02@dummy.invalid",
+			want:    &domain.SignInAttempt{Subject: "email:user02@dummy.invalid", Failures: 1, LastFailedAt: failedAt},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.RecordSignInFailure(t.Context(), tt.subject, failedAt, 1*time.Hour)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_DeleteSignInAttempt(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.SignInAttempts{
+			{Subject: "email:user01@dummy.invalid", Failures: 3, LastFailedAt: time.Date(2025, 1, 1, 0, 5, 0, 0, jst)},
+			{Subject: "ip:192.0.2.1", Failures: 3, LastFailedAt: time.Date(2025, 1, 1, 0, 5, 0, 0, jst)},
+		},
+	}))
+
+	require.NoError(t, c.DeleteSignInAttempt(t.Context(), "email:user01@dummy.invalid"))
+
+	tdb.Assert(t, []any{
+		database.SignInAttempts{
+			{Subject: "ip:192.0.2.1", Failures: 3, LastFailedAt: time.Date(2025, 1, 1, 0, 5, 0, 0, jst)},
+		},
+	})
+}
@@ -3,6 +3,8 @@ package database
 import (
 	"context"
 	"errors"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/minguu42/harmattan/internal/domain"
@@ -16,8 +18,11 @@ type Step struct {
 	TaskID      domain.TaskID
 	Name        string
 	CompletedAt *time.Time
+	Position    string
+	Version     int
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	DeletedAt   gorm.DeletedAt
 }
 
 func (s *Step) ToDomain() *domain.Step {
@@ -27,6 +32,8 @@ func (s *Step) ToDomain() *domain.Step {
 		TaskID:      s.TaskID,
 		Name:        s.Name,
 		CompletedAt: s.CompletedAt,
+		Position:    s.Position,
+		Version:     s.Version,
 		CreatedAt:   s.CreatedAt,
 		UpdatedAt:   s.UpdatedAt,
 	}
@@ -49,6 +56,8 @@ func (c *Client) CreateStep(ctx context.Context, s *domain.Step) error {
 		TaskID:      s.TaskID,
 		Name:        s.Name,
 		CompletedAt: s.CompletedAt,
+		Position:    s.Position,
+		Version:     s.Version,
 		CreatedAt:   s.CreatedAt,
 		UpdatedAt:   s.UpdatedAt,
 	}).Error; err != nil {
@@ -76,19 +85,65 @@ func (c *Client) GetStepByID(ctx context.Context, id domain.StepID) (*domain.Ste
 	return s.ToDomain(), nil
 }
 
+// ListStepPositions はタスクのすべてのステップの位置を位置の昇順で返す
+func (c *Client) ListStepPositions(ctx context.Context, taskID domain.TaskID) ([]domain.PositionEntry[domain.StepID], error) {
+	var ss Steps
+	if err := c.db(ctx).Select("id", "position").Where("task_id = ?", taskID).Order("position").Order("id").Find(&ss).Error; err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	entries := make([]domain.PositionEntry[domain.StepID], 0, len(ss))
+	for _, s := range ss {
+		entries = append(entries, domain.PositionEntry[domain.StepID]{ID: s.ID, Position: s.Position})
+	}
+	return entries, nil
+}
+
+// UpdateStep はステップを更新し、版数を s.Version にする
+// 更新前の版数が s.Version-1 でない場合は ErrVersionConflict を返す
 func (c *Client) UpdateStep(ctx context.Context, s *domain.Step) error {
-	if err := c.db(ctx).Model(Step{}).Where("id = ?", s.ID).Updates(map[string]any{
+	if err := c.updateVersioned(ctx, Step{}, s.ID, s.Version, map[string]any{
 		"name":         s.Name,
 		"completed_at": s.CompletedAt,
 		"updated_at":   s.UpdatedAt,
-	}).Error; err != nil {
+	}); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// UpdateStepPositions はステップの位置を更新する
+// 並び替えは内容の更新ではないため、更新日時は変更しない
+func (c *Client) UpdateStepPositions(ctx context.Context, positions map[domain.StepID]string) error {
+	for _, id := range slices.Sorted(maps.Keys(positions)) {
+		if err := c.db(ctx).Model(Step{}).Where("id = ?", id).UpdateColumns(map[string]any{
+			"position":   positions[id],
+			"updated_at": gorm.Expr("updated_at"),
+		}).Error; err != nil {
+			return errtrace.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// preloadSteps はタスクのステップを位置の昇順で読み込む
+func preloadSteps(q *gorm.DB) *gorm.DB {
+	return q.Preload("Steps", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position").Order("id")
+	})
+}
+
+// TrashStepByID はステップをゴミ箱に入れる
+func (c *Client) TrashStepByID(ctx context.Context, id domain.StepID, deletedAt time.Time) error {
+	if err := c.db(ctx).Model(Step{}).Where("id = ?", id).UpdateColumns(trashColumns(deletedAt)).Error; err != nil {
 		return errtrace.Wrap(err)
 	}
 	return nil
 }
 
-func (c *Client) DeleteStepByID(ctx context.Context, id domain.StepID) error {
-	if err := c.db(ctx).Where("id = ?", id).Delete(Step{}).Error; err != nil {
+// RestoreStep はゴミ箱に入っているステップを復元する
+func (c *Client) RestoreStep(ctx context.Context, id domain.StepID) error {
+	if err := c.db(ctx).Unscoped().Model(Step{}).Where("id = ?", id).UpdateColumns(restoreColumns()).Error; err != nil {
 		return errtrace.Wrap(err)
 	}
 	return nil
@@ -8,6 +8,7 @@ import (
 	"github.com/minguu42/harmattan/internal/domain"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 )
 
 func TestClient_CreateStep(t *testing.T) {
@@ -84,6 +85,53 @@ func TestClient_CountSteps(t *testing.T) {
 	}
 }
 
+func TestClient_ListStepPositions(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "task02", UserID: "user01", ProjectID: "project01", Name: "タスク2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.Steps{
+			{ID: "step01", UserID: "user01", TaskID: "task01", Name: "ステップ1", Position: "r", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "step02", UserID: "user01", TaskID: "task01", Name: "ステップ2", Position: "9", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "step03", UserID: "user01", TaskID: "task02", Name: "ステップ3", Position: "0i", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+	}))
+
+	tests := []struct {
+		name   string
+		taskID domain.TaskID
+		want   []domain.PositionEntry[domain.StepID]
+	}{
+		{
+			name:   "multiple",
+			taskID: "task01",
+			want: []domain.PositionEntry[domain.StepID]{
+				{ID: "step02", Position: "9"},
+				{ID: "step01", Position: "r"},
+			},
+		},
+		{
+			name:   "no_match",
+			taskID: "task99",
+			want:   []domain.PositionEntry[domain.StepID]{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.ListStepPositions(t.Context(), tt.taskID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestClient_GetStepByID(t *testing.T) {
 	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
 		database.Users{
@@ -150,7 +198,7 @@ func TestClient_UpdateStep(t *testing.T) {
 			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
 		},
 		database.Steps{
-			{ID: "step01", UserID: "user01", TaskID: "task01", Name: "ステップ1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "step01", UserID: "user01", TaskID: "task01", Name: "ステップ1", Version: 1, CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
 		},
 	}))
 
@@ -159,18 +207,51 @@ func TestClient_UpdateStep(t *testing.T) {
 		ID:          "step01",
 		Name:        "更新後ステップ",
 		CompletedAt: &completedAt,
+		Version:     2,
 		UpdatedAt:   time.Date(2025, 2, 1, 0, 0, 0, 0, jst),
 	})
 	require.NoError(t, err)
 
+	// 更新前の版数が一致しない場合は、他の更新が先に行われたとみなして更新しない
+	err = c.UpdateStep(t.Context(), &domain.Step{ID: "step01", Name: "競合ステップ", Version: 2})
+	assert.ErrorIs(t, err, database.ErrVersionConflict)
+
+	tdb.Assert(t, []any{
+		database.Steps{
+			{ID: "step01", UserID: "user01", TaskID: "task01", Name: "更新後ステップ", CompletedAt: &completedAt, Version: 2, CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 2, 1, 0, 0, 0, 0, jst)},
+		},
+	})
+}
+
+func TestClient_UpdateStepPositions(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Steps{
+			{ID: "step01", UserID: "user01", TaskID: "task01", Name: "ステップ1", Position: "i", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "step02", UserID: "user01", TaskID: "task01", Name: "ステップ2", Position: "r", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+	}))
+
+	err := c.UpdateStepPositions(t.Context(), map[domain.StepID]string{"step02": "9"})
+	require.NoError(t, err)
+
 	tdb.Assert(t, []any{
 		database.Steps{
-			{ID: "step01", UserID: "user01", TaskID: "task01", Name: "更新後ステップ", CompletedAt: &completedAt, CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 2, 1, 0, 0, 0, 0, jst)},
+			{ID: "step01", UserID: "user01", TaskID: "task01", Name: "ステップ1", Position: "i", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "step02", UserID: "user01", TaskID: "task01", Name: "ステップ2", Position: "9", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
 		},
 	})
 }
 
-func TestClient_DeleteStepByID(t *testing.T) {
+func TestClient_TrashStepByID(t *testing.T) {
 	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
 		database.Users{
 			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
@@ -187,12 +268,41 @@ func TestClient_DeleteStepByID(t *testing.T) {
 		},
 	}))
 
-	err := c.DeleteStepByID(t.Context(), "step01")
+	err := c.TrashStepByID(t.Context(), "step01", time.Date(2025, 2, 1, 0, 0, 0, 0, jst))
 	require.NoError(t, err)
 
 	tdb.Assert(t, []any{
 		database.Steps{
+			{ID: "step01", UserID: "user01", TaskID: "task01", Name: "ステップ1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
 			{ID: "step02", UserID: "user01", TaskID: "task01", Name: "ステップ2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
 		},
 	})
 }
+
+func TestClient_RestoreStep(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Steps{
+			{ID: "step01", UserID: "user01", TaskID: "task01", Name: "ステップ1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "step02", UserID: "user01", TaskID: "task01", Name: "ステップ2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+		},
+	}))
+
+	err := c.RestoreStep(t.Context(), "step01")
+	require.NoError(t, err)
+
+	tdb.Assert(t, []any{
+		database.Steps{
+			{ID: "step01", UserID: "user01", TaskID: "task01", Name: "ステップ1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "step02", UserID: "user01", TaskID: "task01", Name: "ステップ2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+		},
+	})
+}
@@ -14,8 +14,10 @@ type Tag struct {
 	ID        domain.TagID
 	UserID    domain.UserID
 	Name      string
+	Version   int
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt
 }
 
 func (t *Tag) ToDomain() *domain.Tag {
@@ -23,6 +25,7 @@ func (t *Tag) ToDomain() *domain.Tag {
 		ID:        t.ID,
 		UserID:    t.UserID,
 		Name:      t.Name,
+		Version:   t.Version,
 		CreatedAt: t.CreatedAt,
 		UpdatedAt: t.UpdatedAt,
 	}
@@ -43,6 +46,7 @@ func (c *Client) CreateTag(ctx context.Context, t *domain.Tag) error {
 		ID:        t.ID,
 		UserID:    t.UserID,
 		Name:      t.Name,
+		Version:   t.Version,
 		CreatedAt: t.CreatedAt,
 		UpdatedAt: t.UpdatedAt,
 	}).Error; err != nil {
@@ -59,14 +63,29 @@ func (c *Client) CountTags(ctx context.Context, id domain.UserID) (int, error) {
 	return int(count), nil
 }
 
-func (c *Client) ListTags(ctx context.Context, id domain.UserID, limit, offset int) (domain.Tags, error) {
+// ListTags はユーザのタグを作成日時の昇順で返す
+// after が nil でない場合は after が指すタグより後ろのタグを返す
+func (c *Client) ListTags(ctx context.Context, id domain.UserID, after *Cursor, limit, offset int) (domain.Tags, error) {
 	var ts Tags
-	if err := c.db(ctx).Where("user_id = ?", id).Limit(limit).Offset(offset).Find(&ts).Error; err != nil {
+	q := c.db(ctx).Where("user_id = ?", id)
+	if after != nil {
+		createdAt, err := parseCursorTime(after.Key)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		q = whereAfter(q, "created_at", createdAt, after.ID, false)
+	}
+	if err := q.Order("created_at").Order("id").Limit(limit).Offset(offset).Find(&ts).Error; err != nil {
 		return nil, errtrace.Wrap(err)
 	}
 	return ts.ToDomain(), nil
 }
 
+// TagCursor は ListTags で t の次から取得するためのカーソルを返す
+func TagCursor(t *domain.Tag) *Cursor {
+	return &Cursor{Key: formatCursorTime(t.CreatedAt), ID: string(t.ID)}
+}
+
 func (c *Client) GetTagByID(ctx context.Context, id domain.TagID) (*domain.Tag, error) {
 	var t Tag
 	if err := c.db(ctx).Where("id = ?", id).Take(&t).Error; err != nil {
@@ -90,18 +109,30 @@ func (c *Client) GetTagsByIDs(ctx context.Context, ids []domain.TagID) (domain.T
 	return ts.ToDomain(), nil
 }
 
+// UpdateTag はタグを更新し、版数を t.Version にする
+// 更新前の版数が t.Version-1 でない場合は ErrVersionConflict を返す
 func (c *Client) UpdateTag(ctx context.Context, t *domain.Tag) error {
-	if err := c.db(ctx).Model(Tag{}).Where("id = ?", t.ID).Updates(map[string]any{
+	if err := c.updateVersioned(ctx, Tag{}, t.ID, t.Version, map[string]any{
 		"name":       t.Name,
 		"updated_at": t.UpdatedAt,
-	}).Error; err != nil {
+	}); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// TrashTagByID はタグをゴミ箱に入れる
+// タスクとの関連付けは残すため、タグを復元するとタスクにも再び表示される
+func (c *Client) TrashTagByID(ctx context.Context, id domain.TagID, deletedAt time.Time) error {
+	if err := c.db(ctx).Model(Tag{}).Where("id = ?", id).UpdateColumns(trashColumns(deletedAt)).Error; err != nil {
 		return errtrace.Wrap(err)
 	}
 	return nil
 }
 
-func (c *Client) DeleteTagByID(ctx context.Context, id domain.TagID) error {
-	if err := c.db(ctx).Where("id = ?", id).Delete(Tag{}).Error; err != nil {
+// RestoreTag はゴミ箱に入っているタグを復元する
+func (c *Client) RestoreTag(ctx context.Context, id domain.TagID) error {
+	if err := c.db(ctx).Unscoped().Model(Tag{}).Where("id = ?", id).UpdateColumns(restoreColumns()).Error; err != nil {
 		return errtrace.Wrap(err)
 	}
 	return nil
@@ -8,6 +8,7 @@ import (
 	"github.com/minguu42/harmattan/internal/domain"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 )
 
 func TestClient_CreateTag(t *testing.T) {
@@ -86,6 +87,7 @@ func TestClient_ListTags(t *testing.T) {
 	tests := []struct {
 		name   string
 		userID domain.UserID
+		after  *database.Cursor
 		limit  int
 		offset int
 		want   domain.Tags
@@ -118,10 +120,19 @@ func TestClient_ListTags(t *testing.T) {
 				{ID: "tag03", UserID: "user01", Name: "タグ3", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
 			},
 		},
+		{
+			name:   "cursor",
+			userID: "user01",
+			after:  &database.Cursor{Key: "2025-01-01T00:00:02+09:00", ID: "tag02"},
+			limit:  10,
+			want: domain.Tags{
+				{ID: "tag03", UserID: "user01", Name: "タグ3", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := c.ListTags(t.Context(), tt.userID, tt.limit, tt.offset)
+			got, err := c.ListTags(t.Context(), tt.userID, tt.after, tt.limit, tt.offset)
 			require.NoError(t, err)
 			assert.Equal(t, tt.want, got)
 		})
@@ -229,25 +240,30 @@ func TestClient_UpdateTag(t *testing.T) {
 			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
 		},
 		database.Tags{
-			{ID: "tag01", UserID: "user01", Name: "タグ1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "tag01", UserID: "user01", Name: "タグ1", Version: 1, CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
 		},
 	}))
 
 	err := c.UpdateTag(t.Context(), &domain.Tag{
 		ID:        "tag01",
 		Name:      "更新後タグ",
+		Version:   2,
 		UpdatedAt: time.Date(2025, 2, 1, 0, 0, 0, 0, jst),
 	})
 	require.NoError(t, err)
 
+	// 更新前の版数が一致しない場合は、他の更新が先に行われたとみなして更新しない
+	err = c.UpdateTag(t.Context(), &domain.Tag{ID: "tag01", Name: "競合タグ", Version: 2})
+	assert.ErrorIs(t, err, database.ErrVersionConflict)
+
 	tdb.Assert(t, []any{
 		database.Tags{
-			{ID: "tag01", UserID: "user01", Name: "更新後タグ", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 2, 1, 0, 0, 0, 0, jst)},
+			{ID: "tag01", UserID: "user01", Name: "更新後タグ", Version: 2, CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 2, 1, 0, 0, 0, 0, jst)},
 		},
 	})
 }
 
-func TestClient_DeleteTagByID(t *testing.T) {
+func TestClient_TrashTagByID(t *testing.T) {
 	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
 		database.Users{
 			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
@@ -258,12 +274,35 @@ func TestClient_DeleteTagByID(t *testing.T) {
 		},
 	}))
 
-	err := c.DeleteTagByID(t.Context(), "tag01")
+	err := c.TrashTagByID(t.Context(), "tag01", time.Date(2025, 2, 1, 0, 0, 0, 0, jst))
 	require.NoError(t, err)
 
 	tdb.Assert(t, []any{
 		database.Tags{
+			{ID: "tag01", UserID: "user01", Name: "タグ1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
 			{ID: "tag02", UserID: "user01", Name: "タグ2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
 		},
 	})
 }
+
+func TestClient_RestoreTag(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Tags{
+			{ID: "tag01", UserID: "user01", Name: "タグ1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "tag02", UserID: "user01", Name: "タグ2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+		},
+	}))
+
+	err := c.RestoreTag(t.Context(), "tag01")
+	require.NoError(t, err)
+
+	tdb.Assert(t, []any{
+		database.Tags{
+			{ID: "tag01", UserID: "user01", Name: "タグ1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "tag02", UserID: "user01", Name: "タグ2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+		},
+	})
+}
@@ -3,6 +3,9 @@ package database
 import (
 	"context"
 	"errors"
+	"maps"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/minguu42/harmattan/internal/domain"
@@ -12,44 +15,56 @@ import (
 )
 
 type Task struct {
-	ID          domain.TaskID
-	UserID      domain.UserID
-	ProjectID   domain.ProjectID
-	Name        string
-	Content     string
-	Priority    int
-	DueOn       *plain.Date
-	CompletedAt *time.Time
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID               domain.TaskID
+	UserID           domain.UserID
+	ProjectID        domain.ProjectID
+	AssigneeID       *domain.UserID
+	Name             string
+	Content          string
+	Priority         int
+	DueOn            *plain.Date
+	Recurrence       *domain.RecurrenceRule
+	NextOccurrenceID *domain.TaskID
+	CompletedAt      *time.Time
+	Position         string
+	Version          int
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	DeletedAt        gorm.DeletedAt
 
 	Steps Steps
 }
 
-func (t *Task) ToDomain(taskTags TaskTags) *domain.Task {
+func (t *Task) ToDomain(taskTags TaskTags, commentCount int) *domain.Task {
 	return &domain.Task{
-		ID:          t.ID,
-		UserID:      t.UserID,
-		ProjectID:   t.ProjectID,
-		Name:        t.Name,
-		TagIDs:      taskTags.TagIDs(),
-		Content:     t.Content,
-		Priority:    t.Priority,
-		DueOn:       t.DueOn,
-		CompletedAt: t.CompletedAt,
-		CreatedAt:   t.CreatedAt,
-		UpdatedAt:   t.UpdatedAt,
-		Steps:       t.Steps.ToDomain(),
+		ID:               t.ID,
+		UserID:           t.UserID,
+		ProjectID:        t.ProjectID,
+		AssigneeID:       t.AssigneeID,
+		Name:             t.Name,
+		TagIDs:           taskTags.TagIDs(),
+		Content:          t.Content,
+		Priority:         t.Priority,
+		DueOn:            t.DueOn,
+		Recurrence:       t.Recurrence,
+		NextOccurrenceID: t.NextOccurrenceID,
+		CompletedAt:      t.CompletedAt,
+		Position:         t.Position,
+		Version:          t.Version,
+		CreatedAt:        t.CreatedAt,
+		UpdatedAt:        t.UpdatedAt,
+		Steps:            t.Steps.ToDomain(),
+		CommentCount:     commentCount,
 	}
 }
 
 type Tasks []Task
 
-func (ts Tasks) ToDomain(taskTags TaskTags) domain.Tasks {
+func (ts Tasks) ToDomain(taskTags TaskTags, commentCounts map[domain.TaskID]int) domain.Tasks {
 	tasks := make(domain.Tasks, 0, len(ts))
 	taskTagsByTaskID := taskTags.ByTaskID()
 	for _, t := range ts {
-		tasks = append(tasks, *t.ToDomain(taskTagsByTaskID[t.ID]))
+		tasks = append(tasks, *t.ToDomain(taskTagsByTaskID[t.ID], commentCounts[t.ID]))
 	}
 	return tasks
 }
@@ -64,19 +79,35 @@ func (ts Tasks) IDs() []domain.TaskID {
 
 func (c *Client) CreateTask(ctx context.Context, t *domain.Task) error {
 	if err := c.db(ctx).Create(&Task{
-		ID:          t.ID,
-		UserID:      t.UserID,
-		ProjectID:   t.ProjectID,
-		Name:        t.Name,
-		Content:     t.Content,
-		Priority:    t.Priority,
-		DueOn:       t.DueOn,
-		CompletedAt: t.CompletedAt,
-		CreatedAt:   t.CreatedAt,
-		UpdatedAt:   t.UpdatedAt,
+		ID:               t.ID,
+		UserID:           t.UserID,
+		ProjectID:        t.ProjectID,
+		AssigneeID:       t.AssigneeID,
+		Name:             t.Name,
+		Content:          t.Content,
+		Priority:         t.Priority,
+		DueOn:            t.DueOn,
+		Recurrence:       t.Recurrence,
+		NextOccurrenceID: t.NextOccurrenceID,
+		CompletedAt:      t.CompletedAt,
+		Position:         t.Position,
+		Version:          t.Version,
+		CreatedAt:        t.CreatedAt,
+		UpdatedAt:        t.UpdatedAt,
 	}).Error; err != nil {
 		return errtrace.Wrap(err)
 	}
+
+	if len(t.TagIDs) == 0 {
+		return nil
+	}
+	taskTags := make(TaskTags, 0, len(t.TagIDs))
+	for _, tagID := range t.TagIDs {
+		taskTags = append(taskTags, TaskTag{TaskID: t.ID, TagID: tagID, CreatedAt: t.CreatedAt})
+	}
+	if err := c.db(ctx).Create(&taskTags).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
 	return nil
 }
 
@@ -88,26 +119,231 @@ func (c *Client) CountTasks(ctx context.Context, projectID domain.ProjectID) (in
 	return int(count), nil
 }
 
-func (c *Client) ListTasks(ctx context.Context, projectID domain.ProjectID, limit, offset int, showCompleted bool) (domain.Tasks, error) {
+// ListTasksOptions はタスク一覧の絞り込み条件と並び順を表す
+// ポインタのフィールドが nil の場合、その条件では絞り込まない
+type ListTasksOptions struct {
+	ShowCompleted bool
+	MinPriority   *int
+	MaxPriority   *int
+	// TagIDs が空でない場合、いずれかのタグが付いたタスクに絞り込む
+	// MatchAllTags が true の場合はすべてのタグが付いたタスクに絞り込む
+	TagIDs       []domain.TagID
+	MatchAllTags bool
+	AssigneeID   *domain.UserID
+	DueFrom      *plain.Date
+	DueTo        *plain.Date
+	HasDueDate   *bool
+	// SortKey が空の場合は位置で並び替える
+	SortKey    domain.TaskSortKey
+	Descending bool
+	// After が nil でない場合は After が指すタスクより後ろのタスクを返す
+	After *Cursor
+}
+
+func (c *Client) ListTasks(ctx context.Context, projectID domain.ProjectID, opts *ListTasksOptions, limit, offset int) (domain.Tasks, error) {
 	var ts Tasks
-	q := c.db(ctx).Preload("Steps").Where("project_id = ?", projectID)
-	if !showCompleted {
+	q := preloadSteps(c.db(ctx)).Where("project_id = ?", projectID)
+	if !opts.ShowCompleted {
 		q = q.Where("completed_at IS NULL")
 	}
+	if opts.MinPriority != nil {
+		q = q.Where("priority >= ?", *opts.MinPriority)
+	}
+	if opts.MaxPriority != nil {
+		q = q.Where("priority <= ?", *opts.MaxPriority)
+	}
+	if len(opts.TagIDs) > 0 {
+		q = q.Where("id IN (?)", c.taggedTaskIDs(ctx, opts.TagIDs, opts.MatchAllTags))
+	}
+	if opts.AssigneeID != nil {
+		q = q.Where("assignee_id = ?", *opts.AssigneeID)
+	}
+	if opts.DueFrom != nil {
+		q = q.Where("due_on >= ?", opts.DueFrom)
+	}
+	if opts.DueTo != nil {
+		q = q.Where("due_on <= ?", opts.DueTo)
+	}
+	if opts.HasDueDate != nil {
+		if *opts.HasDueDate {
+			q = q.Where("due_on IS NOT NULL")
+		} else {
+			q = q.Where("due_on IS NULL")
+		}
+	}
+
+	if opts.After != nil {
+		var err error
+		q, err = whereAfterTask(q, opts.SortKey, opts.After, opts.Descending)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+	}
+
+	direction := "ASC"
+	if opts.Descending {
+		direction = "DESC"
+	}
+	column := taskSortColumn(opts.SortKey)
+	if column == "due_on" {
+		// 期日が設定されていないタスクは並び順によらず末尾に置く
+		q = q.Order("due_on IS NULL")
+	}
+	q = q.Order(column + " " + direction).Order("id " + direction)
+
 	if err := q.Limit(limit).Offset(offset).Find(&ts).Error; err != nil {
 		return nil, errtrace.Wrap(err)
 	}
 
 	var tts TaskTags
-	if err := c.db(ctx).Where("task_id in ?", ts.IDs()).Find(&tts).Error; err != nil {
+	if err := c.taskTags(ctx).Where("task_id in ?", ts.IDs()).Find(&tts).Error; err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	commentCounts, err := c.countCommentsByTaskID(ctx, ts.IDs())
+	if err != nil {
 		return nil, errtrace.Wrap(err)
 	}
-	return ts.ToDomain(tts), nil
+	return ts.ToDomain(tts, commentCounts), nil
+}
+
+func taskSortColumn(key domain.TaskSortKey) string {
+	switch key {
+	case domain.TaskSortKeyPriority, domain.TaskSortKeyDueOn, domain.TaskSortKeyCreatedAt, domain.TaskSortKeyUpdatedAt, domain.TaskSortKeyName:
+		return string(key)
+	default:
+		return "position"
+	}
+}
+
+func whereAfterTask(q *gorm.DB, key domain.TaskSortKey, after *Cursor, desc bool) (*gorm.DB, error) {
+	switch column := taskSortColumn(key); column {
+	case "priority":
+		priority, err := strconv.Atoi(after.Key)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		return whereAfter(q, column, priority, after.ID, desc), nil
+	case "due_on":
+		// 期日が設定されていないタスクは末尾に並ぶため、カーソルの期日が空の場合は期日が設定されていないタスクのみが残る
+		if after.Key == "" {
+			if desc {
+				return q.Where("due_on IS NULL AND id < ?", after.ID), nil
+			}
+			return q.Where("due_on IS NULL AND id > ?", after.ID), nil
+		}
+		dueOn, err := plain.ParseDate(after.Key)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		if desc {
+			return q.Where("(due_on IS NULL OR due_on < ? OR (due_on = ? AND id < ?))", dueOn, dueOn, after.ID), nil
+		}
+		return q.Where("(due_on IS NULL OR due_on > ? OR (due_on = ? AND id > ?))", dueOn, dueOn, after.ID), nil
+	case "position", "name":
+		return whereAfter(q, column, after.Key, after.ID, desc), nil
+	default:
+		t, err := parseCursorTime(after.Key)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		return whereAfter(q, column, t, after.ID, desc), nil
+	}
+}
+
+// TaskCursor は key で並べたタスク一覧で t の次から取得するためのカーソルを返す
+func TaskCursor(t *domain.Task, key domain.TaskSortKey) *Cursor {
+	c := Cursor{ID: string(t.ID)}
+	switch taskSortColumn(key) {
+	case "priority":
+		c.Key = strconv.Itoa(t.Priority)
+	case "due_on":
+		if t.DueOn != nil {
+			c.Key = t.DueOn.String()
+		}
+	case "name":
+		c.Key = t.Name
+	case "created_at":
+		c.Key = formatCursorTime(t.CreatedAt)
+	case "updated_at":
+		c.Key = formatCursorTime(t.UpdatedAt)
+	default:
+		c.Key = t.Position
+	}
+	return &c
+}
+
+// ListDueTasks はユーザがオーナーまたはメンバーであるプロジェクトの未完了のタスクのうち、期日が from 以上 to 以下のものを期日の昇順で返す
+// from と to が nil の場合はそれぞれ下限と上限を設けない
+// アーカイブされたプロジェクトのタスクは含まない
+// after が nil でない場合は after が指すタスクより後ろのタスクを返す
+func (c *Client) ListDueTasks(ctx context.Context, userID domain.UserID, from, to *plain.Date, after *Cursor, limit, offset int) (domain.Tasks, error) {
+	var ts Tasks
+	q := preloadSteps(c.db(ctx)).
+		Where("project_id IN (?)", c.accessibleProjectIDs(ctx, userID).Where("NOT is_archived")).
+		Where("completed_at IS NULL").
+		Where("due_on IS NOT NULL")
+	if from != nil {
+		q = q.Where("due_on >= ?", from)
+	}
+	if to != nil {
+		q = q.Where("due_on <= ?", to)
+	}
+	if after != nil {
+		dueOn, err := plain.ParseDate(after.Key)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		q = whereAfter(q, "due_on", dueOn, after.ID, false)
+	}
+	if err := q.Order("due_on").Order("id").Limit(limit).Offset(offset).Find(&ts).Error; err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	var tts TaskTags
+	if err := c.taskTags(ctx).Where("task_id in ?", ts.IDs()).Find(&tts).Error; err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	commentCounts, err := c.countCommentsByTaskID(ctx, ts.IDs())
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return ts.ToDomain(tts, commentCounts), nil
+}
+
+// ListAssignedTasks はユーザがオーナーまたはメンバーであるプロジェクトの未完了のタスクのうち、ユーザが担当者であるものを期日の昇順で返す
+// 期日が設定されていないタスクは末尾に置き、アーカイブされたプロジェクトのタスクは含まない
+// after が nil でない場合は after が指すタスクより後ろのタスクを返す
+func (c *Client) ListAssignedTasks(ctx context.Context, userID domain.UserID, after *Cursor, limit, offset int) (domain.Tasks, error) {
+	var ts Tasks
+	q := preloadSteps(c.db(ctx)).
+		Where("project_id IN (?)", c.accessibleProjectIDs(ctx, userID).Where("NOT is_archived")).
+		Where("assignee_id = ?", userID).
+		Where("completed_at IS NULL")
+	if after != nil {
+		var err error
+		q, err = whereAfterTask(q, domain.TaskSortKeyDueOn, after, false)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+	}
+	if err := q.Order("due_on IS NULL").Order("due_on").Order("id").Limit(limit).Offset(offset).Find(&ts).Error; err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	var tts TaskTags
+	if err := c.taskTags(ctx).Where("task_id in ?", ts.IDs()).Find(&tts).Error; err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	commentCounts, err := c.countCommentsByTaskID(ctx, ts.IDs())
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return ts.ToDomain(tts, commentCounts), nil
 }
 
 func (c *Client) GetTaskByID(ctx context.Context, id domain.TaskID) (*domain.Task, error) {
 	var t Task
-	if err := c.db(ctx).Preload("Steps").Where("id = ?", id).Take(&t).Error; err != nil {
+	if err := preloadSteps(c.db(ctx)).Where("id = ?", id).Take(&t).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errtrace.Wrap(ErrNotFound)
 		}
@@ -115,25 +351,52 @@ func (c *Client) GetTaskByID(ctx context.Context, id domain.TaskID) (*domain.Tas
 	}
 
 	var tts TaskTags
-	if err := c.db(ctx).Where("task_id = ?", t.ID).Find(&tts).Error; err != nil {
+	if err := c.taskTags(ctx).Where("task_id = ?", t.ID).Find(&tts).Error; err != nil {
 		return nil, errtrace.Wrap(err)
 	}
-	return t.ToDomain(tts), nil
+	commentCount, err := c.CountComments(ctx, t.ID)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return t.ToDomain(tts, commentCount), nil
 }
 
+// ListTaskPositions はプロジェクトのすべてのタスクの位置を位置の昇順で返す
+func (c *Client) ListTaskPositions(ctx context.Context, projectID domain.ProjectID) ([]domain.PositionEntry[domain.TaskID], error) {
+	var ts Tasks
+	if err := c.db(ctx).Select("id", "position").Where("project_id = ?", projectID).Order("position").Order("id").Find(&ts).Error; err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	entries := make([]domain.PositionEntry[domain.TaskID], 0, len(ts))
+	for _, t := range ts {
+		entries = append(entries, domain.PositionEntry[domain.TaskID]{ID: t.ID, Position: t.Position})
+	}
+	return entries, nil
+}
+
+// UpdateTask はタスクを更新し、版数を t.Version にする
+// 更新前の版数が t.Version-1 でない場合は ErrVersionConflict を返す
 func (c *Client) UpdateTask(ctx context.Context, t *domain.Task) error {
-	if err := c.db(ctx).Model(Task{}).Where("id = ?", t.ID).Updates(map[string]any{
-		"name":         t.Name,
-		"content":      t.Content,
-		"priority":     t.Priority,
-		"due_on":       t.DueOn,
-		"completed_at": t.CompletedAt,
-		"updated_at":   t.UpdatedAt,
-	}).Error; err != nil {
+	if err := c.updateVersioned(ctx, Task{}, t.ID, t.Version, map[string]any{
+		"user_id":            t.UserID,
+		"project_id":         t.ProjectID,
+		"assignee_id":        t.AssigneeID,
+		"name":               t.Name,
+		"content":            t.Content,
+		"priority":           t.Priority,
+		"due_on":             t.DueOn,
+		"recurrence":         t.Recurrence,
+		"next_occurrence_id": t.NextOccurrenceID,
+		"completed_at":       t.CompletedAt,
+		"position":           t.Position,
+		"updated_at":         t.UpdatedAt,
+	}); err != nil {
 		return errtrace.Wrap(err)
 	}
 
-	if err := c.db(ctx).Where("task_id = ?", t.ID).Delete(TaskTag{}).Error; err != nil {
+	// ゴミ箱に入っているタグとの関連付けはタグを復元したときのために残す
+	if err := c.taskTags(ctx).Where("task_id = ?", t.ID).Delete(TaskTag{}).Error; err != nil {
 		return errtrace.Wrap(err)
 	}
 	if len(t.TagIDs) == 0 {
@@ -149,8 +412,53 @@ func (c *Client) UpdateTask(ctx context.Context, t *domain.Task) error {
 	return nil
 }
 
-func (c *Client) DeleteTaskByID(ctx context.Context, id domain.TaskID) error {
-	if err := c.db(ctx).Where("id = ?", id).Delete(Task{}).Error; err != nil {
+// UpdateTaskPositions はタスクの位置を更新する
+// 並び替えは内容の更新ではないため、更新日時は変更しない
+func (c *Client) UpdateTaskPositions(ctx context.Context, positions map[domain.TaskID]string) error {
+	for _, id := range slices.Sorted(maps.Keys(positions)) {
+		if err := c.db(ctx).Model(Task{}).Where("id = ?", id).UpdateColumns(map[string]any{
+			"position":   positions[id],
+			"updated_at": gorm.Expr("updated_at"),
+		}).Error; err != nil {
+			return errtrace.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// UnassignTasks はプロジェクトのタスクのうち、ユーザが担当者であるものの担当者を外す
+// メンバーがプロジェクトから外れたことに伴う変更であるため、ゴミ箱に入っているものも含めて変更し、更新日時は変更しない
+// 担当者はタスクの表現に含まれるため、版数は増やす
+func (c *Client) UnassignTasks(ctx context.Context, projectID domain.ProjectID, userID domain.UserID) error {
+	if err := c.db(ctx).Unscoped().Model(Task{}).Where("project_id = ? AND assignee_id = ?", projectID, userID).UpdateColumns(map[string]any{
+		"assignee_id": nil,
+		"version":     gorm.Expr("version + 1"),
+		"updated_at":  gorm.Expr("updated_at"),
+	}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// TrashTaskByID はタスクをゴミ箱に入れる
+// タスクのステップも同じ削除日時でゴミ箱に入れ、RestoreTask でまとめて復元できるようにする
+func (c *Client) TrashTaskByID(ctx context.Context, id domain.TaskID, deletedAt time.Time) error {
+	if err := c.db(ctx).Model(Step{}).Where("task_id = ?", id).UpdateColumns(trashColumns(deletedAt)).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	if err := c.db(ctx).Model(Task{}).Where("id = ?", id).UpdateColumns(trashColumns(deletedAt)).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// RestoreTask はゴミ箱に入っているタスクを復元する
+// タスクと一緒にゴミ箱に入ったステップも復元し、それより前に個別にゴミ箱に入れたものはゴミ箱に残す
+func (c *Client) RestoreTask(ctx context.Context, id domain.TaskID, deletedAt time.Time) error {
+	if err := c.db(ctx).Unscoped().Model(Step{}).Where("task_id = ? AND deleted_at = ?", id, deletedAt).UpdateColumns(restoreColumns()).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	if err := c.db(ctx).Unscoped().Model(Task{}).Where("id = ?", id).UpdateColumns(restoreColumns()).Error; err != nil {
 		return errtrace.Wrap(err)
 	}
 	return nil
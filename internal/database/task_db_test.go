@@ -9,9 +9,12 @@ import (
 	"github.com/minguu42/harmattan/internal/lib/plain"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 )
 
 func TestClient_CreateTask(t *testing.T) {
+	recurrence := domain.RecurrenceRule("FREQ=DAILY")
+
 	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
 		database.Users{
 			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
@@ -19,24 +22,33 @@ func TestClient_CreateTask(t *testing.T) {
 		database.Projects{
 			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
 		},
+		database.Tags{
+			{ID: "tag01", UserID: "user01", Name: "タグ1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
 		database.Tasks{},
+		database.TaskTags{},
 	}))
 
 	err := c.CreateTask(t.Context(), &domain.Task{
-		ID:        "task01",
-		UserID:    "user01",
-		ProjectID: "project01",
-		Name:      "タスク1",
-		Content:   "Content 1",
-		Priority:  1,
-		CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst),
-		UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst),
+		ID:         "task01",
+		UserID:     "user01",
+		ProjectID:  "project01",
+		Name:       "タスク1",
+		TagIDs:     []domain.TagID{"tag01"},
+		Content:    "Content 1",
+		Priority:   1,
+		Recurrence: &recurrence,
+		CreatedAt:  time.Date(2025, 1, 1, 0, 0, 1, 0, jst),
+		UpdatedAt:  time.Date(2025, 1, 1, 0, 0, 1, 0, jst),
 	})
 	require.NoError(t, err)
 
 	tdb.Assert(t, []any{
 		database.Tasks{
-			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", Content: "Content 1", Priority: 1, CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", Content: "Content 1", Priority: 1, Recurrence: &recurrence, CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.TaskTags{
+			{TaskID: "task01", TagID: "tag01", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
 		},
 	})
 }
@@ -93,6 +105,7 @@ func TestClient_ListTasks(t *testing.T) {
 		},
 		database.Projects{
 			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "project02", UserID: "user01", Name: "プロジェクト2", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
 		},
 		database.Tags{
 			{ID: "tag01", UserID: "user01", Name: "タグ1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
@@ -103,6 +116,10 @@ func TestClient_ListTasks(t *testing.T) {
 			{ID: "task02", UserID: "user01", ProjectID: "project01", Name: "タスク2", Content: "Content 2", Priority: 2, DueOn: &dueOn, CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
 			{ID: "task03", UserID: "user01", ProjectID: "project01", Name: "タスク3", CompletedAt: &completedAt, CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
 			{ID: "task04", UserID: "user01", ProjectID: "project01", Name: "タスク4", CreatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst)},
+			{ID: "task11", UserID: "user01", ProjectID: "project02", Name: "ウ", Priority: 0, CreatedAt: time.Date(2025, 1, 1, 0, 0, 11, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 14, 0, jst)},
+			{ID: "task12", UserID: "user01", ProjectID: "project02", Name: "エ", Priority: 3, DueOn: new(plain.NewDate(2025, 1, 20)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 12, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 12, 0, jst)},
+			{ID: "task13", UserID: "user01", ProjectID: "project02", Name: "ア", Priority: 1, DueOn: new(plain.NewDate(2025, 1, 10)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 13, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 13, 0, jst)},
+			{ID: "task14", UserID: "user01", ProjectID: "project02", Name: "イ", Priority: 2, DueOn: new(plain.NewDate(2025, 1, 30)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 14, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 10, 0, jst)},
 		},
 		database.Steps{
 			{ID: "step01", UserID: "user01", TaskID: "task01", Name: "ステップ1-1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
@@ -112,16 +129,45 @@ func TestClient_ListTasks(t *testing.T) {
 		database.TaskTags{
 			{TaskID: "task02", TagID: "tag01", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
 			{TaskID: "task02", TagID: "tag02", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{TaskID: "task12", TagID: "tag01", CreatedAt: time.Date(2025, 1, 1, 0, 0, 12, 0, jst)},
+			{TaskID: "task13", TagID: "tag01", CreatedAt: time.Date(2025, 1, 1, 0, 0, 13, 0, jst)},
+			{TaskID: "task13", TagID: "tag02", CreatedAt: time.Date(2025, 1, 1, 0, 0, 13, 0, jst)},
+			{TaskID: "task14", TagID: "tag02", CreatedAt: time.Date(2025, 1, 1, 0, 0, 14, 0, jst)},
 		},
+		database.Comments{},
 	}))
 
+	task11 := domain.Task{
+		ID: "task11", UserID: "user01", ProjectID: "project02", Name: "ウ", TagIDs: []domain.TagID{},
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 11, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 14, 0, jst),
+		Steps: domain.Steps{},
+	}
+	task12 := domain.Task{
+		ID: "task12", UserID: "user01", ProjectID: "project02", Name: "エ", TagIDs: []domain.TagID{"tag01"},
+		Priority: 3, DueOn: new(plain.NewDate(2025, 1, 20)),
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 12, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 12, 0, jst),
+		Steps: domain.Steps{},
+	}
+	task13 := domain.Task{
+		ID: "task13", UserID: "user01", ProjectID: "project02", Name: "ア", TagIDs: []domain.TagID{"tag01", "tag02"},
+		Priority: 1, DueOn: new(plain.NewDate(2025, 1, 10)),
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 13, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 13, 0, jst),
+		Steps: domain.Steps{},
+	}
+	task14 := domain.Task{
+		ID: "task14", UserID: "user01", ProjectID: "project02", Name: "イ", TagIDs: []domain.TagID{"tag02"},
+		Priority: 2, DueOn: new(plain.NewDate(2025, 1, 30)),
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 14, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 10, 0, jst),
+		Steps: domain.Steps{},
+	}
+
 	tests := []struct {
-		name          string
-		projectID     domain.ProjectID
-		limit         int
-		offset        int
-		showCompleted bool
-		want          domain.Tasks
+		name      string
+		projectID domain.ProjectID
+		opts      database.ListTasksOptions
+		limit     int
+		offset    int
+		want      domain.Tasks
 	}{
 		{
 			name:      "multiple",
@@ -157,11 +203,11 @@ func TestClient_ListTasks(t *testing.T) {
 			},
 		},
 		{
-			name:          "show_completed",
-			projectID:     "project01",
-			limit:         10,
-			offset:        0,
-			showCompleted: true,
+			name:      "show_completed",
+			projectID: "project01",
+			opts:      database.ListTasksOptions{ShowCompleted: true},
+			limit:     10,
+			offset:    0,
 			want: domain.Tasks{
 				{
 					ID:        "task01",
@@ -247,10 +293,307 @@ func TestClient_ListTasks(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:      "priority_range",
+			projectID: "project02",
+			opts:      database.ListTasksOptions{MinPriority: new(1), MaxPriority: new(2)},
+			limit:     10,
+			want:      domain.Tasks{task13, task14},
+		},
+		{
+			name:      "any_tags",
+			projectID: "project02",
+			opts:      database.ListTasksOptions{TagIDs: []domain.TagID{"tag01", "tag02"}},
+			limit:     10,
+			want:      domain.Tasks{task12, task13, task14},
+		},
+		{
+			name:      "all_tags",
+			projectID: "project02",
+			opts:      database.ListTasksOptions{TagIDs: []domain.TagID{"tag01", "tag02"}, MatchAllTags: true},
+			limit:     10,
+			want:      domain.Tasks{task13},
+		},
+		{
+			name:      "due_range",
+			projectID: "project02",
+			opts:      database.ListTasksOptions{DueFrom: new(plain.NewDate(2025, 1, 10)), DueTo: new(plain.NewDate(2025, 1, 20))},
+			limit:     10,
+			want:      domain.Tasks{task12, task13},
+		},
+		{
+			name:      "no_due_date",
+			projectID: "project02",
+			opts:      database.ListTasksOptions{HasDueDate: new(false)},
+			limit:     10,
+			want:      domain.Tasks{task11},
+		},
+		{
+			name:      "sort_priority_desc",
+			projectID: "project02",
+			opts:      database.ListTasksOptions{SortKey: domain.TaskSortKeyPriority, Descending: true},
+			limit:     10,
+			want:      domain.Tasks{task12, task14, task13, task11},
+		},
+		{
+			name:      "sort_due_on",
+			projectID: "project02",
+			opts:      database.ListTasksOptions{SortKey: domain.TaskSortKeyDueOn},
+			limit:     10,
+			want:      domain.Tasks{task13, task12, task14, task11},
+		},
+		{
+			name:      "sort_due_on_desc",
+			projectID: "project02",
+			opts:      database.ListTasksOptions{SortKey: domain.TaskSortKeyDueOn, Descending: true},
+			limit:     10,
+			want:      domain.Tasks{task14, task12, task13, task11},
+		},
+		{
+			name:      "sort_updated_at",
+			projectID: "project02",
+			opts:      database.ListTasksOptions{SortKey: domain.TaskSortKeyUpdatedAt},
+			limit:     10,
+			want:      domain.Tasks{task14, task12, task13, task11},
+		},
+		{
+			name:      "sort_name",
+			projectID: "project02",
+			opts:      database.ListTasksOptions{SortKey: domain.TaskSortKeyName},
+			limit:     10,
+			want:      domain.Tasks{task13, task14, task11, task12},
+		},
+		{
+			name:      "cursor",
+			projectID: "project02",
+			opts:      database.ListTasksOptions{After: &database.Cursor{Key: "", ID: "task12"}},
+			limit:     10,
+			want:      domain.Tasks{task13, task14},
+		},
+		{
+			name:      "cursor_sort_created_at",
+			projectID: "project02",
+			opts:      database.ListTasksOptions{SortKey: domain.TaskSortKeyCreatedAt, After: &database.Cursor{Key: "2025-01-01T00:00:12+09:00", ID: "task12"}},
+			limit:     10,
+			want:      domain.Tasks{task13, task14},
+		},
+		{
+			name:      "cursor_sort_priority_desc",
+			projectID: "project02",
+			opts:      database.ListTasksOptions{SortKey: domain.TaskSortKeyPriority, Descending: true, After: &database.Cursor{Key: "2", ID: "task14"}},
+			limit:     10,
+			want:      domain.Tasks{task13, task11},
+		},
+		{
+			name:      "cursor_sort_due_on",
+			projectID: "project02",
+			opts:      database.ListTasksOptions{SortKey: domain.TaskSortKeyDueOn, After: &database.Cursor{Key: "2025-01-20", ID: "task12"}},
+			limit:     10,
+			want:      domain.Tasks{task14, task11},
+		},
+		{
+			name:      "cursor_sort_due_on_without_due_on",
+			projectID: "project02",
+			opts:      database.ListTasksOptions{SortKey: domain.TaskSortKeyDueOn, After: &database.Cursor{Key: "", ID: "task10"}},
+			limit:     10,
+			want:      domain.Tasks{task11},
+		},
+		{
+			name:      "cursor_sort_name",
+			projectID: "project02",
+			opts:      database.ListTasksOptions{SortKey: domain.TaskSortKeyName, After: &database.Cursor{Key: "イ", ID: "task14"}},
+			limit:     10,
+			want:      domain.Tasks{task11, task12},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.ListTasks(t.Context(), tt.projectID, &tt.opts, tt.limit, tt.offset)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_ListDueTasks(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "user02", Email: "user02@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "project02", UserID: "user01", Name: "プロジェクト2", Color: "blue", IsArchived: true, CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "project03", UserID: "user02", Name: "プロジェクト3", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", DueOn: new(plain.NewDate(2025, 1, 2)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "task02", UserID: "user01", ProjectID: "project01", Name: "タスク2", DueOn: new(plain.NewDate(2025, 1, 1)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "task03", UserID: "user01", ProjectID: "project01", Name: "タスク3", DueOn: new(plain.NewDate(2025, 1, 1)), CompletedAt: new(time.Date(2025, 1, 1, 0, 0, 0, 0, jst)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+			{ID: "task04", UserID: "user01", ProjectID: "project01", Name: "タスク4", CreatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst)},
+			{ID: "task05", UserID: "user01", ProjectID: "project02", Name: "タスク5", DueOn: new(plain.NewDate(2025, 1, 1)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst)},
+			{ID: "task06", UserID: "user02", ProjectID: "project03", Name: "タスク6", DueOn: new(plain.NewDate(2025, 1, 1)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 6, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 6, 0, jst)},
+			{ID: "task07", UserID: "user01", ProjectID: "project01", Name: "タスク7", DueOn: new(plain.NewDate(2025, 1, 3)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 7, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 7, 0, jst)},
+			{ID: "task08", UserID: "user01", ProjectID: "project01", Name: "タスク8", DueOn: new(plain.NewDate(2025, 1, 2)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 8, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 8, 0, jst)},
+		},
+		database.Steps{},
+		database.TaskTags{},
+		database.Comments{},
+		database.ProjectMembers{},
+	}))
+
+	task01 := domain.Task{
+		ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", TagIDs: []domain.TagID{},
+		DueOn:     new(plain.NewDate(2025, 1, 2)),
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst),
+		Steps: domain.Steps{},
+	}
+	task02 := domain.Task{
+		ID: "task02", UserID: "user01", ProjectID: "project01", Name: "タスク2", TagIDs: []domain.TagID{},
+		DueOn:     new(plain.NewDate(2025, 1, 1)),
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst),
+		Steps: domain.Steps{},
+	}
+	task07 := domain.Task{
+		ID: "task07", UserID: "user01", ProjectID: "project01", Name: "タスク7", TagIDs: []domain.TagID{},
+		DueOn:     new(plain.NewDate(2025, 1, 3)),
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 7, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 7, 0, jst),
+		Steps: domain.Steps{},
+	}
+
+	task08 := domain.Task{
+		ID: "task08", UserID: "user01", ProjectID: "project01", Name: "タスク8", TagIDs: []domain.TagID{},
+		DueOn:     new(plain.NewDate(2025, 1, 2)),
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 8, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 8, 0, jst),
+		Steps: domain.Steps{},
+	}
+
+	tests := []struct {
+		name   string
+		from   *plain.Date
+		to     *plain.Date
+		after  *database.Cursor
+		limit  int
+		offset int
+		want   domain.Tasks
+	}{
+		{
+			name:  "same_day",
+			from:  new(plain.NewDate(2025, 1, 1)),
+			to:    new(plain.NewDate(2025, 1, 1)),
+			limit: 10,
+			want:  domain.Tasks{task02},
+		},
+		{
+			name:  "range",
+			from:  new(plain.NewDate(2025, 1, 2)),
+			to:    new(plain.NewDate(2025, 1, 3)),
+			limit: 10,
+			want:  domain.Tasks{task01, task08, task07},
+		},
+		{
+			name:  "no_lower_bound",
+			to:    new(plain.NewDate(2025, 1, 2)),
+			limit: 10,
+			want:  domain.Tasks{task02, task01, task08},
+		},
+		{
+			name:   "pagination",
+			limit:  1,
+			offset: 1,
+			want:   domain.Tasks{task01},
+		},
+		{
+			name:  "cursor",
+			after: &database.Cursor{Key: "2025-01-02", ID: "task01"},
+			limit: 10,
+			want:  domain.Tasks{task08, task07},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := c.ListTasks(t.Context(), tt.projectID, tt.limit, tt.offset, tt.showCompleted)
+			got, err := c.ListDueTasks(t.Context(), "user01", tt.from, tt.to, tt.after, tt.limit, tt.offset)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_ListAssignedTasks(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "user02", Email: "user02@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "project02", UserID: "user01", Name: "プロジェクト2", Color: "blue", IsArchived: true, CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "project03", UserID: "user02", Name: "プロジェクト3", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+			{ID: "project04", UserID: "user02", Name: "プロジェクト4", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst)},
+		},
+		database.ProjectMembers{
+			{ProjectID: "project03", UserID: "user01", Role: domain.ProjectRoleViewer, CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", AssigneeID: new(domain.UserID("user01")), Name: "タスク1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "task02", UserID: "user01", ProjectID: "project01", AssigneeID: new(domain.UserID("user01")), Name: "タスク2", DueOn: new(plain.NewDate(2025, 1, 2)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "task03", UserID: "user02", ProjectID: "project03", AssigneeID: new(domain.UserID("user01")), Name: "タスク3", DueOn: new(plain.NewDate(2025, 1, 1)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+			{ID: "task04", UserID: "user01", ProjectID: "project01", AssigneeID: new(domain.UserID("user01")), Name: "タスク4", DueOn: new(plain.NewDate(2025, 1, 1)), CompletedAt: new(time.Date(2025, 1, 1, 0, 0, 0, 0, jst)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst)},
+			{ID: "task05", UserID: "user01", ProjectID: "project02", AssigneeID: new(domain.UserID("user01")), Name: "タスク5", DueOn: new(plain.NewDate(2025, 1, 1)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst)},
+			{ID: "task06", UserID: "user02", ProjectID: "project04", AssigneeID: new(domain.UserID("user01")), Name: "タスク6", DueOn: new(plain.NewDate(2025, 1, 1)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 6, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 6, 0, jst)},
+			{ID: "task07", UserID: "user01", ProjectID: "project01", AssigneeID: new(domain.UserID("user02")), Name: "タスク7", DueOn: new(plain.NewDate(2025, 1, 1)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 7, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 7, 0, jst)},
+		},
+		database.Steps{},
+		database.TaskTags{},
+		database.Comments{},
+	}))
+
+	task01 := domain.Task{
+		ID: "task01", UserID: "user01", ProjectID: "project01", AssigneeID: new(domain.UserID("user01")), Name: "タスク1", TagIDs: []domain.TagID{},
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst),
+		Steps: domain.Steps{},
+	}
+	task02 := domain.Task{
+		ID: "task02", UserID: "user01", ProjectID: "project01", AssigneeID: new(domain.UserID("user01")), Name: "タスク2", TagIDs: []domain.TagID{},
+		DueOn:     new(plain.NewDate(2025, 1, 2)),
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst),
+		Steps: domain.Steps{},
+	}
+	task03 := domain.Task{
+		ID: "task03", UserID: "user02", ProjectID: "project03", AssigneeID: new(domain.UserID("user01")), Name: "タスク3", TagIDs: []domain.TagID{},
+		DueOn:     new(plain.NewDate(2025, 1, 1)),
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst),
+		Steps: domain.Steps{},
+	}
+
+	tests := []struct {
+		name   string
+		after  *database.Cursor
+		limit  int
+		offset int
+		want   domain.Tasks
+	}{
+		{
+			name:  "ok",
+			limit: 10,
+			want:  domain.Tasks{task03, task02, task01},
+		},
+		{
+			name:   "pagination",
+			limit:  1,
+			offset: 1,
+			want:   domain.Tasks{task02},
+		},
+		{
+			name:  "cursor",
+			after: &database.Cursor{Key: "2025-01-02", ID: "task02"},
+			limit: 10,
+			want:  domain.Tasks{task01},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.ListAssignedTasks(t.Context(), "user01", tt.after, tt.limit, tt.offset)
 			require.NoError(t, err)
 			assert.Equal(t, tt.want, got)
 		})
@@ -277,6 +620,10 @@ func TestClient_GetTaskByID(t *testing.T) {
 		database.TaskTags{
 			{TaskID: "task01", TagID: "tag01", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
 		},
+		database.Comments{
+			{ID: "comment01", UserID: new(domain.UserID("user01")), TaskID: "task01", Content: "コメント1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "comment02", UserID: new(domain.UserID("user01")), TaskID: "task01", Content: "コメント2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
 	}))
 
 	tests := []struct {
@@ -301,6 +648,7 @@ func TestClient_GetTaskByID(t *testing.T) {
 				Steps: domain.Steps{
 					{ID: "step01", UserID: "user01", TaskID: "task01", Name: "ステップ1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
 				},
+				CommentCount: 2,
 			},
 		},
 		{
@@ -323,6 +671,52 @@ func TestClient_GetTaskByID(t *testing.T) {
 	}
 }
 
+func TestClient_ListTaskPositions(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "project02", UserID: "user01", Name: "プロジェクト2", Color: "red", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", Position: "r", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "task02", UserID: "user01", ProjectID: "project01", Name: "タスク2", Position: "9", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "task03", UserID: "user01", ProjectID: "project02", Name: "タスク3", Position: "0i", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+		database.Steps{},
+		database.TaskTags{},
+	}))
+
+	tests := []struct {
+		name      string
+		projectID domain.ProjectID
+		want      []domain.PositionEntry[domain.TaskID]
+	}{
+		{
+			name:      "multiple",
+			projectID: "project01",
+			want: []domain.PositionEntry[domain.TaskID]{
+				{ID: "task02", Position: "9"},
+				{ID: "task01", Position: "r"},
+			},
+		},
+		{
+			name:      "no_match",
+			projectID: "project99",
+			want:      []domain.PositionEntry[domain.TaskID]{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.ListTaskPositions(t.Context(), tt.projectID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestClient_UpdateTask(t *testing.T) {
 	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
 		database.Users{
@@ -330,13 +724,14 @@ func TestClient_UpdateTask(t *testing.T) {
 		},
 		database.Projects{
 			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "project02", UserID: "user01", Name: "プロジェクト2", Color: "red", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
 		},
 		database.Tags{
 			{ID: "tag01", UserID: "user01", Name: "タグ1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
 			{ID: "tag02", UserID: "user01", Name: "タグ2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
 		},
 		database.Tasks{
-			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", Position: "i", Version: 1, CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
 		},
 		database.TaskTags{
 			{TaskID: "task01", TagID: "tag01", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
@@ -344,18 +739,27 @@ func TestClient_UpdateTask(t *testing.T) {
 	}))
 
 	err := c.UpdateTask(t.Context(), &domain.Task{
-		ID:        "task01",
-		Name:      "更新後タスク",
-		Content:   "Updated content",
-		Priority:  2,
-		TagIDs:    []domain.TagID{"tag02"},
-		UpdatedAt: time.Date(2025, 2, 1, 0, 0, 0, 0, jst),
+		ID:               "task01",
+		UserID:           "user01",
+		ProjectID:        "project02",
+		Name:             "更新後タスク",
+		Content:          "Updated content",
+		Priority:         2,
+		TagIDs:           []domain.TagID{"tag02"},
+		NextOccurrenceID: new(domain.TaskID("task02")),
+		Position:         "r",
+		Version:          2,
+		UpdatedAt:        time.Date(2025, 2, 1, 0, 0, 0, 0, jst),
 	})
 	require.NoError(t, err)
 
+	// 更新前の版数が一致しない場合は、他の更新が先に行われたとみなして更新しない
+	err = c.UpdateTask(t.Context(), &domain.Task{ID: "task01", UserID: "user01", ProjectID: "project02", Name: "競合タスク", Version: 2})
+	assert.ErrorIs(t, err, database.ErrVersionConflict)
+
 	tdb.Assert(t, []any{
 		database.Tasks{
-			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "更新後タスク", Content: "Updated content", Priority: 2, CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 2, 1, 0, 0, 0, 0, jst)},
+			{ID: "task01", UserID: "user01", ProjectID: "project02", Name: "更新後タスク", Content: "Updated content", Priority: 2, NextOccurrenceID: new(domain.TaskID("task02")), Position: "r", Version: 2, CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 2, 1, 0, 0, 0, 0, jst)},
 		},
 		database.TaskTags{
 			{TaskID: "task01", TagID: "tag02", CreatedAt: time.Date(2025, 2, 1, 0, 0, 0, 0, jst)},
@@ -363,7 +767,34 @@ func TestClient_UpdateTask(t *testing.T) {
 	})
 }
 
-func TestClient_DeleteTaskByID(t *testing.T) {
+func TestClient_UpdateTaskPositions(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", Position: "i", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "task02", UserID: "user01", ProjectID: "project01", Name: "タスク2", Position: "r", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.Steps{},
+		database.TaskTags{},
+	}))
+
+	err := c.UpdateTaskPositions(t.Context(), map[domain.TaskID]string{"task02": "9"})
+	require.NoError(t, err)
+
+	tdb.Assert(t, []any{
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", Position: "i", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "task02", UserID: "user01", ProjectID: "project01", Name: "タスク2", Position: "9", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+	})
+}
+
+func TestClient_TrashTaskByID(t *testing.T) {
 	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
 		database.Users{
 			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
@@ -375,14 +806,60 @@ func TestClient_DeleteTaskByID(t *testing.T) {
 			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
 			{ID: "task02", UserID: "user01", ProjectID: "project01", Name: "タスク2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
 		},
+		database.Steps{
+			{ID: "step01", UserID: "user01", TaskID: "task01", Name: "ステップ1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "step02", UserID: "user01", TaskID: "task01", Name: "ステップ2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 1, 15, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "step03", UserID: "user01", TaskID: "task02", Name: "ステップ3", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
 	}))
 
-	err := c.DeleteTaskByID(t.Context(), "task01")
+	err := c.TrashTaskByID(t.Context(), "task01", time.Date(2025, 2, 1, 0, 0, 0, 0, jst))
 	require.NoError(t, err)
 
 	tdb.Assert(t, []any{
 		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
 			{ID: "task02", UserID: "user01", ProjectID: "project01", Name: "タスク2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
 		},
+		database.Steps{
+			{ID: "step01", UserID: "user01", TaskID: "task01", Name: "ステップ1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "step02", UserID: "user01", TaskID: "task01", Name: "ステップ2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 1, 15, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "step03", UserID: "user01", TaskID: "task02", Name: "ステップ3", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+	})
+}
+
+func TestClient_RestoreTask(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "task02", UserID: "user01", ProjectID: "project01", Name: "タスク2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+		},
+		database.Steps{
+			{ID: "step01", UserID: "user01", TaskID: "task01", Name: "ステップ1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "step02", UserID: "user01", TaskID: "task01", Name: "ステップ2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 1, 15, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "step03", UserID: "user01", TaskID: "task02", Name: "ステップ3", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+		},
+	}))
+
+	err := c.RestoreTask(t.Context(), "task01", time.Date(2025, 2, 1, 0, 0, 0, 0, jst))
+	require.NoError(t, err)
+
+	tdb.Assert(t, []any{
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "task02", UserID: "user01", ProjectID: "project01", Name: "タスク2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+		},
+		database.Steps{
+			{ID: "step01", UserID: "user01", TaskID: "task01", Name: "ステップ1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "step02", UserID: "user01", TaskID: "task01", Name: "ステップ2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 1, 15, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "step03", UserID: "user01", TaskID: "task02", Name: "ステップ3", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+		},
 	})
 }
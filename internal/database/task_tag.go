@@ -1,9 +1,11 @@
 package database
 
 import (
+	"context"
 	"time"
 
 	"github.com/minguu42/harmattan/internal/domain"
+	"gorm.io/gorm"
 )
 
 type TaskTag struct {
@@ -29,3 +31,18 @@ func (tts TaskTags) TagIDs() []domain.TagID {
 	}
 	return ids
 }
+
+// taskTags はゴミ箱に入っていないタグとの関連付けに絞り込んだクエリを返す
+func (c *Client) taskTags(ctx context.Context) *gorm.DB {
+	return c.db(ctx).Where("tag_id IN (?)", c.db(ctx).Model(Tag{}).Select("id"))
+}
+
+// taggedTaskIDs は tagIDs のいずれかのタグが付いたタスクのIDを返すサブクエリを返す
+// matchAll が true の場合は tagIDs のすべてのタグが付いたタスクのIDを返す
+func (c *Client) taggedTaskIDs(ctx context.Context, tagIDs []domain.TagID, matchAll bool) *gorm.DB {
+	sub := c.db(ctx).Model(TaskTag{}).Select("task_id").Where("tag_id IN ?", tagIDs)
+	if matchAll {
+		sub = sub.Group("task_id").Having("COUNT(*) = ?", len(tagIDs))
+	}
+	return sub
+}
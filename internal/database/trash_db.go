@@ -0,0 +1,121 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+	"gorm.io/gorm"
+)
+
+type TrashItem struct {
+	Type      domain.TrashItemType
+	ID        string
+	UserID    domain.UserID
+	ParentID  string
+	Name      string
+	DeletedAt time.Time
+}
+
+func (i *TrashItem) ToDomain() *domain.TrashItem {
+	return &domain.TrashItem{
+		ID:        i.ID,
+		UserID:    i.UserID,
+		Type:      i.Type,
+		ParentID:  i.ParentID,
+		Name:      i.Name,
+		DeletedAt: i.DeletedAt,
+	}
+}
+
+type TrashItems []TrashItem
+
+func (is TrashItems) ToDomain() domain.TrashItems {
+	items := make(domain.TrashItems, 0, len(is))
+	for _, i := range is {
+		items = append(items, *i.ToDomain())
+	}
+	return items
+}
+
+// trashColumns はゴミ箱に入れるときに更新する列を返す
+// ゴミ箱への移動は内容の更新ではないため、更新日時は変更しない
+func trashColumns(deletedAt time.Time) map[string]any {
+	return map[string]any{
+		"deleted_at": deletedAt,
+		"updated_at": gorm.Expr("updated_at"),
+	}
+}
+
+// restoreColumns はゴミ箱から復元するときに更新する列を返す
+func restoreColumns() map[string]any {
+	return map[string]any{
+		"deleted_at": nil,
+		"updated_at": gorm.Expr("updated_at"),
+	}
+}
+
+// trashItems はゴミ箱の項目を返すクエリを組み立てる
+// scope は項目の種類ごとに絞り込み条件を加える
+// 親と一緒にゴミ箱に入った項目は親を復元すると復元されるため、親がゴミ箱に入っている項目は含まない
+func (c *Client) trashItems(ctx context.Context, scope func(q *gorm.DB, typ domain.TrashItemType) *gorm.DB) *gorm.DB {
+	trashed := func(model any) *gorm.DB {
+		return c.db(ctx).Unscoped().Model(model).Where("deleted_at IS NOT NULL")
+	}
+	projects := scope(trashed(Project{}).
+		Select("'project' AS type, id, user_id, '' AS parent_id, name, deleted_at"), domain.TrashItemTypeProject)
+	tasks := scope(trashed(Task{}).
+		Select("'task' AS type, id, user_id, project_id AS parent_id, name, deleted_at").
+		Where("project_id NOT IN (?)", trashed(Project{}).Select("id")), domain.TrashItemTypeTask)
+	steps := scope(trashed(Step{}).
+		Select("'step' AS type, id, user_id, task_id AS parent_id, name, deleted_at").
+		Where("task_id NOT IN (?)", trashed(Task{}).Select("id")), domain.TrashItemTypeStep)
+	tags := scope(trashed(Tag{}).
+		Select("'tag' AS type, id, user_id, '' AS parent_id, name, deleted_at"), domain.TrashItemTypeTag)
+	return c.db(ctx).Table("(? UNION ALL ? UNION ALL ? UNION ALL ?) AS trash", projects, tasks, steps, tags)
+}
+
+// ListTrashItems はユーザが復元できるゴミ箱の項目を削除日時の降順で返す
+// プロジェクトとタグはユーザのもの、タスクとステップはユーザがオーナーまたは編集者であるプロジェクトのものを返す
+func (c *Client) ListTrashItems(ctx context.Context, userID domain.UserID, limit, offset int) (domain.TrashItems, error) {
+	var is TrashItems
+	if err := c.trashItems(ctx, func(q *gorm.DB, typ domain.TrashItemType) *gorm.DB {
+		switch typ {
+		case domain.TrashItemTypeTask:
+			return q.Where("project_id IN (?)", c.writableProjectIDs(ctx, userID))
+		case domain.TrashItemTypeStep:
+			return q.Where("task_id IN (?)", c.db(ctx).Model(Task{}).Select("id").Where("project_id IN (?)", c.writableProjectIDs(ctx, userID)))
+		default:
+			return q.Where("user_id = ?", userID)
+		}
+	}).Order("deleted_at DESC").Order("id DESC").Limit(limit).Offset(offset).Find(&is).Error; err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return is.ToDomain(), nil
+}
+
+func (c *Client) GetTrashItemByID(ctx context.Context, id string) (*domain.TrashItem, error) {
+	var i TrashItem
+	if err := c.trashItems(ctx, func(q *gorm.DB, _ domain.TrashItemType) *gorm.DB {
+		return q.Where("id = ?", id)
+	}).Take(&i).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errtrace.Wrap(ErrNotFound)
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	return i.ToDomain(), nil
+}
+
+// PurgeTrash は削除日時が before より前のゴミ箱の項目を完全に削除する
+// 子の項目は外部キーの ON DELETE CASCADE により親と一緒に削除される
+func (c *Client) PurgeTrash(ctx context.Context, before time.Time) error {
+	for _, model := range []any{Step{}, Task{}, Project{}, Tag{}} {
+		if err := c.db(ctx).Unscoped().Where("deleted_at < ?", before).Delete(model).Error; err != nil {
+			return errtrace.Wrap(err)
+		}
+	}
+	return nil
+}
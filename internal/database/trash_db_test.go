@@ -0,0 +1,190 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/database"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestClient_ListTrashItems(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "user02", Email: "user02@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "user03", Email: "user03@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+			{ID: "user04", Email: "user04@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "project02", UserID: "user01", Name: "プロジェクト2", Color: "red", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "project03", UserID: "user02", Name: "プロジェクト3", Color: "green", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+		},
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "task02", UserID: "user01", ProjectID: "project02", Name: "タスク2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 2, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "task03", UserID: "user01", ProjectID: "project02", Name: "タスク3", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+		database.Steps{
+			{ID: "step01", UserID: "user01", TaskID: "task02", Name: "ステップ1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 2, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "step02", UserID: "user01", TaskID: "task03", Name: "ステップ2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 3, 0, 0, 0, 0, jst), Valid: true}},
+		},
+		database.ProjectMembers{
+			{ProjectID: "project02", UserID: "user03", Role: domain.ProjectRoleEditor, CreatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst)},
+			{ProjectID: "project02", UserID: "user04", Role: domain.ProjectRoleViewer, CreatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst)},
+		},
+		database.Tags{
+			{ID: "tag01", UserID: "user01", Name: "タグ1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 1, 31, 0, 0, 0, 0, jst), Valid: true}},
+		},
+	}))
+
+	tests := []struct {
+		name   string
+		userID domain.UserID
+		limit  int
+		offset int
+		want   domain.TrashItems
+	}{
+		{
+			name:   "all",
+			userID: "user01",
+			limit:  10,
+			want: domain.TrashItems{
+				{ID: "step02", UserID: "user01", Type: domain.TrashItemTypeStep, ParentID: "task03", Name: "ステップ2", DeletedAt: time.Date(2025, 2, 3, 0, 0, 0, 0, jst)},
+				{ID: "task02", UserID: "user01", Type: domain.TrashItemTypeTask, ParentID: "project02", Name: "タスク2", DeletedAt: time.Date(2025, 2, 2, 0, 0, 0, 0, jst)},
+				{ID: "project01", UserID: "user01", Type: domain.TrashItemTypeProject, Name: "プロジェクト1", DeletedAt: time.Date(2025, 2, 1, 0, 0, 0, 0, jst)},
+				{ID: "tag01", UserID: "user01", Type: domain.TrashItemTypeTag, Name: "タグ1", DeletedAt: time.Date(2025, 1, 31, 0, 0, 0, 0, jst)},
+			},
+		},
+		{
+			name:   "limit_offset",
+			userID: "user01",
+			limit:  2,
+			offset: 1,
+			want: domain.TrashItems{
+				{ID: "task02", UserID: "user01", Type: domain.TrashItemTypeTask, ParentID: "project02", Name: "タスク2", DeletedAt: time.Date(2025, 2, 2, 0, 0, 0, 0, jst)},
+				{ID: "project01", UserID: "user01", Type: domain.TrashItemTypeProject, Name: "プロジェクト1", DeletedAt: time.Date(2025, 2, 1, 0, 0, 0, 0, jst)},
+			},
+		},
+		{
+			// 共有されたプロジェクトの編集者には、プロジェクトのタスクとステップのみを返す
+			name:   "editor",
+			userID: "user03",
+			limit:  10,
+			want: domain.TrashItems{
+				{ID: "step02", UserID: "user01", Type: domain.TrashItemTypeStep, ParentID: "task03", Name: "ステップ2", DeletedAt: time.Date(2025, 2, 3, 0, 0, 0, 0, jst)},
+				{ID: "task02", UserID: "user01", Type: domain.TrashItemTypeTask, ParentID: "project02", Name: "タスク2", DeletedAt: time.Date(2025, 2, 2, 0, 0, 0, 0, jst)},
+			},
+		},
+		{
+			name:   "viewer",
+			userID: "user04",
+			limit:  10,
+			want:   domain.TrashItems{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.ListTrashItems(t.Context(), tt.userID, tt.limit, tt.offset)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_GetTrashItemByID(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "project02", UserID: "user01", Name: "プロジェクト2", Color: "red", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "task02", UserID: "user01", ProjectID: "project02", Name: "タスク2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 2, 0, 0, 0, 0, jst), Valid: true}},
+		},
+	}))
+
+	tests := []struct {
+		name    string
+		id      string
+		want    *domain.TrashItem
+		wantErr error
+	}{
+		{
+			name: "found",
+			id:   "task02",
+			want: &domain.TrashItem{ID: "task02", UserID: "user01", Type: domain.TrashItemTypeTask, ParentID: "project02", Name: "タスク2", DeletedAt: time.Date(2025, 2, 2, 0, 0, 0, 0, jst)},
+		},
+		{
+			name:    "not_trashed",
+			id:      "project02",
+			wantErr: database.ErrNotFound,
+		},
+		{
+			name:    "trashed_with_parent",
+			id:      "task01",
+			wantErr: database.ErrNotFound,
+		},
+		{
+			name:    "not_found",
+			id:      "task99",
+			wantErr: database.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.GetTrashItemByID(t.Context(), tt.id)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_PurgeTrash(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project01", UserID: "user01", Name: "プロジェクト1", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 1, 1, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "project02", UserID: "user01", Name: "プロジェクト2", Color: "red", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.Tasks{
+			{ID: "task01", UserID: "user01", ProjectID: "project01", Name: "タスク1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 1, 1, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "task02", UserID: "user01", ProjectID: "project02", Name: "タスク2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "task03", UserID: "user01", ProjectID: "project02", Name: "タスク3", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+		database.Tags{
+			{ID: "tag01", UserID: "user01", Name: "タグ1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 1, 1, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "tag02", UserID: "user01", Name: "タグ2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+	}))
+
+	err := c.PurgeTrash(t.Context(), time.Date(2025, 1, 15, 0, 0, 0, 0, jst))
+	require.NoError(t, err)
+
+	tdb.Assert(t, []any{
+		database.Projects{
+			{ID: "project02", UserID: "user01", Name: "プロジェクト2", Color: "red", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.Tasks{
+			{ID: "task02", UserID: "user01", ProjectID: "project02", Name: "タスク2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, jst), Valid: true}},
+			{ID: "task03", UserID: "user01", ProjectID: "project02", Name: "タスク3", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+		database.Tags{
+			{ID: "tag02", UserID: "user01", Name: "タグ2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+	})
+}
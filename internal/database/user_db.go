@@ -12,18 +12,33 @@ import (
 )
 
 type User struct {
-	ID             string
-	Email          string
-	HashedPassword string
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	ID               string
+	Email            string
+	HashedPassword   string
+	EmailVerifiedAt  *time.Time
+	PendingEmail     string
+	TOTPSecret       string
+	TOTPEnabledAt    *time.Time
+	TOTPLastUsedStep *int64
+	TokensValidAfter *time.Time
+	Language         string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
 }
 
 func (u *User) ToDomain() *domain.User {
 	return &domain.User{
-		ID:             domain.UserID(u.ID),
-		Email:          u.Email,
-		HashedPassword: u.HashedPassword,
+		ID:               domain.UserID(u.ID),
+		Email:            u.Email,
+		HashedPassword:   u.HashedPassword,
+		EmailVerifiedAt:  u.EmailVerifiedAt,
+		PendingEmail:     u.PendingEmail,
+		TOTPSecret:       u.TOTPSecret,
+		TOTPEnabledAt:    u.TOTPEnabledAt,
+		TokensValidAfter: u.TokensValidAfter,
+		Language:         domain.Language(u.Language),
+		CreatedAt:        u.CreatedAt,
+		UpdatedAt:        u.UpdatedAt,
 	}
 }
 
@@ -64,3 +79,120 @@ func (c *Client) GetUserByEmail(ctx context.Context, email string) (*domain.User
 	}
 	return u.ToDomain(), nil
 }
+
+// UpdateUserTokensValidAfter はユーザの発行済みのIDトークンを validAfter より前に発行されたものとして無効にする
+// datetime型のカラムは小数秒を丸めて保存するため、直後に発行したIDトークンを無効にしないように秒単位に切り捨てて保存する
+func (c *Client) UpdateUserTokensValidAfter(ctx context.Context, id domain.UserID, validAfter time.Time) error {
+	if err := c.db(ctx).Model(User{}).Where("id = ?", string(id)).Updates(map[string]any{
+		"tokens_valid_after": validAfter.Truncate(time.Second),
+		"updated_at":         validAfter,
+	}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func (c *Client) UpdateUserPassword(ctx context.Context, id domain.UserID, hashedPassword string) error {
+	if err := c.db(ctx).Model(User{}).Where("id = ?", string(id)).Updates(map[string]any{
+		"hashed_password": hashedPassword,
+		"updated_at":      clock.Now(ctx),
+	}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// UpdateUserPendingEmail はユーザの変更待ちのメールアドレスを保存する
+func (c *Client) UpdateUserPendingEmail(ctx context.Context, id domain.UserID, email string) error {
+	if err := c.db(ctx).Model(User{}).Where("id = ?", string(id)).Updates(map[string]any{
+		"pending_email": email,
+		"updated_at":    clock.Now(ctx),
+	}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// UpdateUserEmail はユーザのメールアドレスを確認済みの email に変更し、変更待ちのメールアドレスを削除する
+func (c *Client) UpdateUserEmail(ctx context.Context, id domain.UserID, email string, verifiedAt time.Time) error {
+	if err := c.db(ctx).Model(User{}).Where("id = ?", string(id)).Updates(map[string]any{
+		"email":             email,
+		"pending_email":     "",
+		"email_verified_at": verifiedAt,
+		"updated_at":        verifiedAt,
+	}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// UpdateUserLanguage はユーザの言語を変更する
+// lang が空文字列の場合は言語の設定を解除する
+func (c *Client) UpdateUserLanguage(ctx context.Context, id domain.UserID, lang domain.Language) error {
+	if err := c.db(ctx).Model(User{}).Where("id = ?", string(id)).Updates(map[string]any{
+		"language":   string(lang),
+		"updated_at": clock.Now(ctx),
+	}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func (c *Client) UpdateUserEmailVerifiedAt(ctx context.Context, id domain.UserID, verifiedAt time.Time) error {
+	if err := c.db(ctx).Model(User{}).Where("id = ?", string(id)).Updates(map[string]any{
+		"email_verified_at": verifiedAt,
+		"updated_at":        verifiedAt,
+	}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// UpdateUserTOTPSecret は2段階認証の登録を開始し、確認するまで2段階認証を無効にする
+func (c *Client) UpdateUserTOTPSecret(ctx context.Context, id domain.UserID, secret string) error {
+	if err := c.db(ctx).Model(User{}).Where("id = ?", string(id)).Updates(map[string]any{
+		"totp_secret":         secret,
+		"totp_enabled_at":     nil,
+		"totp_last_used_step": nil,
+		"updated_at":          clock.Now(ctx),
+	}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// EnableUserTOTP は2段階認証を有効にし、登録の確認に使った認証コードの時間ステップ step を使用済みとして記録する
+func (c *Client) EnableUserTOTP(ctx context.Context, id domain.UserID, enabledAt time.Time, step int64) error {
+	if err := c.db(ctx).Model(User{}).Where("id = ?", string(id)).Updates(map[string]any{
+		"totp_enabled_at":     enabledAt,
+		"totp_last_used_step": step,
+		"updated_at":          enabledAt,
+	}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// UpdateUserTOTPLastUsedStep は認証コードの時間ステップ step を使用済みとして記録し、記録できたかを返す
+// 同じか以前の時間ステップの認証コードが既に使われている場合は記録せず、認証コードの再利用を防ぐ
+func (c *Client) UpdateUserTOTPLastUsedStep(ctx context.Context, id domain.UserID, step int64) (bool, error) {
+	result := c.db(ctx).Model(User{}).
+		Where("id = ? AND (totp_last_used_step IS NULL OR totp_last_used_step < ?)", string(id), step).
+		Updates(map[string]any{
+			"totp_last_used_step": step,
+			"updated_at":          gorm.Expr("updated_at"),
+		})
+	if result.Error != nil {
+		return false, errtrace.Wrap(result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// DeleteUser はユーザを削除する
+// ユーザが作成したプロジェクトやトークンなどは外部キー制約によりすべて削除され、コメントと履歴はユーザを空にして残る
+func (c *Client) DeleteUser(ctx context.Context, id domain.UserID) error {
+	if err := c.db(ctx).Where("id = ?", string(id)).Delete(User{}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
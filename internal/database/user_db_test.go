@@ -51,6 +51,8 @@ func TestClient_GetUserByID(t *testing.T) {
 				ID:             "user01",
 				Email:          "user01@dummy.invalid",
 				HashedPassword: "hashedpassword1",
+				CreatedAt:      time.Date(2025, 1, 1, 0, 0, 1, 0, jst),
+				UpdatedAt:      time.Date(2025, 1, 1, 0, 0, 1, 0, jst),
 			},
 		},
 		{
@@ -93,6 +95,8 @@ func TestClient_GetUserByEmail(t *testing.T) {
 				ID:             "user01",
 				Email:          "user01@dummy.invalid",
 				HashedPassword: "hashedpassword1",
+				CreatedAt:      time.Date(2025, 1, 1, 0, 0, 1, 0, jst),
+				UpdatedAt:      time.Date(2025, 1, 1, 0, 0, 1, 0, jst),
 			},
 		},
 		{
@@ -114,3 +118,150 @@ func TestClient_GetUserByEmail(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_UpdateUserTOTPLastUsedStep(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", TOTPSecret: "JBSWY3DPEHPK3PXP", TOTPEnabledAt: new(time.Date(2025, 1, 1, 0, 0, 1, 0, jst)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+	}))
+
+	tests := []struct {
+		name string
+		step int64
+		want bool
+	}{
+		{name: "first_use", step: 100, want: true},
+		{name: "same_step", step: 100, want: false},
+		{name: "previous_step", step: 99, want: false},
+		{name: "next_step", step: 101, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.UpdateUserTOTPLastUsedStep(t.Context(), "user01", tt.step)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	tdb.Assert(t, []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", TOTPSecret: "JBSWY3DPEHPK3PXP", TOTPEnabledAt: new(time.Date(2025, 1, 1, 0, 0, 1, 0, jst)), TOTPLastUsedStep: new(int64(101)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+	})
+}
+
+func TestClient_UpdateUserTokensValidAfter(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+	}))
+
+	// 小数秒を切り上げて保存すると、同じ秒に発行したIDトークンが無効になる
+	validAfter := time.Date(2025, 1, 1, 0, 10, 0, 600_000_000, jst)
+	require.NoError(t, c.UpdateUserTokensValidAfter(t.Context(), "user01", validAfter))
+
+	got, err := c.GetUserByID(t.Context(), "user01")
+	require.NoError(t, err)
+	assert.Equal(t, new(time.Date(2025, 1, 1, 0, 10, 0, 0, jst)), got.TokensValidAfter)
+	assert.True(t, got.AcceptsIDTokenIssuedAt(time.Date(2025, 1, 1, 0, 10, 0, 0, jst)))
+}
+
+func TestClient_UpdateUserPendingEmail(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", EmailVerifiedAt: new(time.Date(2025, 1, 1, 0, 0, 5, 0, jst)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst)},
+		},
+	}))
+
+	ctx := clock.WithFixedNow(t.Context(), time.Date(2025, 1, 1, 0, 10, 0, 0, jst))
+	require.NoError(t, c.UpdateUserPendingEmail(ctx, "user01", "new@dummy.invalid"))
+
+	// 確認が完了するまでメールアドレスは変更しない
+	tdb.Assert(t, []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", EmailVerifiedAt: new(time.Date(2025, 1, 1, 0, 0, 5, 0, jst)), PendingEmail: "new@dummy.invalid", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 10, 0, 0, jst)},
+		},
+	})
+}
+
+func TestClient_UpdateUserEmail(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", EmailVerifiedAt: new(time.Date(2025, 1, 1, 0, 0, 5, 0, jst)), PendingEmail: "new@dummy.invalid", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst)},
+			{ID: "user02", Email: "user02@dummy.invalid", HashedPassword: "pass", EmailVerifiedAt: new(time.Date(2025, 1, 1, 0, 0, 5, 0, jst)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst)},
+		},
+	}))
+
+	require.NoError(t, c.UpdateUserEmail(t.Context(), "user01", "new@dummy.invalid", time.Date(2025, 1, 1, 0, 10, 0, 0, jst)))
+
+	tdb.Assert(t, []any{
+		database.Users{
+			{ID: "user01", Email: "new@dummy.invalid", HashedPassword: "pass", EmailVerifiedAt: new(time.Date(2025, 1, 1, 0, 10, 0, 0, jst)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 10, 0, 0, jst)},
+			{ID: "user02", Email: "user02@dummy.invalid", HashedPassword: "pass", EmailVerifiedAt: new(time.Date(2025, 1, 1, 0, 0, 5, 0, jst)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 5, 0, jst)},
+		},
+	})
+}
+
+func TestClient_UpdateUserLanguage(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "user02", Email: "user02@dummy.invalid", HashedPassword: "pass", Language: "ja", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+	}))
+
+	ctx := clock.WithFixedNow(t.Context(), time.Date(2025, 1, 1, 0, 10, 0, 0, jst))
+	require.NoError(t, c.UpdateUserLanguage(ctx, "user01", domain.LanguageEnglish))
+	require.NoError(t, c.UpdateUserLanguage(ctx, "user02", ""))
+
+	tdb.Assert(t, []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", Language: "en", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 10, 0, 0, jst)},
+			{ID: "user02", Email: "user02@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 10, 0, 0, jst)},
+		},
+	})
+}
+
+func TestClient_DeleteUser(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "user02", Email: "user02@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.Tags{
+			{ID: "tag01", UserID: "user01", Name: "タグ1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "tag02", UserID: "user02", Name: "タグ2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.Projects{
+			{ID: "project02", UserID: "user02", Name: "プロジェクト2", Color: "blue", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.Tasks{
+			{ID: "task02", UserID: "user02", ProjectID: "project02", Name: "タスク2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.Comments{
+			{ID: "comment01", UserID: new(domain.UserID("user01")), TaskID: "task02", Content: "コメント1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+		database.HistoryEntries{
+			{ID: 1, UserID: new(domain.UserID("user01")), EntityType: domain.HistoryEntityTypeTask, EntityID: "task02", ProjectID: new(domain.ProjectID("project02")), TaskID: new(domain.TaskID("task02")), Action: domain.HistoryActionUpdate, Changes: database.FieldChanges{{Field: "name", Before: "タスク", After: "タスク2"}}, CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+	}))
+
+	require.NoError(t, c.DeleteUser(t.Context(), "user01"))
+
+	tdb.Assert(t, []any{
+		database.Users{
+			{ID: "user02", Email: "user02@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.Tags{
+			{ID: "tag02", UserID: "user02", Name: "タグ2", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.Comments{
+			{ID: "comment01", TaskID: "task02", Content: "コメント1", CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+		database.HistoryEntries{
+			{ID: 1, EntityType: domain.HistoryEntityTypeTask, EntityID: "task02", ProjectID: new(domain.ProjectID("project02")), TaskID: new(domain.TaskID("task02")), Action: domain.HistoryActionUpdate, Changes: database.FieldChanges{{Field: "name", Before: "タスク", After: "タスク2"}}, CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+		},
+	})
+}
@@ -0,0 +1,72 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+	"gorm.io/gorm"
+)
+
+type UserIdentity struct {
+	ID         domain.UserIdentityID
+	UserID     domain.UserID
+	Issuer     string
+	Subject    string
+	Email      string
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+}
+
+func (i *UserIdentity) ToDomain() *domain.UserIdentity {
+	return &domain.UserIdentity{
+		ID:         i.ID,
+		UserID:     i.UserID,
+		Issuer:     i.Issuer,
+		Subject:    i.Subject,
+		Email:      i.Email,
+		LastUsedAt: i.LastUsedAt,
+		CreatedAt:  i.CreatedAt,
+	}
+}
+
+type UserIdentities []UserIdentity
+
+func (c *Client) CreateUserIdentity(ctx context.Context, i *domain.UserIdentity) error {
+	if err := c.db(ctx).Create(&UserIdentity{
+		ID:         i.ID,
+		UserID:     i.UserID,
+		Issuer:     i.Issuer,
+		Subject:    i.Subject,
+		Email:      i.Email,
+		LastUsedAt: i.LastUsedAt,
+		CreatedAt:  i.CreatedAt,
+	}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func (c *Client) GetUserIdentity(ctx context.Context, issuer, subject string) (*domain.UserIdentity, error) {
+	var i UserIdentity
+	if err := c.db(ctx).Where("issuer = ? AND subject = ?", issuer, subject).Take(&i).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errtrace.Wrap(ErrNotFound)
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	return i.ToDomain(), nil
+}
+
+// UpdateUserIdentityLastUsedAt はサインインしたときにIDプロバイダが返したメールアドレスと日時を記録する
+func (c *Client) UpdateUserIdentityLastUsedAt(ctx context.Context, id domain.UserIdentityID, email string, usedAt time.Time) error {
+	if err := c.db(ctx).Model(UserIdentity{}).Where("id = ?", id).Updates(map[string]any{
+		"email":        email,
+		"last_used_at": usedAt,
+	}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/database"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateUserIdentity(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.UserIdentities{},
+	}))
+
+	err := c.CreateUserIdentity(t.Context(), &domain.UserIdentity{
+		ID:         "identity01",
+		UserID:     "user01",
+		Issuer:     "https://idp.dummy.invalid",
+		Subject:    "subject01",
+		Email:      "user01@dummy.invalid",
+		LastUsedAt: new(time.Date(2025, 1, 1, 0, 10, 0, 0, jst)),
+		CreatedAt:  time.Date(2025, 1, 1, 0, 10, 0, 0, jst),
+	})
+	require.NoError(t, err)
+
+	tdb.Assert(t, []any{
+		database.UserIdentities{
+			{ID: "identity01", UserID: "user01", Issuer: "https://idp.dummy.invalid", Subject: "subject01", Email: "user01@dummy.invalid", LastUsedAt: new(time.Date(2025, 1, 1, 0, 10, 0, 0, jst)), CreatedAt: time.Date(2025, 1, 1, 0, 10, 0, 0, jst)},
+		},
+	})
+}
+
+func TestClient_GetUserIdentity(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.UserIdentities{
+			{ID: "identity01", UserID: "user01", Issuer: "https://idp.dummy.invalid", Subject: "subject01", Email: "user01@dummy.invalid", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "identity02", UserID: "user01", Issuer: "https://another-idp.dummy.invalid", Subject: "subject01", Email: "user01@dummy.invalid", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+	}))
+
+	t.Run("ok", func(t *testing.T) {
+		got, err := c.GetUserIdentity(t.Context(), "https://another-idp.dummy.invalid", "subject01")
+		require.NoError(t, err)
+
+		want := &domain.UserIdentity{ID: "identity02", UserID: "user01", Issuer: "https://another-idp.dummy.invalid", Subject: "subject01", Email: "user01@dummy.invalid", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)}
+		assert.Equal(t, want, got)
+	})
+	t.Run("not_found", func(t *testing.T) {
+		_, err := c.GetUserIdentity(t.Context(), "https://idp.dummy.invalid", "subject02")
+		assert.ErrorIs(t, err, database.ErrNotFound)
+	})
+}
+
+func TestClient_UpdateUserIdentityLastUsedAt(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.UserIdentities{
+			{ID: "identity01", UserID: "user01", Issuer: "https://idp.dummy.invalid", Subject: "subject01", Email: "old@dummy.invalid", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+	}))
+
+	require.NoError(t, c.UpdateUserIdentityLastUsedAt(t.Context(), "identity01", "new@dummy.invalid", time.Date(2025, 1, 1, 0, 10, 0, 0, jst)))
+
+	tdb.Assert(t, []any{
+		database.UserIdentities{
+			{ID: "identity01", UserID: "user01", Issuer: "https://idp.dummy.invalid", Subject: "subject01", Email: "new@dummy.invalid", LastUsedAt: new(time.Date(2025, 1, 1, 0, 10, 0, 0, jst)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+	})
+}
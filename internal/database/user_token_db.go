@@ -0,0 +1,85 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+	"gorm.io/gorm"
+)
+
+type UserToken struct {
+	ID        domain.UserTokenID
+	UserID    domain.UserID
+	Purpose   domain.UserTokenPurpose
+	Email     string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+func (t *UserToken) ToDomain() *domain.UserToken {
+	return &domain.UserToken{
+		ID:        t.ID,
+		UserID:    t.UserID,
+		Purpose:   t.Purpose,
+		Email:     t.Email,
+		TokenHash: t.TokenHash,
+		ExpiresAt: t.ExpiresAt,
+		UsedAt:    t.UsedAt,
+		CreatedAt: t.CreatedAt,
+	}
+}
+
+type UserTokens []UserToken
+
+func (c *Client) CreateUserToken(ctx context.Context, t *domain.UserToken) error {
+	if err := c.db(ctx).Create(&UserToken{
+		ID:        t.ID,
+		UserID:    t.UserID,
+		Purpose:   t.Purpose,
+		Email:     t.Email,
+		TokenHash: t.TokenHash,
+		ExpiresAt: t.ExpiresAt,
+		CreatedAt: t.CreatedAt,
+	}).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func (c *Client) GetUserTokenByHash(ctx context.Context, purpose domain.UserTokenPurpose, tokenHash string) (*domain.UserToken, error) {
+	var t UserToken
+	if err := c.db(ctx).Where("purpose = ? AND token_hash = ?", purpose, tokenHash).Take(&t).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errtrace.Wrap(ErrNotFound)
+		}
+		return nil, errtrace.Wrap(err)
+	}
+	return t.ToDomain(), nil
+}
+
+// MarkUserTokenUsed は未使用のトークンを使用済みにし、使用済みにできたかを返す
+// 同じトークンで同時にリクエストされた場合も、使用済みにできるのはいずれか1つのリクエストのみである
+func (c *Client) MarkUserTokenUsed(ctx context.Context, id domain.UserTokenID, usedAt time.Time) (bool, error) {
+	result := c.db(ctx).Model(UserToken{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", usedAt)
+	if result.Error != nil {
+		return false, errtrace.Wrap(result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// InvalidateUserTokens はユーザの用途が purpose のトークンのうち未使用のものをすべて使用済みにする
+func (c *Client) InvalidateUserTokens(ctx context.Context, userID domain.UserID, purpose domain.UserTokenPurpose, usedAt time.Time) error {
+	if err := c.db(ctx).Model(UserToken{}).
+		Where("user_id = ? AND purpose = ? AND used_at IS NULL", userID, purpose).
+		Update("used_at", usedAt).Error; err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/database"
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetUserTokenByHash(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.UserTokens{
+			{ID: "token01", UserID: "user01", Purpose: domain.UserTokenPurposePasswordReset, Email: "user01@dummy.invalid", TokenHash: "hash01", ExpiresAt: time.Date(2025, 1, 1, 1, 0, 1, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+	}))
+
+	tests := []struct {
+		name      string
+		purpose   domain.UserTokenPurpose
+		tokenHash string
+		want      *domain.UserToken
+		wantErr   error
+	}{
+		{
+			name:      "found",
+			purpose:   domain.UserTokenPurposePasswordReset,
+			tokenHash: "hash01",
+			want: &domain.UserToken{
+				ID:        "token01",
+				UserID:    "user01",
+				Purpose:   domain.UserTokenPurposePasswordReset,
+				Email:     "user01@dummy.invalid",
+				TokenHash: "hash01",
+				ExpiresAt: time.Date(2025, 1, 1, 1, 0, 1, 0, jst),
+				CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst),
+			},
+		},
+		{
+			name:      "other_purpose",
+			purpose:   domain.UserTokenPurposeEmailVerification,
+			tokenHash: "hash01",
+			wantErr:   database.ErrNotFound,
+		},
+		{
+			name:      "not_found",
+			purpose:   domain.UserTokenPurposePasswordReset,
+			tokenHash: "hash99",
+			wantErr:   database.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.GetUserTokenByHash(t.Context(), tt.purpose, tt.tokenHash)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_MarkUserTokenUsed(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+		},
+		database.UserTokens{
+			{ID: "token01", UserID: "user01", Purpose: domain.UserTokenPurposePasswordReset, Email: "user01@dummy.invalid", TokenHash: "hash01", ExpiresAt: time.Date(2025, 1, 1, 1, 0, 1, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "token02", UserID: "user01", Purpose: domain.UserTokenPurposePasswordReset, Email: "user01@dummy.invalid", TokenHash: "hash02", ExpiresAt: time.Date(2025, 1, 1, 1, 0, 2, 0, jst), UsedAt: new(time.Date(2025, 1, 1, 0, 5, 0, 0, jst)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+	}))
+
+	tests := []struct {
+		name string
+		id   domain.UserTokenID
+		want bool
+	}{
+		{name: "unused", id: "token01", want: true},
+		{name: "used_twice", id: "token01", want: false},
+		{name: "already_used", id: "token02", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.MarkUserTokenUsed(t.Context(), tt.id, time.Date(2025, 1, 1, 0, 10, 0, 0, jst))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_InvalidateUserTokens(t *testing.T) {
+	require.NoError(t, tdb.TruncateAndInsert(t.Context(), []any{
+		database.Users{
+			{ID: "user01", Email: "user01@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "user02", Email: "user02@dummy.invalid", HashedPassword: "pass", CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+		},
+		database.UserTokens{
+			{ID: "token01", UserID: "user01", Purpose: domain.UserTokenPurposePasswordReset, Email: "user01@dummy.invalid", TokenHash: "hash01", ExpiresAt: time.Date(2025, 1, 1, 1, 0, 1, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "token02", UserID: "user01", Purpose: domain.UserTokenPurposePasswordReset, Email: "user01@dummy.invalid", TokenHash: "hash02", ExpiresAt: time.Date(2025, 1, 1, 1, 0, 2, 0, jst), UsedAt: new(time.Date(2025, 1, 1, 0, 5, 0, 0, jst)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "token03", UserID: "user01", Purpose: domain.UserTokenPurposeEmailVerification, Email: "user01@dummy.invalid", TokenHash: "hash03", ExpiresAt: time.Date(2025, 1, 2, 0, 0, 3, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+			{ID: "token04", UserID: "user02", Purpose: domain.UserTokenPurposePasswordReset, Email: "user02@dummy.invalid", TokenHash: "hash04", ExpiresAt: time.Date(2025, 1, 1, 1, 0, 4, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst)},
+		},
+	}))
+
+	usedAt := time.Date(2025, 1, 1, 0, 10, 0, 0, jst)
+	require.NoError(t, c.InvalidateUserTokens(t.Context(), "user01", domain.UserTokenPurposePasswordReset, usedAt))
+
+	tdb.Assert(t, []any{
+		database.UserTokens{
+			{ID: "token01", UserID: "user01", Purpose: domain.UserTokenPurposePasswordReset, Email: "user01@dummy.invalid", TokenHash: "hash01", ExpiresAt: time.Date(2025, 1, 1, 1, 0, 1, 0, jst), UsedAt: &usedAt, CreatedAt: time.Date(2025, 1, 1, 0, 0, 1, 0, jst)},
+			{ID: "token02", UserID: "user01", Purpose: domain.UserTokenPurposePasswordReset, Email: "user01@dummy.invalid", TokenHash: "hash02", ExpiresAt: time.Date(2025, 1, 1, 1, 0, 2, 0, jst), UsedAt: new(time.Date(2025, 1, 1, 0, 5, 0, 0, jst)), CreatedAt: time.Date(2025, 1, 1, 0, 0, 2, 0, jst)},
+			{ID: "token03", UserID: "user01", Purpose: domain.UserTokenPurposeEmailVerification, Email: "user01@dummy.invalid", TokenHash: "hash03", ExpiresAt: time.Date(2025, 1, 2, 0, 0, 3, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 0, 3, 0, jst)},
+			{ID: "token04", UserID: "user02", Purpose: domain.UserTokenPurposePasswordReset, Email: "user02@dummy.invalid", TokenHash: "hash04", ExpiresAt: time.Date(2025, 1, 1, 1, 0, 4, 0, jst), CreatedAt: time.Date(2025, 1, 1, 0, 0, 4, 0, jst)},
+		},
+	})
+}
@@ -0,0 +1,20 @@
+package domain
+
+import "time"
+
+// MaxCommentsPerTask は1タスクに作成できるコメント数の上限
+const MaxCommentsPerTask = 100
+
+type CommentID string
+
+type Comment struct {
+	ID CommentID
+	// UserID はコメントしたユーザのIDであり、ユーザが退会した場合は nil である
+	UserID    *UserID
+	TaskID    TaskID
+	Content   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type Comments []Comment
@@ -0,0 +1,256 @@
+package domain
+
+import (
+	"reflect"
+	"slices"
+	"time"
+)
+
+type HistoryEntityType string
+
+const (
+	HistoryEntityTypeProject HistoryEntityType = "project"
+	HistoryEntityTypeTask    HistoryEntityType = "task"
+	HistoryEntityTypeStep    HistoryEntityType = "step"
+	HistoryEntityTypeTag     HistoryEntityType = "tag"
+)
+
+type HistoryAction string
+
+const (
+	HistoryActionCreate  HistoryAction = "create"
+	HistoryActionUpdate  HistoryAction = "update"
+	HistoryActionDelete  HistoryAction = "delete"
+	HistoryActionRestore HistoryAction = "restore"
+)
+
+// FieldChange はフィールドの変更前と変更後の値である
+// 作成時の変更前の値と削除時の変更後の値は nil になる
+type FieldChange struct {
+	Field  string
+	Before any
+	After  any
+}
+
+// HistoryEntry はプロジェクト・タスク・ステップ・タグに対する操作の履歴である
+// ProjectID と TaskID は履歴を絞り込むためのものであり、操作した要素が属するプロジェクトとタスクを表す
+// ステップの履歴はタスクのみ、タグの履歴はどちらも持たない
+type HistoryEntry struct {
+	ID uint64
+	// UserID は操作したユーザのIDであり、ユーザが退会した場合は nil である
+	UserID     *UserID
+	EntityType HistoryEntityType
+	EntityID   string
+	ProjectID  *ProjectID
+	TaskID     *TaskID
+	Action     HistoryAction
+	Changes    []FieldChange
+	CreatedAt  time.Time
+}
+
+type HistoryEntries []HistoryEntry
+
+type fieldValue struct {
+	field string
+	value any
+}
+
+// NewProjectHistoryEntry は before から after への変更の履歴を返す
+func NewProjectHistoryEntry(userID UserID, before, after *Project, at time.Time) *HistoryEntry {
+	p := firstNonNil(after, before)
+	e := newHistoryEntry(userID, HistoryEntityTypeProject, string(p.ID), projectFields(before), projectFields(after), at)
+	if e != nil {
+		e.ProjectID = &p.ID
+	}
+	return e
+}
+
+// NewTaskHistoryEntry は before から after への変更の履歴を返す
+func NewTaskHistoryEntry(userID UserID, before, after *Task, at time.Time) *HistoryEntry {
+	t := firstNonNil(after, before)
+	e := newHistoryEntry(userID, HistoryEntityTypeTask, string(t.ID), taskFields(before), taskFields(after), at)
+	if e != nil {
+		e.ProjectID = &t.ProjectID
+		e.TaskID = &t.ID
+	}
+	return e
+}
+
+// NewStepHistoryEntry は before から after への変更の履歴を返す
+func NewStepHistoryEntry(userID UserID, before, after *Step, at time.Time) *HistoryEntry {
+	s := firstNonNil(after, before)
+	e := newHistoryEntry(userID, HistoryEntityTypeStep, string(s.ID), stepFields(before), stepFields(after), at)
+	if e != nil {
+		e.TaskID = &s.TaskID
+	}
+	return e
+}
+
+// NewTagHistoryEntry は before から after への変更の履歴を返す
+func NewTagHistoryEntry(userID UserID, before, after *Tag, at time.Time) *HistoryEntry {
+	t := firstNonNil(after, before)
+	return newHistoryEntry(userID, HistoryEntityTypeTag, string(t.ID), tagFields(before), tagFields(after), at)
+}
+
+// NewRestoreHistoryEntry はゴミ箱から item を復元した履歴を返す
+func NewRestoreHistoryEntry(userID UserID, item *TrashItem, at time.Time) *HistoryEntry {
+	e := &HistoryEntry{
+		UserID:    &userID,
+		EntityID:  item.ID,
+		Action:    HistoryActionRestore,
+		Changes:   []FieldChange{},
+		CreatedAt: at,
+	}
+	switch item.Type {
+	case TrashItemTypeProject:
+		e.EntityType = HistoryEntityTypeProject
+		e.ProjectID = new(ProjectID(item.ID))
+	case TrashItemTypeTask:
+		e.EntityType = HistoryEntityTypeTask
+		e.ProjectID = new(ProjectID(item.ParentID))
+		e.TaskID = new(TaskID(item.ID))
+	case TrashItemTypeStep:
+		e.EntityType = HistoryEntityTypeStep
+		e.TaskID = new(TaskID(item.ParentID))
+	case TrashItemTypeTag:
+		e.EntityType = HistoryEntityTypeTag
+	}
+	return e
+}
+
+func firstNonNil[T any](a, b *T) *T {
+	if a != nil {
+		return a
+	}
+	return b
+}
+
+// newHistoryEntry は before から after への変更の履歴を返す
+// before が nil の場合は作成、after が nil の場合は削除の履歴になり、更新で値が変わっていない場合は nil を返す
+func newHistoryEntry(userID UserID, entityType HistoryEntityType, entityID string, before, after []fieldValue, at time.Time) *HistoryEntry {
+	action := HistoryActionUpdate
+	switch {
+	case before == nil:
+		action = HistoryActionCreate
+	case after == nil:
+		action = HistoryActionDelete
+	}
+
+	changes := diffFields(before, after)
+	if action == HistoryActionUpdate && len(changes) == 0 {
+		return nil
+	}
+	return &HistoryEntry{
+		UserID:     &userID,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		Changes:    changes,
+		CreatedAt:  at,
+	}
+}
+
+// diffFields は値が異なるフィールドの変更を返す
+// before と after は同じ順序で同じフィールドを持つか、どちらかが nil である
+func diffFields(before, after []fieldValue) []FieldChange {
+	n := max(len(before), len(after))
+	changes := make([]FieldChange, 0, n)
+	for i := range n {
+		var c FieldChange
+		if before != nil {
+			c.Field, c.Before = before[i].field, before[i].value
+		}
+		if after != nil {
+			c.Field, c.After = after[i].field, after[i].value
+		}
+		if equalFieldValues(c.Before, c.After) {
+			continue
+		}
+		changes = append(changes, c)
+	}
+	return changes
+}
+
+// equalFieldValues は a と b が等しいかを返す
+// 日時はタイムゾーンによらず同じ時刻であれば等しいとみなす
+func equalFieldValues(a, b any) bool {
+	ta, ok := a.(time.Time)
+	tb, ok2 := b.(time.Time)
+	if ok && ok2 {
+		return ta.Equal(tb)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func projectFields(p *Project) []fieldValue {
+	if p == nil {
+		return nil
+	}
+	return []fieldValue{
+		{field: "name", value: p.Name},
+		{field: "color", value: string(p.Color)},
+		{field: "is_archived", value: p.IsArchived},
+		{field: "position", value: p.Position},
+	}
+}
+
+func taskFields(t *Task) []fieldValue {
+	if t == nil {
+		return nil
+	}
+	tagIDs := make([]string, 0, len(t.TagIDs))
+	for _, id := range t.TagIDs {
+		tagIDs = append(tagIDs, string(id))
+	}
+	slices.Sort(tagIDs)
+
+	var assigneeID, dueOn, recurrence, completedAt any
+	if t.AssigneeID != nil {
+		assigneeID = string(*t.AssigneeID)
+	}
+	if t.DueOn != nil {
+		dueOn = t.DueOn.String()
+	}
+	if t.Recurrence != nil {
+		recurrence = string(*t.Recurrence)
+	}
+	if t.CompletedAt != nil {
+		completedAt = *t.CompletedAt
+	}
+	return []fieldValue{
+		{field: "project_id", value: string(t.ProjectID)},
+		{field: "assignee_id", value: assigneeID},
+		{field: "name", value: t.Name},
+		{field: "tag_ids", value: tagIDs},
+		{field: "content", value: t.Content},
+		{field: "priority", value: t.Priority},
+		{field: "due_on", value: dueOn},
+		{field: "recurrence", value: recurrence},
+		{field: "completed_at", value: completedAt},
+		{field: "position", value: t.Position},
+	}
+}
+
+func stepFields(s *Step) []fieldValue {
+	if s == nil {
+		return nil
+	}
+	var completedAt any
+	if s.CompletedAt != nil {
+		completedAt = *s.CompletedAt
+	}
+	return []fieldValue{
+		{field: "name", value: s.Name},
+		{field: "completed_at", value: completedAt},
+		{field: "position", value: s.Position},
+	}
+}
+
+func tagFields(t *Tag) []fieldValue {
+	if t == nil {
+		return nil
+	}
+	return []fieldValue{
+		{field: "name", value: t.Name},
+	}
+}
@@ -0,0 +1,228 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/plain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTaskHistoryEntry(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	task := domain.Task{
+		ID:        "task01",
+		UserID:    "user01",
+		ProjectID: "project01",
+		Name:      "タスク1",
+		TagIDs:    []domain.TagID{"tag02", "tag01"},
+		Priority:  1,
+		Position:  "a0",
+	}
+	updated := task
+	updated.Priority = 3
+	updated.DueOn = new(plain.NewDate(2025, 1, 10))
+	updated.CompletedAt = new(time.Date(2025, 1, 2, 9, 0, 0, 0, time.FixedZone("JST", 9*60*60)))
+	sameInstant := updated
+	sameInstant.CompletedAt = new(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC))
+	moved := task
+	moved.Position = "Zz"
+
+	tests := []struct {
+		name   string
+		before *domain.Task
+		after  *domain.Task
+		want   *domain.HistoryEntry
+	}{
+		{
+			name:  "create",
+			after: &task,
+			want: &domain.HistoryEntry{
+				UserID:     new(domain.UserID("user01")),
+				EntityType: domain.HistoryEntityTypeTask,
+				EntityID:   "task01",
+				ProjectID:  new(domain.ProjectID("project01")),
+				TaskID:     new(domain.TaskID("task01")),
+				Action:     domain.HistoryActionCreate,
+				Changes: []domain.FieldChange{
+					{Field: "project_id", After: "project01"},
+					{Field: "name", After: "タスク1"},
+					{Field: "tag_ids", After: []string{"tag01", "tag02"}},
+					{Field: "content", After: ""},
+					{Field: "priority", After: 1},
+					{Field: "position", After: "a0"},
+				},
+				CreatedAt: at,
+			},
+		},
+		{
+			name:   "update",
+			before: &task,
+			after:  &updated,
+			want: &domain.HistoryEntry{
+				UserID:     new(domain.UserID("user01")),
+				EntityType: domain.HistoryEntityTypeTask,
+				EntityID:   "task01",
+				ProjectID:  new(domain.ProjectID("project01")),
+				TaskID:     new(domain.TaskID("task01")),
+				Action:     domain.HistoryActionUpdate,
+				Changes: []domain.FieldChange{
+					{Field: "priority", Before: 1, After: 3},
+					{Field: "due_on", After: "2025-01-10"},
+					{Field: "completed_at", After: *updated.CompletedAt},
+				},
+				CreatedAt: at,
+			},
+		},
+		{
+			name:   "no_changes",
+			before: &task,
+			after:  &task,
+		},
+		{
+			name:   "move",
+			before: &task,
+			after:  &moved,
+			want: &domain.HistoryEntry{
+				UserID:     new(domain.UserID("user01")),
+				EntityType: domain.HistoryEntityTypeTask,
+				EntityID:   "task01",
+				ProjectID:  new(domain.ProjectID("project01")),
+				TaskID:     new(domain.TaskID("task01")),
+				Action:     domain.HistoryActionUpdate,
+				Changes: []domain.FieldChange{
+					{Field: "position", Before: "a0", After: "Zz"},
+				},
+				CreatedAt: at,
+			},
+		},
+		{
+			name:   "same_instant_in_other_time_zone",
+			before: &updated,
+			after:  &sameInstant,
+		},
+		{
+			name:   "delete",
+			before: &updated,
+			want: &domain.HistoryEntry{
+				UserID:     new(domain.UserID("user01")),
+				EntityType: domain.HistoryEntityTypeTask,
+				EntityID:   "task01",
+				ProjectID:  new(domain.ProjectID("project01")),
+				TaskID:     new(domain.TaskID("task01")),
+				Action:     domain.HistoryActionDelete,
+				Changes: []domain.FieldChange{
+					{Field: "project_id", Before: "project01"},
+					{Field: "name", Before: "タスク1"},
+					{Field: "tag_ids", Before: []string{"tag01", "tag02"}},
+					{Field: "content", Before: ""},
+					{Field: "priority", Before: 3},
+					{Field: "due_on", Before: "2025-01-10"},
+					{Field: "completed_at", Before: *updated.CompletedAt},
+					{Field: "position", Before: "a0"},
+				},
+				CreatedAt: at,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, domain.NewTaskHistoryEntry("user01", tt.before, tt.after, at))
+		})
+	}
+}
+
+func TestNewStepHistoryEntry(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	before := domain.Step{ID: "step01", UserID: "user01", TaskID: "task01", Name: "ステップ1"}
+	after := before
+	after.Name = "ステップ2"
+
+	assert.Equal(t, &domain.HistoryEntry{
+		UserID:     new(domain.UserID("user01")),
+		EntityType: domain.HistoryEntityTypeStep,
+		EntityID:   "step01",
+		TaskID:     new(domain.TaskID("task01")),
+		Action:     domain.HistoryActionUpdate,
+		Changes:    []domain.FieldChange{{Field: "name", Before: "ステップ1", After: "ステップ2"}},
+		CreatedAt:  at,
+	}, domain.NewStepHistoryEntry("user01", &before, &after, at))
+}
+
+func TestNewRestoreHistoryEntry(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		item domain.TrashItem
+		want *domain.HistoryEntry
+	}{
+		{
+			name: "project",
+			item: domain.TrashItem{ID: "project01", UserID: "user01", Type: domain.TrashItemTypeProject},
+			want: &domain.HistoryEntry{
+				UserID:     new(domain.UserID("user01")),
+				EntityType: domain.HistoryEntityTypeProject,
+				EntityID:   "project01",
+				ProjectID:  new(domain.ProjectID("project01")),
+				Action:     domain.HistoryActionRestore,
+				Changes:    []domain.FieldChange{},
+				CreatedAt:  at,
+			},
+		},
+		{
+			name: "task",
+			item: domain.TrashItem{ID: "task01", UserID: "user01", Type: domain.TrashItemTypeTask, ParentID: "project01"},
+			want: &domain.HistoryEntry{
+				UserID:     new(domain.UserID("user01")),
+				EntityType: domain.HistoryEntityTypeTask,
+				EntityID:   "task01",
+				ProjectID:  new(domain.ProjectID("project01")),
+				TaskID:     new(domain.TaskID("task01")),
+				Action:     domain.HistoryActionRestore,
+				Changes:    []domain.FieldChange{},
+				CreatedAt:  at,
+			},
+		},
+		{
+			name: "step",
+			item: domain.TrashItem{ID: "step01", UserID: "user01", Type: domain.TrashItemTypeStep, ParentID: "task01"},
+			want: &domain.HistoryEntry{
+				UserID:     new(domain.UserID("user01")),
+				EntityType: domain.HistoryEntityTypeStep,
+				EntityID:   "step01",
+				TaskID:     new(domain.TaskID("task01")),
+				Action:     domain.HistoryActionRestore,
+				Changes:    []domain.FieldChange{},
+				CreatedAt:  at,
+			},
+		},
+		{
+			name: "tag",
+			item: domain.TrashItem{ID: "tag01", UserID: "user01", Type: domain.TrashItemTypeTag},
+			want: &domain.HistoryEntry{
+				UserID:     new(domain.UserID("user01")),
+				EntityType: domain.HistoryEntityTypeTag,
+				EntityID:   "tag01",
+				Action:     domain.HistoryActionRestore,
+				Changes:    []domain.FieldChange{},
+				CreatedAt:  at,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, domain.NewRestoreHistoryEntry("user01", &tt.item, at))
+		})
+	}
+}
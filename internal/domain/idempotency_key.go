@@ -0,0 +1,21 @@
+package domain
+
+import "time"
+
+// IdempotencyKey は Idempotency-Key ヘッダを指定したリクエストの処理結果であり、同じキーで再送されたリクエストに最初のレスポンスを返すために保存する
+// キーはユーザごとに一意であり、他のユーザのレスポンスを返すことはない
+type IdempotencyKey struct {
+	UserID UserID
+	Key    string
+	// RequestHash はリクエストのメソッド、パス、ボディのハッシュ値であり、同じキーを異なるリクエストに使い回していないかの確認に使う
+	RequestHash string
+	// Response は処理を完了したリクエストのレスポンスボディであり、処理中の場合は nil である
+	Response  []byte
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+// IsCompleted はキーを指定したリクエストの処理が完了しているかを返す
+func (k *IdempotencyKey) IsCompleted() bool {
+	return k.Response != nil
+}
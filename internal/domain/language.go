@@ -0,0 +1,15 @@
+package domain
+
+// Language はメッセージの言語を表すISO 639-1の言語コードである
+type Language string
+
+const (
+	LanguageJapanese Language = "ja"
+	LanguageEnglish  Language = "en"
+)
+
+// DefaultLanguage はユーザの設定やリクエストから言語を決められない場合に使う言語である
+const DefaultLanguage = LanguageJapanese
+
+// SupportedLanguages はメッセージを提供する言語であり、優先して使う順に並んでいる
+var SupportedLanguages = []Language{LanguageJapanese, LanguageEnglish}
@@ -0,0 +1,69 @@
+package domain
+
+import "time"
+
+// MaxMembersPerProject は1プロジェクトに参加できるメンバー数の上限であり、招待中のものも含む
+const MaxMembersPerProject = 50
+
+// ProjectRole はプロジェクトにおけるユーザのロール
+// プロジェクトを作成したユーザがオーナーとなり、それ以外のユーザは招待によってエディターかビューアーとして参加する
+type ProjectRole string
+
+const (
+	ProjectRoleOwner  ProjectRole = "owner"
+	ProjectRoleEditor ProjectRole = "editor"
+	ProjectRoleViewer ProjectRole = "viewer"
+)
+
+// Permission はプロジェクトに対する操作の種類
+type Permission string
+
+const (
+	// PermissionRead はプロジェクトとそのタスク・ステップ・コメント・変更履歴の閲覧
+	PermissionRead Permission = "read"
+	// PermissionWrite はタスク・ステップ・コメントの作成・更新・削除
+	PermissionWrite Permission = "write"
+	// PermissionManage はプロジェクト自体の更新・削除とメンバーの管理
+	PermissionManage Permission = "manage"
+)
+
+// Allows はロール r が操作 p を行えるかを返す
+func (r ProjectRole) Allows(p Permission) bool {
+	switch r {
+	case ProjectRoleOwner:
+		return true
+	case ProjectRoleEditor:
+		return p == PermissionRead || p == PermissionWrite
+	case ProjectRoleViewer:
+		return p == PermissionRead
+	default:
+		return false
+	}
+}
+
+type ProjectMember struct {
+	ProjectID ProjectID
+	UserID    UserID
+	Email     string
+	Role      ProjectRole
+	Position  string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type ProjectMembers []ProjectMember
+
+type ProjectInvitationID string
+
+// ProjectInvitation はメールアドレスを指定したプロジェクトへの招待であり、招待されたユーザが承諾するとメンバーになる
+type ProjectInvitation struct {
+	ID          ProjectInvitationID
+	ProjectID   ProjectID
+	ProjectName string
+	Email       string
+	Role        ProjectRole
+	InvitedBy   UserID
+	CreatedAt   time.Time
+}
+
+type ProjectInvitations []ProjectInvitation
@@ -0,0 +1,88 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectRole_Allows(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		role domain.ProjectRole
+		want map[domain.Permission]bool
+	}{
+		{
+			name: "owner",
+			role: domain.ProjectRoleOwner,
+			want: map[domain.Permission]bool{domain.PermissionRead: true, domain.PermissionWrite: true, domain.PermissionManage: true},
+		},
+		{
+			name: "editor",
+			role: domain.ProjectRoleEditor,
+			want: map[domain.Permission]bool{domain.PermissionRead: true, domain.PermissionWrite: true, domain.PermissionManage: false},
+		},
+		{
+			name: "viewer",
+			role: domain.ProjectRoleViewer,
+			want: map[domain.Permission]bool{domain.PermissionRead: true, domain.PermissionWrite: false, domain.PermissionManage: false},
+		},
+		{
+			name: "no_role",
+			role: "",
+			want: map[domain.Permission]bool{domain.PermissionRead: false, domain.PermissionWrite: false, domain.PermissionManage: false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			for perm, want := range tt.want {
+				assert.Equal(t, want, tt.role.Allows(perm), perm)
+			}
+		})
+	}
+}
+
+func TestUser_RoleIn(t *testing.T) {
+	t.Parallel()
+
+	p := &domain.Project{ID: "project01", UserID: "user01"}
+	tests := []struct {
+		name   string
+		user   *domain.User
+		member *domain.ProjectMember
+		want   domain.ProjectRole
+	}{
+		{
+			name: "owner",
+			user: &domain.User{ID: "user01"},
+			want: domain.ProjectRoleOwner,
+		},
+		{
+			name:   "member",
+			user:   &domain.User{ID: "user02"},
+			member: &domain.ProjectMember{ProjectID: "project01", UserID: "user02", Role: domain.ProjectRoleViewer},
+			want:   domain.ProjectRoleViewer,
+		},
+		{
+			name:   "member_of_other_project",
+			user:   &domain.User{ID: "user02"},
+			member: &domain.ProjectMember{ProjectID: "project02", UserID: "user02", Role: domain.ProjectRoleEditor},
+		},
+		{
+			name: "not_member",
+			user: &domain.User{ID: "user02"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.user.RoleIn(p, tt.member))
+		})
+	}
+}
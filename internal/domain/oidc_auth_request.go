@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+type OIDCAuthRequestID string
+
+// OIDCAuthRequest は外部のIDプロバイダへの認可リクエストであり、コールバックで受け取った state から nonce とコード検証子を引き当てるために保存する
+// state はハッシュ値を保存し、nonce とコード検証子はIDプロバイダとのやり取りに使うため平文で保存する
+type OIDCAuthRequest struct {
+	ID           OIDCAuthRequestID
+	StateHash    string
+	Nonce        string
+	CodeVerifier string
+	ExpiresAt    time.Time
+	UsedAt       *time.Time
+	CreatedAt    time.Time
+}
+
+// IsValid は認可リクエストが now の時点で未使用かつ有効期限内かを返す
+func (r *OIDCAuthRequest) IsValid(now time.Time) bool {
+	return r.UsedAt == nil && now.Before(r.ExpiresAt)
+}
@@ -0,0 +1,44 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOIDCAuthRequest_IsValid(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 0, 10, 0, 0, time.UTC)
+	usedAt := time.Date(2025, 1, 1, 0, 5, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		request *domain.OIDCAuthRequest
+		want    bool
+	}{
+		{
+			name:    "valid",
+			request: &domain.OIDCAuthRequest{ExpiresAt: now.Add(time.Second)},
+			want:    true,
+		},
+		{
+			name:    "used",
+			request: &domain.OIDCAuthRequest{ExpiresAt: now.Add(time.Second), UsedAt: &usedAt},
+			want:    false,
+		},
+		{
+			name:    "expired",
+			request: &domain.OIDCAuthRequest{ExpiresAt: now},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.request.IsValid(now))
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// MaxPersonalAccessTokensPerUser は1ユーザが作成できるパーソナルアクセストークン数の上限
+const MaxPersonalAccessTokensPerUser = 20
+
+type PersonalAccessTokenID string
+
+// Scope はパーソナルアクセストークンで実行できる操作の範囲であり、「リソース:read」か「リソース:write」の形式で表す
+type Scope string
+
+const (
+	ScopeProjectsRead  Scope = "projects:read"
+	ScopeProjectsWrite Scope = "projects:write"
+	ScopeTasksRead     Scope = "tasks:read"
+	ScopeTasksWrite    Scope = "tasks:write"
+	ScopeTagsRead      Scope = "tags:read"
+	ScopeTagsWrite     Scope = "tags:write"
+)
+
+// Scopes はパーソナルアクセストークンに付与できるすべてのスコープである
+var Scopes = []Scope{
+	ScopeProjectsRead,
+	ScopeProjectsWrite,
+	ScopeTasksRead,
+	ScopeTasksWrite,
+	ScopeTagsRead,
+	ScopeTagsWrite,
+}
+
+func (s Scope) IsValid() bool {
+	return slices.Contains(Scopes, s)
+}
+
+// PersonalAccessToken はスクリプトや外部のツールからAPIを利用するための有効期限の長いトークンであり、データベースには平文ではなくハッシュ値を保存する
+// IDトークンと異なり、付与されたスコープの操作のみ実行できる
+type PersonalAccessToken struct {
+	ID        PersonalAccessTokenID
+	UserID    UserID
+	Name      string
+	TokenHash string
+	Scopes    []Scope
+	// ExpiresAt が nil の場合は削除するまで有効である
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+}
+
+type PersonalAccessTokens []PersonalAccessToken
+
+// ShouldRecordUse は now の時点の利用を最終利用日時として記録するかを返す
+// リクエストのたびに書き込まないように、最終利用日時の記録は1分に1回までとする
+func (t *PersonalAccessToken) ShouldRecordUse(now time.Time) bool {
+	return t.LastUsedAt == nil || now.Sub(*t.LastUsedAt) >= time.Minute
+}
+
+// IsExpired はトークンが now の時点で有効期限切れかを返す
+func (t *PersonalAccessToken) IsExpired(now time.Time) bool {
+	return t.ExpiresAt != nil && !now.Before(*t.ExpiresAt)
+}
+
+// HasScopes はトークンが scopes のすべてを持つかを返す
+// 書き込みのスコープは同じリソースの読み取りのスコープを含む
+func (t *PersonalAccessToken) HasScopes(scopes ...Scope) bool {
+	for _, s := range scopes {
+		if slices.Contains(t.Scopes, s) {
+			continue
+		}
+		if resource, ok := strings.CutSuffix(string(s), ":read"); ok && slices.Contains(t.Scopes, Scope(resource+":write")) {
+			continue
+		}
+		return false
+	}
+	return true
+}
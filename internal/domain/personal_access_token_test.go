@@ -0,0 +1,102 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPersonalAccessToken_IsExpired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 0, 10, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		token *domain.PersonalAccessToken
+		want  bool
+	}{
+		{
+			name:  "no_expiration",
+			token: &domain.PersonalAccessToken{},
+			want:  false,
+		},
+		{
+			name:  "before_expiration",
+			token: &domain.PersonalAccessToken{ExpiresAt: new(now.Add(time.Second))},
+			want:  false,
+		},
+		{
+			name:  "expired",
+			token: &domain.PersonalAccessToken{ExpiresAt: new(now)},
+			want:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.token.IsExpired(now))
+		})
+	}
+}
+
+func TestPersonalAccessToken_ShouldRecordUse(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 0, 10, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		token *domain.PersonalAccessToken
+		want  bool
+	}{
+		{
+			name:  "never_used",
+			token: &domain.PersonalAccessToken{},
+			want:  true,
+		},
+		{
+			name:  "used_within_a_minute",
+			token: &domain.PersonalAccessToken{LastUsedAt: new(now.Add(-time.Minute + time.Second))},
+			want:  false,
+		},
+		{
+			name:  "used_a_minute_ago",
+			token: &domain.PersonalAccessToken{LastUsedAt: new(now.Add(-time.Minute))},
+			want:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.token.ShouldRecordUse(now))
+		})
+	}
+}
+
+func TestPersonalAccessToken_HasScopes(t *testing.T) {
+	t.Parallel()
+
+	token := &domain.PersonalAccessToken{Scopes: []domain.Scope{domain.ScopeProjectsRead, domain.ScopeTasksWrite}}
+	tests := []struct {
+		name   string
+		scopes []domain.Scope
+		want   bool
+	}{
+		{name: "granted", scopes: []domain.Scope{domain.ScopeProjectsRead}, want: true},
+		{name: "read_implied_by_write", scopes: []domain.Scope{domain.ScopeTasksRead}, want: true},
+		{name: "write_not_implied_by_read", scopes: []domain.Scope{domain.ScopeProjectsWrite}, want: false},
+		{name: "not_granted", scopes: []domain.Scope{domain.ScopeTagsRead}, want: false},
+		{name: "all_granted", scopes: []domain.Scope{domain.ScopeProjectsRead, domain.ScopeTasksRead}, want: true},
+		{name: "partially_granted", scopes: []domain.Scope{domain.ScopeProjectsRead, domain.ScopeTagsRead}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, token.HasScopes(tt.scopes...))
+		})
+	}
+}
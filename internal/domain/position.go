@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+	"github.com/minguu42/harmattan/internal/lib/fracindex"
+)
+
+// MaxPositionLength は並び順を表す位置の最大長であり、これを超える場合は兄弟要素の位置を振り直す
+const MaxPositionLength = 48
+
+var ErrPositionAnchorNotFound = errors.New("anchor not found")
+
+// PositionEntry は手動で並び替えられる要素のIDと位置の組である
+// 位置は fracindex のキーであり、辞書順で並び順を表す
+type PositionEntry[ID ~string] struct {
+	ID       ID
+	Position string
+}
+
+// MovePosition は位置の昇順に並んだ兄弟要素 entries の中で、要素 id を anchor の直前（before が true の場合）または直後に置いたときの位置を返す
+// anchor が空の場合は末尾に置く。id が entries に含まれない場合は新しい要素として扱う
+// 戻り値は位置が変わる要素のIDと新しい位置の組である
+// 前後の要素の位置の間に余地がない場合や位置が設定されていない要素がある場合は、すべての要素の位置を振り直す
+func MovePosition[ID ~string](entries []PositionEntry[ID], id, anchor ID, before bool) (map[ID]string, error) {
+	if id == anchor {
+		return nil, errtrace.Wrap(errors.New("cannot move relative to itself"))
+	}
+
+	others := slices.DeleteFunc(slices.Clone(entries), func(e PositionEntry[ID]) bool { return e.ID == id })
+	i := len(others)
+	if anchor != "" {
+		i = slices.IndexFunc(others, func(e PositionEntry[ID]) bool { return e.ID == anchor })
+		if i == -1 {
+			return nil, errtrace.Wrap(ErrPositionAnchorNotFound)
+		}
+		if !before {
+			i++
+		}
+	}
+
+	var prev, next string
+	if i > 0 {
+		prev = others[i-1].Position
+	}
+	if i < len(others) {
+		next = others[i].Position
+	}
+	if (i == 0 || fracindex.Valid(prev)) && (i == len(others) || fracindex.Valid(next)) {
+		if p, err := fracindex.Between(prev, next); err == nil && len(p) <= MaxPositionLength {
+			return map[ID]string{id: p}, nil
+		}
+	}
+
+	ordered := slices.Insert(others, i, PositionEntry[ID]{ID: id})
+	positions := make(map[ID]string, len(ordered))
+	for j, p := range fracindex.Spread(len(ordered)) {
+		if e := ordered[j]; e.ID == id || e.Position != p {
+			positions[e.ID] = p
+		}
+	}
+	return positions, nil
+}
@@ -0,0 +1,113 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMovePosition(t *testing.T) {
+	t.Parallel()
+
+	entries := []domain.PositionEntry[domain.TaskID]{
+		{ID: "task01", Position: "9"},
+		{ID: "task02", Position: "i"},
+		{ID: "task03", Position: "r"},
+	}
+	tests := []struct {
+		name    string
+		entries []domain.PositionEntry[domain.TaskID]
+		id      domain.TaskID
+		anchor  domain.TaskID
+		before  bool
+		want    map[domain.TaskID]string
+		wantErr error
+	}{
+		{
+			name:    "append_new",
+			entries: entries,
+			id:      "task04",
+			want:    map[domain.TaskID]string{"task04": "s"},
+		},
+		{
+			name:    "append_to_empty",
+			entries: nil,
+			id:      "task01",
+			want:    map[domain.TaskID]string{"task01": "i"},
+		},
+		{
+			name:    "after",
+			entries: entries,
+			id:      "task03",
+			anchor:  "task01",
+			want:    map[domain.TaskID]string{"task03": "e"},
+		},
+		{
+			name:    "before_first",
+			entries: entries,
+			id:      "task03",
+			anchor:  "task01",
+			before:  true,
+			want:    map[domain.TaskID]string{"task03": "8"},
+		},
+		{
+			name:    "after_last",
+			entries: entries,
+			id:      "task01",
+			anchor:  "task03",
+			want:    map[domain.TaskID]string{"task01": "s"},
+		},
+		{
+			name: "rebalance_when_positions_are_not_set",
+			entries: []domain.PositionEntry[domain.TaskID]{
+				{ID: "task01", Position: ""},
+				{ID: "task02", Position: ""},
+				{ID: "task03", Position: ""},
+			},
+			id:     "task03",
+			anchor: "task01",
+			before: true,
+			want:   map[domain.TaskID]string{"task03": "9", "task01": "i", "task02": "r"},
+		},
+		{
+			name: "rebalance_when_too_long",
+			entries: []domain.PositionEntry[domain.TaskID]{
+				{ID: "task01", Position: "9"},
+				{ID: "task02", Position: "9000000000000000000000000000000000000000000000001"},
+				{ID: "task03", Position: "r"},
+			},
+			id:     "task03",
+			anchor: "task01",
+			want:   map[domain.TaskID]string{"task03": "i", "task02": "r"},
+		},
+		{
+			name:    "anchor_not_found",
+			entries: entries,
+			id:      "task01",
+			anchor:  "task99",
+			wantErr: domain.ErrPositionAnchorNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := domain.MovePosition(tt.entries, tt.id, tt.anchor, tt.before)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("self_anchor", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := domain.MovePosition(entries, "task01", "task01", false)
+		assert.Error(t, err)
+	})
+}
@@ -1,6 +1,11 @@
 package domain
 
-import "time"
+import (
+	"strconv"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/lib/etag"
+)
 
 // MaxProjectsPerUser は1ユーザが作成できるプロジェクト数の上限
 const MaxProjectsPerUser = 100
@@ -13,8 +18,16 @@ type Project struct {
 	Name       string
 	Color      ProjectColor
 	IsArchived bool
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+	Position   string
+	// Version は内容を更新するたびに1ずつ増える版数であり、楽観的排他制御とエンティティタグに使う
+	Version   int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ETag はプロジェクトの表現を識別するエンティティタグを返す
+func (p *Project) ETag() string {
+	return etag.Strong(strconv.Itoa(p.Version))
 }
 
 type Projects []Project
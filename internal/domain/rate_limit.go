@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// RateLimit はトークンバケットでリクエスト数を制限するときの上限であり、Period の間に Requests 回までリクエストを許可する
+type RateLimit struct {
+	// Requests はバケットの容量であり、連続して許可するリクエスト数の上限である
+	Requests int
+	// Period は空のバケットが満杯になるまでの時間である
+	Period time.Duration
+}
+
+// RateLimitBucket はリクエスト数を制限する単位ごとのトークンバケットである
+type RateLimitBucket struct {
+	// Key は制限する単位であり、「<OperationID>:user:<ユーザID>」か「<OperationID>:ip:<IPアドレス>」の形式で表す
+	Key    string
+	Tokens float64
+	// RefilledAt は最後にトークンを補充した日時であり、ゼロ値の場合はバケットが満杯であるとみなす
+	RefilledAt time.Time
+}
+
+// Take は now の時点までの分のトークンを補充してから1つ取り出し、取り出せたかを返す
+// 取り出せなかった場合もトークンの補充は反映する
+func (b *RateLimitBucket) Take(l RateLimit, now time.Time) bool {
+	b.refill(l, now)
+	if b.Tokens < 1 {
+		return false
+	}
+	b.Tokens--
+	return true
+}
+
+// Remaining は取り出せる残りのトークンの数を返す
+func (b *RateLimitBucket) Remaining() int {
+	return int(b.Tokens)
+}
+
+// RetryAfter はトークンを1つ取り出せるようになるまでの時間を返す
+func (b *RateLimitBucket) RetryAfter(l RateLimit) time.Duration {
+	if b.Tokens >= 1 {
+		return 0
+	}
+	return time.Duration(math.Ceil((1 - b.Tokens) * float64(l.Period) / float64(l.Requests)))
+}
+
+// FullAt はトークンの補充によってバケットが満杯になる日時を返す
+// 満杯のバケットは削除しても次のリクエストで満杯のバケットとして作り直されるため、この日時以降は保存しておく必要がない
+func (b *RateLimitBucket) FullAt(l RateLimit) time.Time {
+	return b.RefilledAt.Add(time.Duration(math.Ceil((float64(l.Requests) - b.Tokens) * float64(l.Period) / float64(l.Requests))))
+}
+
+func (b *RateLimitBucket) refill(l RateLimit, now time.Time) {
+	if b.RefilledAt.IsZero() {
+		b.Tokens = float64(l.Requests)
+		b.RefilledAt = now
+		return
+	}
+	// 複数のAPIサーバの時刻のずれで now が過去になった場合は補充しない
+	if elapsed := now.Sub(b.RefilledAt); elapsed > 0 {
+		b.Tokens = min(float64(l.Requests), b.Tokens+float64(elapsed)*float64(l.Requests)/float64(l.Period))
+		b.RefilledAt = now
+	}
+}
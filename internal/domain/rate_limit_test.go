@@ -0,0 +1,116 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitBucket_Take(t *testing.T) {
+	t.Parallel()
+
+	limit := domain.RateLimit{Requests: 10, Period: 10 * time.Second}
+	now := time.Date(2025, 1, 1, 0, 10, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		bucket domain.RateLimitBucket
+		want   bool
+		// wantBucket はトークンを取り出した後のバケットである
+		wantBucket domain.RateLimitBucket
+	}{
+		{
+			name:       "new_bucket",
+			bucket:     domain.RateLimitBucket{},
+			want:       true,
+			wantBucket: domain.RateLimitBucket{Tokens: 9, RefilledAt: now},
+		},
+		{
+			name:       "has_tokens",
+			bucket:     domain.RateLimitBucket{Tokens: 3, RefilledAt: now},
+			want:       true,
+			wantBucket: domain.RateLimitBucket{Tokens: 2, RefilledAt: now},
+		},
+		{
+			name:       "empty",
+			bucket:     domain.RateLimitBucket{Tokens: 0.5, RefilledAt: now},
+			want:       false,
+			wantBucket: domain.RateLimitBucket{Tokens: 0.5, RefilledAt: now},
+		},
+		{
+			name:       "refilled",
+			bucket:     domain.RateLimitBucket{Tokens: 0.5, RefilledAt: now.Add(-2 * time.Second)},
+			want:       true,
+			wantBucket: domain.RateLimitBucket{Tokens: 1.5, RefilledAt: now},
+		},
+		{
+			name:       "refilled_up_to_capacity",
+			bucket:     domain.RateLimitBucket{Tokens: 0, RefilledAt: now.Add(-1 * time.Hour)},
+			want:       true,
+			wantBucket: domain.RateLimitBucket{Tokens: 9, RefilledAt: now},
+		},
+		{
+			name:       "refilled_in_future",
+			bucket:     domain.RateLimitBucket{Tokens: 0, RefilledAt: now.Add(1 * time.Second)},
+			want:       false,
+			wantBucket: domain.RateLimitBucket{Tokens: 0, RefilledAt: now.Add(1 * time.Second)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := tt.bucket
+			assert.Equal(t, tt.want, b.Take(limit, now))
+			assert.Equal(t, tt.wantBucket, b)
+		})
+	}
+}
+
+func TestRateLimitBucket_RetryAfter(t *testing.T) {
+	t.Parallel()
+
+	limit := domain.RateLimit{Requests: 10, Period: 10 * time.Second}
+	tests := []struct {
+		name   string
+		tokens float64
+		want   time.Duration
+	}{
+		{name: "has_tokens", tokens: 1, want: 0},
+		{name: "empty", tokens: 0, want: 1 * time.Second},
+		{name: "partially_refilled", tokens: 0.75, want: 250 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := domain.RateLimitBucket{Tokens: tt.tokens}
+			assert.Equal(t, tt.want, b.RetryAfter(limit))
+		})
+	}
+}
+
+func TestRateLimitBucket_FullAt(t *testing.T) {
+	t.Parallel()
+
+	limit := domain.RateLimit{Requests: 10, Period: 10 * time.Second}
+	refilledAt := time.Date(2025, 1, 1, 0, 10, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		tokens float64
+		want   time.Time
+	}{
+		{name: "full", tokens: 10, want: refilledAt},
+		{name: "empty", tokens: 0, want: refilledAt.Add(10 * time.Second)},
+		{name: "partially_refilled", tokens: 7.5, want: refilledAt.Add(2500 * time.Millisecond)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := domain.RateLimitBucket{Tokens: tt.tokens, RefilledAt: refilledAt}
+			assert.Equal(t, tt.want, b.FullAt(limit))
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package domain
+
+import "time"
+
+// RecoveryCode は認証アプリを使えなくなったときに認証コードの代わりに1回だけ使えるコードであり、データベースには平文ではなくハッシュ値を保存する
+type RecoveryCode struct {
+	UserID    UserID
+	CodeHash  string
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
@@ -0,0 +1,202 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+	"github.com/minguu42/harmattan/internal/lib/plain"
+)
+
+// MaxRecurrenceInterval は繰り返しルールに指定できる間隔の上限
+const MaxRecurrenceInterval = 365
+
+// RecurrenceRule はタスクの繰り返しルールであり、RFC 5545のRRULEに倣った文字列で表す
+// 以下の形式をサポートする
+// - FREQ=DAILY;INTERVAL=n: n日ごと
+// - FREQ=WEEKLY;INTERVAL=n;BYDAY=MO,WE: n週ごとの指定した曜日
+// - FREQ=MONTHLY;INTERVAL=n;BYMONTHDAY=d: nか月ごとのd日（月末を超える場合は月末日）
+// - FREQ=DAILY;INTERVAL=n;X-FROM=COMPLETION: 完了日からn日後
+// INTERVALを省略した場合は1とみなす
+type RecurrenceRule string
+
+type RecurrenceFrequency string
+
+const (
+	RecurrenceFrequencyDaily   RecurrenceFrequency = "DAILY"
+	RecurrenceFrequencyWeekly  RecurrenceFrequency = "WEEKLY"
+	RecurrenceFrequencyMonthly RecurrenceFrequency = "MONTHLY"
+)
+
+// Recurrence は RecurrenceRule を解析した結果である
+type Recurrence struct {
+	Frequency      RecurrenceFrequency
+	Interval       int
+	Weekdays       []time.Weekday
+	MonthDay       int
+	FromCompletion bool
+}
+
+var weekdayCodes = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// ParseRecurrenceRule は s を解析し、正規化した繰り返しルールを返す
+func ParseRecurrenceRule(s string) (RecurrenceRule, error) {
+	r, err := RecurrenceRule(s).Parse()
+	if err != nil {
+		return "", errtrace.Wrap(err)
+	}
+	return r.Rule(), nil
+}
+
+func (rule RecurrenceRule) Parse() (*Recurrence, error) {
+	r := Recurrence{Interval: 1}
+	seen := make(map[string]bool)
+	for part := range strings.SplitSeq(strings.ToUpper(strings.TrimSpace(string(rule))), ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, errtrace.Wrap(fmt.Errorf("invalid rule part: %q", part))
+		}
+		if seen[key] {
+			return nil, errtrace.Wrap(fmt.Errorf("duplicate rule part: %s", key))
+		}
+		seen[key] = true
+
+		switch key {
+		case "FREQ":
+			switch f := RecurrenceFrequency(value); f {
+			case RecurrenceFrequencyDaily, RecurrenceFrequencyWeekly, RecurrenceFrequencyMonthly:
+				r.Frequency = f
+			default:
+				return nil, errtrace.Wrap(fmt.Errorf("unsupported frequency: %s", value))
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 || MaxRecurrenceInterval < n {
+				return nil, errtrace.Wrap(fmt.Errorf("invalid interval: %s", value))
+			}
+			r.Interval = n
+		case "BYDAY":
+			for code := range strings.SplitSeq(value, ",") {
+				i := slices.Index(weekdayCodes, code)
+				if i == -1 {
+					return nil, errtrace.Wrap(fmt.Errorf("invalid weekday: %s", code))
+				}
+				if slices.Contains(r.Weekdays, time.Weekday(i)) {
+					return nil, errtrace.Wrap(fmt.Errorf("duplicate weekday: %s", code))
+				}
+				r.Weekdays = append(r.Weekdays, time.Weekday(i))
+			}
+			slices.Sort(r.Weekdays)
+		case "BYMONTHDAY":
+			d, err := strconv.Atoi(value)
+			if err != nil || d < 1 || 31 < d {
+				return nil, errtrace.Wrap(fmt.Errorf("invalid month day: %s", value))
+			}
+			r.MonthDay = d
+		case "X-FROM":
+			if value != "COMPLETION" {
+				return nil, errtrace.Wrap(fmt.Errorf("unsupported basis: %s", value))
+			}
+			r.FromCompletion = true
+		default:
+			return nil, errtrace.Wrap(fmt.Errorf("unsupported rule part: %s", key))
+		}
+	}
+
+	switch {
+	case r.Frequency == "":
+		return nil, errtrace.Wrap(errors.New("FREQ is required"))
+	case r.Weekdays != nil && r.Frequency != RecurrenceFrequencyWeekly:
+		return nil, errtrace.Wrap(errors.New("BYDAY is only allowed with FREQ=WEEKLY"))
+	case r.MonthDay != 0 && r.Frequency != RecurrenceFrequencyMonthly:
+		return nil, errtrace.Wrap(errors.New("BYMONTHDAY is only allowed with FREQ=MONTHLY"))
+	case r.FromCompletion && r.Frequency != RecurrenceFrequencyDaily:
+		return nil, errtrace.Wrap(errors.New("X-FROM=COMPLETION is only allowed with FREQ=DAILY"))
+	}
+	return &r, nil
+}
+
+// Rule は r を正規化した文字列で返す
+func (r *Recurrence) Rule() RecurrenceRule {
+	parts := []string{"FREQ=" + string(r.Frequency)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.Weekdays) > 0 {
+		codes := make([]string, 0, len(r.Weekdays))
+		for _, wd := range r.Weekdays {
+			codes = append(codes, weekdayCodes[wd])
+		}
+		parts = append(parts, "BYDAY="+strings.Join(codes, ","))
+	}
+	if r.MonthDay != 0 {
+		parts = append(parts, "BYMONTHDAY="+strconv.Itoa(r.MonthDay))
+	}
+	if r.FromCompletion {
+		parts = append(parts, "X-FROM=COMPLETION")
+	}
+	return RecurrenceRule(strings.Join(parts, ";"))
+}
+
+// Next は次回の期日を返す
+// 期日 dueOn が設定されていない場合と X-FROM=COMPLETION の場合は完了日 completedOn を基準にする
+func (r *Recurrence) Next(dueOn *plain.Date, completedOn plain.Date) plain.Date {
+	base := r.base(dueOn, completedOn)
+	switch r.Frequency {
+	case RecurrenceFrequencyWeekly:
+		if len(r.Weekdays) == 0 {
+			return base.AddDate(0, 0, 7*r.Interval)
+		}
+		// 週の始まりを月曜日として、基準日の週から数えてINTERVAL週ごとの週に含まれる指定した曜日を探す
+		baseWeekStart := base.AddDate(0, 0, -mondayOffset(base.Weekday()))
+		for d := base.AddDate(0, 0, 1); ; d = d.AddDate(0, 0, 1) {
+			weekStart := d.AddDate(0, 0, -mondayOffset(d.Weekday()))
+			weeks := int(weekStart.In(time.UTC).Sub(baseWeekStart.In(time.UTC)).Hours()) / (7 * 24)
+			if weeks%r.Interval == 0 && slices.Contains(r.Weekdays, d.Weekday()) {
+				return d
+			}
+		}
+	case RecurrenceFrequencyMonthly:
+		day := r.MonthDay
+		if day == 0 {
+			day = base.Day()
+		}
+		if d := min(day, daysIn(base.Year(), base.Month())); base.Day() < d {
+			return plain.NewDate(base.Year(), base.Month(), d)
+		}
+		firstOfMonth := plain.NewDate(base.Year(), base.Month(), 1).AddDate(0, r.Interval, 0)
+		return plain.NewDate(firstOfMonth.Year(), firstOfMonth.Month(), min(day, daysIn(firstOfMonth.Year(), firstOfMonth.Month())))
+	default:
+		return base.AddDate(0, 0, r.Interval)
+	}
+}
+
+// Anchor は日を指定していない毎月の繰り返しについて、基準日の日を BYMONTHDAY に指定した繰り返しを返す
+// 1月31日の次回を2月28日とした後も3月28日ではなく3月31日に戻れるように、次回分のタスクには元の日を引き継ぐ
+func (r *Recurrence) Anchor(dueOn *plain.Date, completedOn plain.Date) *Recurrence {
+	if r.Frequency != RecurrenceFrequencyMonthly || r.MonthDay != 0 {
+		return r
+	}
+	anchored := *r
+	anchored.MonthDay = r.base(dueOn, completedOn).Day()
+	return &anchored
+}
+
+func (r *Recurrence) base(dueOn *plain.Date, completedOn plain.Date) plain.Date {
+	if dueOn != nil && !r.FromCompletion {
+		return *dueOn
+	}
+	return completedOn
+}
+
+func mondayOffset(wd time.Weekday) int {
+	return (int(wd) + 6) % 7
+}
+
+func daysIn(year int, month time.Month) int {
+	return plain.NewDate(year, month+1, 0).Day()
+}
@@ -0,0 +1,166 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/plain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRecurrenceRule(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		s       string
+		want    domain.RecurrenceRule
+		wantErr bool
+	}{
+		{name: "daily", s: "FREQ=DAILY", want: "FREQ=DAILY"},
+		{name: "omit_interval_1", s: "FREQ=DAILY;INTERVAL=1", want: "FREQ=DAILY"},
+		{name: "weekly_sorted_weekdays", s: "FREQ=WEEKLY;BYDAY=FR,MO", want: "FREQ=WEEKLY;BYDAY=MO,FR"},
+		{name: "monthly", s: "FREQ=MONTHLY;INTERVAL=2;BYMONTHDAY=31", want: "FREQ=MONTHLY;INTERVAL=2;BYMONTHDAY=31"},
+		{name: "from_completion", s: "X-FROM=COMPLETION;INTERVAL=3;FREQ=DAILY", want: "FREQ=DAILY;INTERVAL=3;X-FROM=COMPLETION"},
+		{name: "lowercase", s: "freq=weekly;byday=sa", want: "FREQ=WEEKLY;BYDAY=SA"},
+		{name: "empty", s: "", wantErr: true},
+		{name: "missing_freq", s: "INTERVAL=2", wantErr: true},
+		{name: "unsupported_freq", s: "FREQ=YEARLY", wantErr: true},
+		{name: "unsupported_part", s: "FREQ=DAILY;COUNT=3", wantErr: true},
+		{name: "duplicate_part", s: "FREQ=DAILY;FREQ=WEEKLY", wantErr: true},
+		{name: "zero_interval", s: "FREQ=DAILY;INTERVAL=0", wantErr: true},
+		{name: "too_large_interval", s: "FREQ=DAILY;INTERVAL=366", wantErr: true},
+		{name: "invalid_weekday", s: "FREQ=WEEKLY;BYDAY=XX", wantErr: true},
+		{name: "duplicate_weekday", s: "FREQ=WEEKLY;BYDAY=MO,MO", wantErr: true},
+		{name: "invalid_month_day", s: "FREQ=MONTHLY;BYMONTHDAY=32", wantErr: true},
+		{name: "byday_with_daily", s: "FREQ=DAILY;BYDAY=MO", wantErr: true},
+		{name: "bymonthday_with_weekly", s: "FREQ=WEEKLY;BYMONTHDAY=1", wantErr: true},
+		{name: "from_completion_with_weekly", s: "FREQ=WEEKLY;X-FROM=COMPLETION", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := domain.ParseRecurrenceRule(tt.s)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRecurrence_Next(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		rule        domain.RecurrenceRule
+		dueOn       *plain.Date
+		completedOn plain.Date
+		want        plain.Date
+	}{
+		{
+			name:        "daily_from_due_on",
+			rule:        "FREQ=DAILY;INTERVAL=2",
+			dueOn:       new(plain.NewDate(2025, 1, 10)),
+			completedOn: plain.NewDate(2025, 1, 12),
+			want:        plain.NewDate(2025, 1, 12),
+		},
+		{
+			name:        "daily_without_due_on",
+			rule:        "FREQ=DAILY",
+			completedOn: plain.NewDate(2025, 1, 12),
+			want:        plain.NewDate(2025, 1, 13),
+		},
+		{
+			name:        "daily_from_completion",
+			rule:        "FREQ=DAILY;INTERVAL=3;X-FROM=COMPLETION",
+			dueOn:       new(plain.NewDate(2025, 1, 10)),
+			completedOn: plain.NewDate(2025, 1, 12),
+			want:        plain.NewDate(2025, 1, 15),
+		},
+		{
+			name:        "weekly_without_weekdays",
+			rule:        "FREQ=WEEKLY",
+			dueOn:       new(plain.NewDate(2025, 1, 1)),
+			completedOn: plain.NewDate(2025, 1, 1),
+			want:        plain.NewDate(2025, 1, 8),
+		},
+		{
+			name:        "weekly_same_week",
+			rule:        "FREQ=WEEKLY;BYDAY=MO,TH",
+			dueOn:       new(plain.NewDate(2025, 1, 6)), // 月曜日
+			completedOn: plain.NewDate(2025, 1, 6),
+			want:        plain.NewDate(2025, 1, 9),
+		},
+		{
+			name:        "weekly_next_week",
+			rule:        "FREQ=WEEKLY;BYDAY=MO,TH",
+			dueOn:       new(plain.NewDate(2025, 1, 9)), // 木曜日
+			completedOn: plain.NewDate(2025, 1, 9),
+			want:        plain.NewDate(2025, 1, 13),
+		},
+		{
+			name:        "weekly_sunday_is_end_of_week",
+			rule:        "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,SU",
+			dueOn:       new(plain.NewDate(2025, 1, 6)), // 月曜日
+			completedOn: plain.NewDate(2025, 1, 6),
+			want:        plain.NewDate(2025, 1, 12),
+		},
+		{
+			name:        "biweekly_skips_week",
+			rule:        "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO",
+			dueOn:       new(plain.NewDate(2025, 1, 6)), // 月曜日
+			completedOn: plain.NewDate(2025, 1, 6),
+			want:        plain.NewDate(2025, 1, 20),
+		},
+		{
+			name:        "monthly_later_in_same_month",
+			rule:        "FREQ=MONTHLY;BYMONTHDAY=25",
+			dueOn:       new(plain.NewDate(2025, 1, 10)),
+			completedOn: plain.NewDate(2025, 1, 10),
+			want:        plain.NewDate(2025, 1, 25),
+		},
+		{
+			name:        "monthly_next_month",
+			rule:        "FREQ=MONTHLY;BYMONTHDAY=10",
+			dueOn:       new(plain.NewDate(2025, 1, 10)),
+			completedOn: plain.NewDate(2025, 1, 10),
+			want:        plain.NewDate(2025, 2, 10),
+		},
+		{
+			name:        "monthly_clamp_to_end_of_month",
+			rule:        "FREQ=MONTHLY;BYMONTHDAY=31",
+			dueOn:       new(plain.NewDate(2025, 1, 31)),
+			completedOn: plain.NewDate(2025, 1, 31),
+			want:        plain.NewDate(2025, 2, 28),
+		},
+		{
+			name:        "monthly_clamp_in_same_month",
+			rule:        "FREQ=MONTHLY;BYMONTHDAY=31",
+			dueOn:       new(plain.NewDate(2025, 2, 10)),
+			completedOn: plain.NewDate(2025, 2, 10),
+			want:        plain.NewDate(2025, 2, 28),
+		},
+		{
+			name:        "monthly_without_month_day",
+			rule:        "FREQ=MONTHLY;INTERVAL=3",
+			dueOn:       new(plain.NewDate(2025, 11, 15)),
+			completedOn: plain.NewDate(2025, 11, 15),
+			want:        plain.NewDate(2026, 2, 15),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := tt.rule.Parse()
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, r.Next(tt.dueOn, tt.completedOn))
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+type RefreshTokenID string
+
+// RefreshToken はIDトークンを再発行するためのトークンであり、データベースには平文ではなくハッシュ値を保存する
+// 再発行のたびに同じファミリーの新しいトークンに置き換え、使用済みのトークンが再び使われた場合は漏洩したものとみなしてファミリーごと失効させる
+type RefreshToken struct {
+	ID RefreshTokenID
+	// FamilyID はサインインで最初に発行したトークンのIDであり、ローテーションで発行したトークンに引き継ぐ
+	FamilyID  RefreshTokenID
+	UserID    UserID
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+	// AuthenticatedAt はファミリーの最初のトークンを発行したときにユーザが認証した日時であり、記録していない場合は nil である
+	AuthenticatedAt *time.Time
+	CreatedAt       time.Time
+}
+
+// IsExpired はトークンが now の時点で有効期限切れかを返す
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"html"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// MaxSearchQueryLength は検索キーワードに指定できる最大文字数
+const MaxSearchQueryLength = 100
+
+const (
+	// snippetLength はスニペットとして切り出す最大文字数
+	snippetLength = 80
+	// snippetLeading はスニペットに含める最初の検索語より前の文字数
+	snippetLeading = 20
+)
+
+type SearchResultType string
+
+const (
+	SearchResultTypeProject SearchResultType = "project"
+	SearchResultTypeTask    SearchResultType = "task"
+	SearchResultTypeStep    SearchResultType = "step"
+	SearchResultTypeTag     SearchResultType = "tag"
+)
+
+// SearchResult は全文検索に一致したプロジェクト・タスク・ステップ・タグのいずれかである
+// ProjectID はプロジェクト・タスク・ステップの場合に設定し、TaskID はステップの場合のみ設定する
+// Content はタスクの場合のみ設定する
+type SearchResult struct {
+	Type      SearchResultType
+	ID        string
+	ProjectID ProjectID
+	TaskID    TaskID
+	Name      string
+	Content   string
+}
+
+type SearchResults []SearchResult
+
+// SearchTerms は検索キーワード q を空白で区切り、重複を除いた検索語を返す
+func SearchTerms(q string) []string {
+	var terms []string
+	for t := range strings.FieldsSeq(q) {
+		if !slices.Contains(terms, t) {
+			terms = append(terms, t)
+		}
+	}
+	return terms
+}
+
+// Snippet は検索結果のうち検索語を含む部分を切り出し、検索語を <mark> タグで囲んだ文字列を返す
+// タスクは内容に検索語を含む場合は内容から、それ以外の場合は名前から切り出す
+// HTMLとしてそのまま表示できるよう、検索語を囲むタグ以外はエスケープする
+func (r *SearchResult) Snippet(terms []string) string {
+	if r.Content != "" && len(matchRanges([]rune(r.Content), terms)) > 0 {
+		return highlight(r.Content, terms)
+	}
+	return highlight(r.Name, terms)
+}
+
+type runeRange struct {
+	start int
+	end   int
+}
+
+// matchRanges は s のうち terms のいずれかに一致する範囲を、重ならないように先頭から順に返す
+// 英字の大文字と小文字は区別せず、同じ位置で複数の検索語に一致する場合は長い検索語を優先する
+func matchRanges(s []rune, terms []string) []runeRange {
+	lower := toLowerRunes(s)
+	ts := make([][]rune, 0, len(terms))
+	for _, t := range terms {
+		if t != "" {
+			ts = append(ts, toLowerRunes([]rune(t)))
+		}
+	}
+	slices.SortStableFunc(ts, func(a, b []rune) int { return len(b) - len(a) })
+
+	var ranges []runeRange
+	for i := 0; i < len(lower); {
+		j := slices.IndexFunc(ts, func(t []rune) bool {
+			return i+len(t) <= len(lower) && slices.Equal(lower[i:i+len(t)], t)
+		})
+		if j == -1 {
+			i++
+			continue
+		}
+		ranges = append(ranges, runeRange{start: i, end: i + len(ts[j])})
+		i += len(ts[j])
+	}
+	return ranges
+}
+
+func toLowerRunes(s []rune) []rune {
+	lower := make([]rune, 0, len(s))
+	for _, r := range s {
+		lower = append(lower, unicode.ToLower(r))
+	}
+	return lower
+}
+
+// highlight は text の最初に検索語に一致する位置の周辺を切り出し、検索語を <mark> タグで囲む
+// 改行などの連続する空白は1つの空白にまとめ、切り出した前後に続きがある場合は省略記号を付ける
+func highlight(text string, terms []string) string {
+	s := []rune(strings.Join(strings.Fields(text), " "))
+	ranges := matchRanges(s, terms)
+
+	start := 0
+	if len(ranges) > 0 {
+		start = max(0, ranges[0].start-snippetLeading)
+	}
+	end := min(len(s), start+snippetLength)
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	pos := start
+	for _, r := range ranges {
+		if end <= r.start {
+			break
+		}
+		b.WriteString(html.EscapeString(string(s[pos:r.start])))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(string(s[r.start:min(r.end, end)])))
+		b.WriteString("</mark>")
+		pos = min(r.end, end)
+	}
+	b.WriteString(html.EscapeString(string(s[pos:end])))
+	if end < len(s) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
@@ -0,0 +1,104 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchTerms(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		q    string
+		want []string
+	}{
+		{name: "single", q: "会議", want: []string{"会議"}},
+		{name: "multiple", q: " 会議  資料 ", want: []string{"会議", "資料"}},
+		{name: "ideographic_space", q: "会議　資料", want: []string{"会議", "資料"}},
+		{name: "duplicate", q: "会議 資料 会議", want: []string{"会議", "資料"}},
+		{name: "blank", q: "  ", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, domain.SearchTerms(tt.q))
+		})
+	}
+}
+
+func TestSearchResult_Snippet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		result domain.SearchResult
+		terms  []string
+		want   string
+	}{
+		{
+			name:   "name",
+			result: domain.SearchResult{Name: "週次会議の資料を作成する"},
+			terms:  []string{"会議", "資料"},
+			want:   "週次<mark>会議</mark>の<mark>資料</mark>を作成する",
+		},
+		{
+			name:   "content_preferred_over_name",
+			result: domain.SearchResult{Name: "会議の準備", Content: "会議室を予約する"},
+			terms:  []string{"予約"},
+			want:   "会議室を<mark>予約</mark>する",
+		},
+		{
+			name:   "name_when_content_not_matched",
+			result: domain.SearchResult{Name: "会議の準備", Content: "資料を印刷する"},
+			terms:  []string{"会議"},
+			want:   "<mark>会議</mark>の準備",
+		},
+		{
+			name:   "case_insensitive",
+			result: domain.SearchResult{Name: "Go言語の勉強"},
+			terms:  []string{"go"},
+			want:   "<mark>Go</mark>言語の勉強",
+		},
+		{
+			name:   "longer_term_preferred",
+			result: domain.SearchResult{Name: "会議室の予約"},
+			terms:  []string{"会議", "会議室"},
+			want:   "<mark>会議室</mark>の予約",
+		},
+		{
+			name:   "escape_html",
+			result: domain.SearchResult{Name: "<b>会議</b> & 資料"},
+			terms:  []string{"会議"},
+			want:   "&lt;b&gt;<mark>会議</mark>&lt;/b&gt; &amp; 資料",
+		},
+		{
+			name:   "collapse_whitespace",
+			result: domain.SearchResult{Name: "タスク", Content: "1行目\n\n2行目の会議"},
+			terms:  []string{"会議"},
+			want:   "1行目 2行目の<mark>会議</mark>",
+		},
+		{
+			name:   "truncate_around_first_match",
+			result: domain.SearchResult{Name: "タスク", Content: "あいうえおかきくけこさしすせそたちつてとなにぬねのはひふへほまみむめもやゆよらりるれろわをん会議あいうえおかきくけこさしすせそたちつてとなにぬねのはひふへほまみむめもやゆよらりるれろわをんあいうえおかきくけこさしすせそたちつてとなにぬねのはひふへほまみむめもやゆよらりるれろわをん"},
+			terms:  []string{"会議"},
+			want:   "…ひふへほまみむめもやゆよらりるれろわをん<mark>会議</mark>あいうえおかきくけこさしすせそたちつてとなにぬねのはひふへほまみむめもやゆよらりるれろわをんあいうえおかきくけこさし…",
+		},
+		{
+			name:   "no_match",
+			result: domain.SearchResult{Name: "買い物"},
+			terms:  []string{"会議"},
+			want:   "買い物",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.result.Snippet(tt.terms))
+		})
+	}
+}
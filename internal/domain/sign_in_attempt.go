@@ -0,0 +1,11 @@
+package domain
+
+import "time"
+
+// SignInAttempt はメールアドレスやIPアドレスごとのサインインの連続した失敗の記録である
+type SignInAttempt struct {
+	// Subject はサインインを試行した主体であり、「email:メールアドレス」か「ip:IPアドレス」の形式で表す
+	Subject      string
+	Failures     int
+	LastFailedAt time.Time
+}
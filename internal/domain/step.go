@@ -1,6 +1,11 @@
 package domain
 
-import "time"
+import (
+	"strconv"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/lib/etag"
+)
 
 // MaxStepsPerTask は1タスクに作成できるステップ数の上限
 const MaxStepsPerTask = 20
@@ -13,8 +18,15 @@ type Step struct {
 	TaskID      TaskID
 	Name        string
 	CompletedAt *time.Time
+	Position    string
+	Version     int
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
 
+// ETag はステップの表現を識別するエンティティタグを返す
+func (s *Step) ETag() string {
+	return etag.Strong(strconv.Itoa(s.Version))
+}
+
 type Steps []Step
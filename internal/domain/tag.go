@@ -1,6 +1,11 @@
 package domain
 
-import "time"
+import (
+	"strconv"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/lib/etag"
+)
 
 // MaxTagsPerUser は1ユーザが作成できるタグ数の上限
 const MaxTagsPerUser = 100
@@ -11,10 +16,16 @@ type Tag struct {
 	ID        TagID
 	UserID    UserID
 	Name      string
+	Version   int
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
+// ETag はタグの表現を識別するエンティティタグを返す
+func (t *Tag) ETag() string {
+	return etag.Strong(strconv.Itoa(t.Version))
+}
+
 type Tags []Tag
 
 func (ts Tags) IDs() []TagID {
@@ -1,9 +1,15 @@
 package domain
 
 import (
+	"cmp"
+	"crypto/sha256"
+	"errors"
+	"fmt"
 	"slices"
 	"time"
 
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+	"github.com/minguu42/harmattan/internal/lib/etag"
 	"github.com/minguu42/harmattan/internal/lib/plain"
 )
 
@@ -13,20 +19,91 @@ const MaxTasksPerProject = 1000
 type TaskID string
 
 type Task struct {
-	ID          TaskID
-	UserID      UserID
-	ProjectID   ProjectID
-	Name        string
-	TagIDs      []TagID
-	Content     string
-	Priority    int
-	DueOn       *plain.Date
-	CompletedAt *time.Time
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	Steps       Steps
+	ID         TaskID
+	UserID     UserID
+	ProjectID  ProjectID
+	AssigneeID *UserID
+	Name       string
+	TagIDs     []TagID
+	Content    string
+	Priority   int
+	DueOn      *plain.Date
+	Recurrence *RecurrenceRule
+	// NextOccurrenceID は完了したときに作成した次回分のタスクのIDであり、作成していない場合は nil である
+	NextOccurrenceID *TaskID
+	CompletedAt      *time.Time
+	Position         string
+	Version          int
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	Steps            Steps
+	CommentCount     int
 }
 
+// CanHaveTag はタグ tag をタスクに付けられるかを返す
+// 共有プロジェクトでも、タスクに付けられるのはタスクの所有者であるプロジェクトのオーナーのタグのみである
+func (t *Task) CanHaveTag(tag *Tag) bool {
+	return t.UserID == tag.UserID
+}
+
+// ETag はタスクの表現を識別するエンティティタグを返す
+// タスクの表現には tags で渡すタグとステップ・コメント数も含まれるため、タスクの版数に加えてそれらの要約を含める
+func (t *Task) ETag(tags Tags) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "c%d", t.CommentCount)
+	for _, s := range t.Steps {
+		fmt.Fprintf(h, "\x00s%s:%d", s.ID, s.Version)
+	}
+	// タグは取得順が定まらないため、IDの順に並べてから含める
+	for _, tag := range slices.SortedFunc(slices.Values(tags), func(a, b Tag) int { return cmp.Compare(a.ID, b.ID) }) {
+		fmt.Fprintf(h, "\x00t%s:%d", tag.ID, tag.Version)
+	}
+	return etag.Strong(fmt.Sprintf("%d-%x", t.Version, h.Sum(nil)[:8]))
+}
+
+// NextOccurrence は繰り返しタスク t の次回分のタスクを返す
+// 次回分のタスクはステップを引き継がない
+func (t *Task) NextOccurrence(id TaskID, completedOn plain.Date, now time.Time) (*Task, error) {
+	if t.Recurrence == nil {
+		return nil, errtrace.Wrap(errors.New("task is not recurring"))
+	}
+	r, err := t.Recurrence.Parse()
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	dueOn := r.Next(t.DueOn, completedOn)
+	recurrence := r.Anchor(t.DueOn, completedOn).Rule()
+	return &Task{
+		ID:         id,
+		UserID:     t.UserID,
+		ProjectID:  t.ProjectID,
+		AssigneeID: t.AssigneeID,
+		Name:       t.Name,
+		TagIDs:     slices.Clone(t.TagIDs),
+		Content:    t.Content,
+		Priority:   t.Priority,
+		DueOn:      &dueOn,
+		Recurrence: &recurrence,
+		Version:    1,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		Steps:      Steps{},
+	}, nil
+}
+
+// TaskSortKey はタスク一覧の並び替えに使うキー
+type TaskSortKey string
+
+const (
+	TaskSortKeyPosition  TaskSortKey = "position"
+	TaskSortKeyPriority  TaskSortKey = "priority"
+	TaskSortKeyDueOn     TaskSortKey = "due_on"
+	TaskSortKeyCreatedAt TaskSortKey = "created_at"
+	TaskSortKeyUpdatedAt TaskSortKey = "updated_at"
+	TaskSortKeyName      TaskSortKey = "name"
+)
+
 type Tasks []Task
 
 func (ts Tasks) TagIDs() []TagID {
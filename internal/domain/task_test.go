@@ -0,0 +1,135 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/plain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTask_ETag(t *testing.T) {
+	t.Parallel()
+
+	base := &domain.Task{
+		ID:      "task01",
+		Version: 1,
+		Steps:   domain.Steps{{ID: "step01", Version: 1}},
+	}
+	tags := domain.Tags{{ID: "tag01", Version: 1}, {ID: "tag02", Version: 1}}
+	want := base.ETag(tags)
+
+	tests := []struct {
+		name     string
+		task     *domain.Task
+		tags     domain.Tags
+		wantSame bool
+	}{
+		{
+			name:     "same",
+			task:     &domain.Task{ID: "task01", Version: 1, Steps: domain.Steps{{ID: "step01", Version: 1}}},
+			tags:     tags,
+			wantSame: true,
+		},
+		{
+			name:     "tags_in_different_order",
+			task:     base,
+			tags:     domain.Tags{{ID: "tag02", Version: 1}, {ID: "tag01", Version: 1}},
+			wantSame: true,
+		},
+		{
+			name: "task_updated",
+			task: &domain.Task{ID: "task01", Version: 2, Steps: domain.Steps{{ID: "step01", Version: 1}}},
+			tags: tags,
+		},
+		{
+			name: "step_updated",
+			task: &domain.Task{ID: "task01", Version: 1, Steps: domain.Steps{{ID: "step01", Version: 2}}},
+			tags: tags,
+		},
+		{
+			name: "step_added",
+			task: &domain.Task{ID: "task01", Version: 1, Steps: domain.Steps{{ID: "step01", Version: 1}, {ID: "step02", Version: 1}}},
+			tags: tags,
+		},
+		{
+			name: "tag_updated",
+			task: base,
+			tags: domain.Tags{{ID: "tag01", Version: 2}, {ID: "tag02", Version: 1}},
+		},
+		{
+			name: "tag_removed",
+			task: base,
+			tags: domain.Tags{{ID: "tag01", Version: 1}},
+		},
+		{
+			name: "comment_added",
+			task: &domain.Task{ID: "task01", Version: 1, Steps: domain.Steps{{ID: "step01", Version: 1}}, CommentCount: 1},
+			tags: tags,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tt.task.ETag(tt.tags)
+			if tt.wantSame {
+				assert.Equal(t, want, got)
+				return
+			}
+			assert.NotEqual(t, want, got)
+		})
+	}
+}
+
+func TestTask_NextOccurrence(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name           string
+		recurrence     domain.RecurrenceRule
+		dueOn          plain.Date
+		wantDueOns     []plain.Date
+		wantRecurrence domain.RecurrenceRule
+	}{
+		{
+			// 月末で日が繰り上がった後も、元の日を基準に繰り返す
+			name:           "monthly_from_end_of_month",
+			recurrence:     "FREQ=MONTHLY",
+			dueOn:          plain.NewDate(2025, 1, 31),
+			wantDueOns:     []plain.Date{plain.NewDate(2025, 2, 28), plain.NewDate(2025, 3, 31), plain.NewDate(2025, 4, 30)},
+			wantRecurrence: "FREQ=MONTHLY;BYMONTHDAY=31",
+		},
+		{
+			name:           "monthly_with_month_day",
+			recurrence:     "FREQ=MONTHLY;BYMONTHDAY=30",
+			dueOn:          plain.NewDate(2025, 1, 31),
+			wantDueOns:     []plain.Date{plain.NewDate(2025, 2, 28), plain.NewDate(2025, 3, 30), plain.NewDate(2025, 4, 30)},
+			wantRecurrence: "FREQ=MONTHLY;BYMONTHDAY=30",
+		},
+		{
+			name:           "weekly",
+			recurrence:     "FREQ=WEEKLY;BYDAY=MO,TH",
+			dueOn:          plain.NewDate(2025, 1, 27),
+			wantDueOns:     []plain.Date{plain.NewDate(2025, 1, 30), plain.NewDate(2025, 2, 3), plain.NewDate(2025, 2, 6)},
+			wantRecurrence: "FREQ=WEEKLY;BYDAY=MO,TH",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := &domain.Task{ID: "task01", Recurrence: &tt.recurrence, DueOn: &tt.dueOn}
+			for _, want := range tt.wantDueOns {
+				next, err := task.NextOccurrence("task02", *task.DueOn, now)
+				require.NoError(t, err)
+				assert.Equal(t, want, *next.DueOn)
+				assert.Equal(t, tt.wantRecurrence, *next.Recurrence)
+				task = next
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package domain
+
+import "time"
+
+type TrashItemType string
+
+const (
+	TrashItemTypeProject TrashItemType = "project"
+	TrashItemTypeTask    TrashItemType = "task"
+	TrashItemTypeStep    TrashItemType = "step"
+	TrashItemTypeTag     TrashItemType = "tag"
+)
+
+// TrashItem はゴミ箱に入っているプロジェクト・タスク・ステップ・タグのいずれかである
+// ParentID はタスクの場合はプロジェクトのID、ステップの場合はタスクのIDであり、それ以外の場合は空である
+type TrashItem struct {
+	ID        string
+	UserID    UserID
+	Type      TrashItemType
+	ParentID  string
+	Name      string
+	DeletedAt time.Time
+}
+
+type TrashItems []TrashItem
@@ -3,6 +3,7 @@ package domain
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/minguu42/harmattan/internal/lib/errtrace"
 )
@@ -13,26 +14,73 @@ type User struct {
 	ID             UserID
 	Email          string
 	HashedPassword string
+	// EmailVerifiedAt はメールアドレスの確認が完了した日時であり、未確認の場合は nil である
+	EmailVerifiedAt *time.Time
+	// PendingEmail は変更を申請して確認を待っているメールアドレスであり、申請していない場合は空文字列である
+	PendingEmail string
+	// TOTPSecret は2段階認証に使うTOTPの秘密鍵であり、登録を開始していない場合は空文字列である
+	TOTPSecret string
+	// TOTPEnabledAt は2段階認証を有効にした日時であり、登録を確認するまでは nil である
+	TOTPEnabledAt *time.Time
+	// TokensValidAfter はこの日時より前に発行されたIDトークンを無効とする日時であり、サインアウトなどで更新する
+	TokensValidAfter *time.Time
+	// Language はエラーメッセージなどに使う言語であり、設定していない場合は空文字列である
+	Language  Language
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
-func (u *User) HasProject(p *Project) bool {
-	return u.ID == p.UserID
+// AcceptsIDTokenIssuedAt は発行日時が issuedAt のIDトークンが有効かを返す
+// IDトークンの発行日時は秒単位のため、TokensValidAfter も秒単位に切り捨てて比較する
+func (u *User) AcceptsIDTokenIssuedAt(issuedAt time.Time) bool {
+	if u.TokensValidAfter == nil {
+		return true
+	}
+	return !issuedAt.Before(u.TokensValidAfter.Truncate(time.Second))
 }
 
-func (u *User) HasTask(t *Task) bool {
-	return u.ID == t.UserID
+// TwoFactorEnabled は2段階認証が有効かを返す
+func (u *User) TwoFactorEnabled() bool {
+	return u.TOTPEnabledAt != nil
 }
 
-func (u *User) HasStep(s *Step) bool {
-	return u.ID == s.UserID
+// RoleIn はユーザのプロジェクト p におけるロールを返す
+// m はユーザのメンバー情報であり、メンバーでない場合は nil を渡す。ロールを持たない場合は空文字列を返す
+func (u *User) RoleIn(p *Project, m *ProjectMember) ProjectRole {
+	if u.ID == p.UserID {
+		return ProjectRoleOwner
+	}
+	if m != nil && m.ProjectID == p.ID && m.UserID == u.ID {
+		return m.Role
+	}
+	return ""
+}
+
+// EmailVerified はメールアドレスの確認が完了しているかを返す
+func (u *User) EmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+func (u *User) HasComment(c *Comment) bool {
+	return c.UserID != nil && u.ID == *c.UserID
 }
 
 func (u *User) HasTag(t *Tag) bool {
 	return u.ID == t.UserID
 }
 
+func (u *User) HasTrashItem(i *TrashItem) bool {
+	return u.ID == i.UserID
+}
+
+func (u *User) HasPersonalAccessToken(t *PersonalAccessToken) bool {
+	return u.ID == t.UserID
+}
+
 type userKey struct{}
 
+type authTimeKey struct{}
+
 func ContextWithUser(ctx context.Context, u *User) context.Context {
 	return context.WithValue(ctx, userKey{}, u)
 }
@@ -44,3 +92,15 @@ func UserFromContext(ctx context.Context) (*User, error) {
 	}
 	return user, nil
 }
+
+// ContextWithAuthTime はリクエストしたユーザが認証した日時 t を ctx に保存する
+func ContextWithAuthTime(ctx context.Context, t time.Time) context.Context {
+	return context.WithValue(ctx, authTimeKey{}, t)
+}
+
+// AuthTimeFromContext はリクエストしたユーザが認証した日時を返す
+// IDトークンに認証日時が含まれない場合やパーソナルアクセストークンで認証した場合はゼロ値を返す
+func AuthTimeFromContext(ctx context.Context) time.Time {
+	t, _ := ctx.Value(authTimeKey{}).(time.Time)
+	return t
+}
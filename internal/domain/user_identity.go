@@ -0,0 +1,17 @@
+package domain
+
+import "time"
+
+type UserIdentityID string
+
+// UserIdentity は外部のIDプロバイダのアカウントとユーザの連携であり、発行者と発行者内のユーザの識別子の組でアカウントを識別する
+type UserIdentity struct {
+	ID      UserIdentityID
+	UserID  UserID
+	Issuer  string
+	Subject string
+	// Email は最後にサインインしたときにIDプロバイダが返したメールアドレスであり、ユーザのメールアドレスとは一致しない場合がある
+	Email      string
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+}
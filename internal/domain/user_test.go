@@ -0,0 +1,53 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUser_AcceptsIDTokenIssuedAt(t *testing.T) {
+	t.Parallel()
+
+	validAfter := time.Date(2025, 1, 1, 0, 10, 0, 500_000_000, time.UTC)
+	tests := []struct {
+		name     string
+		user     *domain.User
+		issuedAt time.Time
+		want     bool
+	}{
+		{
+			name:     "never_revoked",
+			user:     &domain.User{ID: "user01"},
+			issuedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			want:     true,
+		},
+		{
+			name:     "issued_before",
+			user:     &domain.User{ID: "user01", TokensValidAfter: &validAfter},
+			issuedAt: time.Date(2025, 1, 1, 0, 9, 59, 0, time.UTC),
+			want:     false,
+		},
+		{
+			name:     "issued_in_same_second",
+			user:     &domain.User{ID: "user01", TokensValidAfter: &validAfter},
+			issuedAt: time.Date(2025, 1, 1, 0, 10, 0, 0, time.UTC),
+			want:     true,
+		},
+		{
+			name:     "issued_after",
+			user:     &domain.User{ID: "user01", TokensValidAfter: &validAfter},
+			issuedAt: time.Date(2025, 1, 1, 0, 10, 1, 0, time.UTC),
+			want:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.user.AcceptsIDTokenIssuedAt(tt.issuedAt))
+		})
+	}
+}
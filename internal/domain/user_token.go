@@ -0,0 +1,36 @@
+package domain
+
+import "time"
+
+type UserTokenID string
+
+type UserTokenPurpose string
+
+const (
+	UserTokenPurposePasswordReset     UserTokenPurpose = "password_reset"
+	UserTokenPurposeEmailVerification UserTokenPurpose = "email_verification"
+	// UserTokenPurposeTwoFactorChallenge はパスワードの検証後に2段階認証の認証コードを検証するまでの間だけ使うトークンであり、メールでは送らない
+	UserTokenPurposeTwoFactorChallenge UserTokenPurpose = "two_factor_challenge"
+)
+
+// UserToken はパスワードの再設定やメールアドレスの確認などに使う1回限りのトークンであり、データベースには平文ではなくハッシュ値を保存する
+type UserToken struct {
+	ID      UserTokenID
+	UserID  UserID
+	Purpose UserTokenPurpose
+	// Email はトークンを発行したときのユーザのメールアドレスであり、発行後にユーザのメールアドレスが変わった場合はトークンを無効とする
+	Email     string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// IsValidFor はトークンが now の時点でユーザ u に対して有効かを返す
+// メールアドレス確認用のトークンは、変更待ちのメールアドレスに送ったものも有効とする
+func (t *UserToken) IsValidFor(u *User, now time.Time) bool {
+	if t.UsedAt != nil || !now.Before(t.ExpiresAt) || t.UserID != u.ID {
+		return false
+	}
+	return t.Email == u.Email || t.Purpose == UserTokenPurposeEmailVerification && t.Email == u.PendingEmail
+}
@@ -0,0 +1,65 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserToken_IsValidFor(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 0, 10, 0, 0, time.UTC)
+	usedAt := time.Date(2025, 1, 1, 0, 5, 0, 0, time.UTC)
+	user := &domain.User{ID: "user01", Email: "user01@dummy.invalid", PendingEmail: "new@dummy.invalid"}
+	tests := []struct {
+		name  string
+		token *domain.UserToken
+		want  bool
+	}{
+		{
+			name:  "valid",
+			token: &domain.UserToken{UserID: "user01", Email: "user01@dummy.invalid", ExpiresAt: now.Add(time.Second)},
+			want:  true,
+		},
+		{
+			name:  "used",
+			token: &domain.UserToken{UserID: "user01", Email: "user01@dummy.invalid", ExpiresAt: now.Add(time.Second), UsedAt: &usedAt},
+			want:  false,
+		},
+		{
+			name:  "expired",
+			token: &domain.UserToken{UserID: "user01", Email: "user01@dummy.invalid", ExpiresAt: now},
+			want:  false,
+		},
+		{
+			name:  "other_user",
+			token: &domain.UserToken{UserID: "user02", Email: "user01@dummy.invalid", ExpiresAt: now.Add(time.Second)},
+			want:  false,
+		},
+		{
+			name:  "email_changed",
+			token: &domain.UserToken{UserID: "user01", Email: "old@dummy.invalid", ExpiresAt: now.Add(time.Second)},
+			want:  false,
+		},
+		{
+			name:  "pending_email_verification",
+			token: &domain.UserToken{UserID: "user01", Purpose: domain.UserTokenPurposeEmailVerification, Email: "new@dummy.invalid", ExpiresAt: now.Add(time.Second)},
+			want:  true,
+		},
+		{
+			name:  "pending_email_password_reset",
+			token: &domain.UserToken{UserID: "user01", Purpose: domain.UserTokenPurposePasswordReset, Email: "new@dummy.invalid", ExpiresAt: now.Add(time.Second)},
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.token.IsValidFor(user, now))
+		})
+	}
+}
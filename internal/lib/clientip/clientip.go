@@ -0,0 +1,28 @@
+package clientip
+
+import (
+	"context"
+	"net"
+	"net/http"
+)
+
+type ipKey struct{}
+
+// FromRequest はリクエスト元のIPアドレスを返す
+func FromRequest(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func WithIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, ipKey{}, ip)
+}
+
+// FromContext は WithIP で付与したIPアドレスを返し、付与されていない場合は空文字列を返す
+func FromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(ipKey{}).(string)
+	return ip
+}
@@ -0,0 +1,34 @@
+package clientip_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/minguu42/harmattan/internal/lib/clientip"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromRequest(t *testing.T) {
+	tests := []struct {
+		name       string
+		remoteAddr string
+		want       string
+	}{
+		{name: "ipv4", remoteAddr: "192.0.2.1:12345", want: "192.0.2.1"},
+		{name: "ipv6", remoteAddr: "[2001:db8::1]:12345", want: "2001:db8::1"},
+		{name: "no_port", remoteAddr: "192.0.2.1", want: "192.0.2.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			r.RemoteAddr = tt.remoteAddr
+
+			assert.Equal(t, tt.want, clientip.FromRequest(r))
+		})
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	assert.Equal(t, "192.0.2.1", clientip.FromContext(clientip.WithIP(t.Context(), "192.0.2.1")))
+	assert.Empty(t, clientip.FromContext(t.Context()))
+}
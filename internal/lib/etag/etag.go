@@ -0,0 +1,42 @@
+package etag
+
+import "strings"
+
+// Strong は opaque を強いエンティティタグの形式にして返す
+func Strong(opaque string) string {
+	return `"` + opaque + `"`
+}
+
+// MatchStrong は If-Match ヘッダの値 header が tag と強い比較で一致するかを返す
+func MatchStrong(header, tag string) bool {
+	return match(header, tag, false)
+}
+
+// MatchWeak は If-None-Match ヘッダの値 header が tag と弱い比較で一致するかを返す
+func MatchWeak(header, tag string) bool {
+	return match(header, tag, true)
+}
+
+func match(header, tag string, weak bool) bool {
+	if strings.TrimSpace(header) == "*" {
+		return true
+	}
+	if weak {
+		tag = strings.TrimPrefix(tag, "W/")
+	} else if strings.HasPrefix(tag, "W/") {
+		return false
+	}
+
+	for t := range strings.SplitSeq(header, ",") {
+		t = strings.TrimSpace(t)
+		if weak {
+			t = strings.TrimPrefix(t, "W/")
+		} else if strings.HasPrefix(t, "W/") {
+			continue
+		}
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
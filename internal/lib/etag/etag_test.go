@@ -0,0 +1,55 @@
+package etag_test
+
+import (
+	"testing"
+
+	"github.com/minguu42/harmattan/internal/lib/etag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStrong(t *testing.T) {
+	assert.Equal(t, `"3"`, etag.Strong("3"))
+}
+
+func TestMatchStrong(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		tag    string
+		want   bool
+	}{
+		{name: "same", header: `"3"`, tag: `"3"`, want: true},
+		{name: "different", header: `"2"`, tag: `"3"`, want: false},
+		{name: "any", header: "*", tag: `"3"`, want: true},
+		{name: "list", header: `"1", "3"`, tag: `"3"`, want: true},
+		{name: "weak_header", header: `W/"3"`, tag: `"3"`, want: false},
+		{name: "weak_tag", header: `"3"`, tag: `W/"3"`, want: false},
+		{name: "unquoted", header: "3", tag: `"3"`, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, etag.MatchStrong(tt.header, tt.tag))
+		})
+	}
+}
+
+func TestMatchWeak(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		tag    string
+		want   bool
+	}{
+		{name: "same", header: `"3"`, tag: `"3"`, want: true},
+		{name: "different", header: `"2"`, tag: `"3"`, want: false},
+		{name: "any", header: "*", tag: `"3"`, want: true},
+		{name: "list", header: `"1",W/"3"`, tag: `"3"`, want: true},
+		{name: "weak_header", header: `W/"3"`, tag: `"3"`, want: true},
+		{name: "weak_tag", header: `"3"`, tag: `W/"3"`, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, etag.MatchWeak(tt.header, tt.tag))
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Package fracindex は要素の並び順を文字列の辞書順で表すためのキーを生成する
+// キーは0から9とaからzの36種類の文字からなる、末尾が0でない空でない文字列であり、0と1の間の36進数の小数部分とみなせる
+package fracindex
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+const digits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// Valid は s がキーとして有効かを返す
+func Valid(s string) bool {
+	if s == "" || s[len(s)-1] == digits[0] {
+		return false
+	}
+	for i := range len(s) {
+		if strings.IndexByte(digits, s[i]) == -1 {
+			return false
+		}
+	}
+	return true
+}
+
+// Between は a と b の間に並ぶキーを返す
+// a が空の場合は b より前、b が空の場合は a より後に並ぶキーを返す
+func Between(a, b string) (string, error) {
+	if a != "" && !Valid(a) {
+		return "", errtrace.Wrap(fmt.Errorf("invalid key: %q", a))
+	}
+	if b != "" && !Valid(b) {
+		return "", errtrace.Wrap(fmt.Errorf("invalid key: %q", b))
+	}
+
+	switch {
+	case a == "" && b == "":
+		return midpoint("", "", true), nil
+	case b == "":
+		// 末尾への追加が続いてもキーが長くなりにくいように、最初の z でない文字を1つ進める
+		for i := range len(a) {
+			if a[i] != digits[len(digits)-1] {
+				return a[:i] + string(digits[strings.IndexByte(digits, a[i])+1]), nil
+			}
+		}
+		return a + midpoint("", "", true), nil
+	case a == "":
+		// 先頭への追加が続いてもキーが長くなりにくいように、最初の1より大きい文字を1つ戻す
+		for i := range len(b) {
+			if b[i] > digits[1] {
+				return b[:i] + string(digits[strings.IndexByte(digits, b[i])-1]), nil
+			}
+		}
+		return b[:len(b)-1] + string(digits[0]) + midpoint("", "", true), nil
+	case a >= b:
+		return "", errtrace.Wrap(errors.New("a must be less than b"))
+	default:
+		return midpoint(a, b, false), nil
+	}
+}
+
+// midpoint は a と b のおおよそ中間に並ぶキーを返す
+// bInf が true の場合、b は最後のキーよりも後ろを表す
+func midpoint(a, b string, bInf bool) string {
+	if !bInf {
+		n := 0
+		for n < len(b) && digitAt(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			return b[:n] + midpoint(suffix(a, n), b[n:], false)
+		}
+	}
+
+	da := strings.IndexByte(digits, digitAt(a, 0))
+	db := len(digits)
+	if !bInf {
+		db = strings.IndexByte(digits, b[0])
+	}
+	if db-da > 1 {
+		return string(digits[(da+db+1)/2])
+	}
+	if !bInf && len(b) > 1 {
+		return b[:1]
+	}
+	return string(digits[da]) + midpoint(suffix(a, 1), "", true)
+}
+
+func digitAt(s string, i int) byte {
+	if i < len(s) {
+		return s[i]
+	}
+	return digits[0]
+}
+
+func suffix(s string, i int) string {
+	if i < len(s) {
+		return s[i:]
+	}
+	return ""
+}
+
+// Spread は n 個の要素に対して、等間隔に並ぶ昇順のキーを返す
+func Spread(n int) []string {
+	// 振り直した後も各要素の間に新しいキーを短いまま挿入できるように、要素数の8倍以上の値を表せる桁数を使う
+	width, capacity := 1, len(digits)
+	for capacity < (n+1)*8 {
+		width++
+		capacity *= len(digits)
+	}
+
+	keys := make([]string, 0, n)
+	for i := range n {
+		v := (i + 1) * capacity / (n + 1)
+		b := make([]byte, width)
+		for j := width - 1; j >= 0; j-- {
+			b[j] = digits[v%len(digits)]
+			v /= len(digits)
+		}
+		keys = append(keys, strings.TrimRight(string(b), digits[:1]))
+	}
+	return keys
+}
@@ -0,0 +1,129 @@
+package fracindex_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/minguu42/harmattan/internal/lib/fracindex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		s    string
+		want bool
+	}{
+		{name: "single", s: "i", want: true},
+		{name: "multiple", s: "0a9z", want: true},
+		{name: "empty", s: "", want: false},
+		{name: "trailing_zero", s: "a0", want: false},
+		{name: "uppercase", s: "A", want: false},
+		{name: "symbol", s: "a-b", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, fracindex.Valid(tt.s))
+		})
+	}
+}
+
+func TestBetween(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		a       string
+		b       string
+		want    string
+		wantErr bool
+	}{
+		{name: "first", a: "", b: "", want: "i"},
+		{name: "append", a: "i", b: "", want: "j"},
+		{name: "append_after_z", a: "zk", b: "", want: "zl"},
+		{name: "append_after_all_z", a: "zz", b: "", want: "zzi"},
+		{name: "prepend", a: "", b: "i", want: "h"},
+		{name: "prepend_before_1", a: "", b: "1", want: "0i"},
+		{name: "prepend_before_01", a: "", b: "01", want: "00i"},
+		{name: "middle", a: "a", b: "c", want: "b"},
+		{name: "adjacent", a: "a", b: "b", want: "ai"},
+		{name: "common_prefix", a: "ab", b: "ad", want: "ac"},
+		{name: "shorter_a", a: "a", b: "a1", want: "a0i"},
+		{name: "longer_a", a: "49", b: "5", want: "4n"},
+		{name: "longer_b", a: "4", b: "4z5", want: "4i"},
+		{name: "invalid_a", a: "a0", b: "", wantErr: true},
+		{name: "invalid_b", a: "", b: "A", wantErr: true},
+		{name: "same", a: "a", b: "a", wantErr: true},
+		{name: "reversed", a: "b", b: "a", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := fracindex.Between(tt.a, tt.b)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.True(t, fracindex.Valid(got))
+			assert.True(t, tt.a < got && (tt.b == "" || got < tt.b))
+		})
+	}
+}
+
+func TestBetween_repeated(t *testing.T) {
+	t.Parallel()
+
+	// 同じ位置への挿入を繰り返しても常に前後のキーの間に並ぶ
+	a, b := "a", "b"
+	for range 200 {
+		got, err := fracindex.Between(a, b)
+		require.NoError(t, err)
+		require.True(t, a < got && got < b, "a=%q, got=%q, b=%q", a, got, b)
+		b = got
+	}
+}
+
+func TestSpread(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		n    int
+		want []string
+	}{
+		{name: "zero", n: 0, want: []string{}},
+		{name: "one", n: 1, want: []string{"i"}},
+		{name: "three", n: 3, want: []string{"9", "i", "r"}},
+		{name: "five", n: 5, want: []string{"6", "c", "i", "o", "u"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fracindex.Spread(tt.n)
+			assert.Equal(t, tt.want, got)
+			assert.True(t, slices.IsSorted(got))
+		})
+	}
+
+	t.Run("many", func(t *testing.T) {
+		t.Parallel()
+
+		got := fracindex.Spread(1000)
+		assert.Len(t, got, 1000)
+		assert.True(t, slices.IsSorted(got))
+		assert.Len(t, slices.Compact(slices.Clone(got)), 1000)
+		for _, k := range got {
+			assert.True(t, fracindex.Valid(k), k)
+			assert.LessOrEqual(t, len(k), 3)
+		}
+	})
+}
@@ -0,0 +1,87 @@
+// Package totp はRFC 6238のTOTP(Time-Based One-Time Password)を扱う
+// 認証アプリとの互換性のため、アルゴリズムはHMAC-SHA1、桁数は6桁、時間ステップは30秒に固定する
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+const (
+	digits = 6
+	period = 30
+	// skew は端末との時刻のずれを許容する前後の時間ステップの数である
+	skew = 1
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Step は時刻 t が属する時間ステップを返す
+func Step(t time.Time) int64 {
+	return t.Unix() / period
+}
+
+// Code は秘密鍵 secret と時間ステップ step から認証コードを求める
+// secret はパディングなしのBase32でエンコードした秘密鍵である
+func Code(secret string, step int64) (string, error) {
+	key, err := encoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", errtrace.Wrap(err)
+	}
+
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", digits, value%1_000_000), nil
+}
+
+// Validate は認証コード code が時刻 now において有効かを検証し、一致した時間ステップを返す
+// 前後 skew ステップまでのずれを許容する。同じ認証コードの再利用を防ぐため、呼び出し側は一致した時間ステップを記録して以前のステップ以下のものを拒否する
+func Validate(secret, code string, now time.Time) (int64, bool, error) {
+	if len(code) != digits {
+		return 0, false, nil
+	}
+
+	current := Step(now)
+	for step := current - skew; step <= current+skew; step++ {
+		want, err := Code(secret, step)
+		if err != nil {
+			return 0, false, errtrace.Wrap(err)
+		}
+		if subtle.ConstantTimeCompare([]byte(want), []byte(code)) == 1 {
+			return step, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// URI は認証アプリに秘密鍵を登録するためのotpauth URIを返す
+// 多くの認証アプリはこのURIをQRコードとして読み取る
+func URI(issuer, account, secret string) string {
+	q := url.Values{}
+	q.Set("secret", secret)
+	q.Set("issuer", issuer)
+	q.Set("algorithm", "SHA1")
+	q.Set("digits", fmt.Sprint(digits))
+	q.Set("period", fmt.Sprint(period))
+	return "otpauth://totp/" + url.PathEscape(issuer) + ":" + url.PathEscape(account) + "?" + q.Encode()
+}
+
+// EncodeSecret は秘密鍵をパディングなしのBase32でエンコードする
+func EncodeSecret(key []byte) string {
+	return encoding.EncodeToString(key)
+}
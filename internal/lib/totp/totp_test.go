@@ -0,0 +1,85 @@
+package totp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/lib/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// secret はRFC 6238のテストベクトルで使われるSHA-1用の秘密鍵"12345678901234567890"をBase32でエンコードしたもの
+var secret = totp.EncodeSecret([]byte("12345678901234567890"))
+
+func TestCode(t *testing.T) {
+	t.Parallel()
+
+	// 期待値はRFC 6238 Appendix Bの8桁の認証コードの下6桁である
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+		{unix: 20000000000, want: "353130"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := totp.Code(secret, totp.Step(time.Unix(tt.unix, 0)))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1111111109, 0)
+	tests := []struct {
+		name     string
+		code     string
+		wantStep int64
+		wantOK   bool
+	}{
+		{name: "current_step", code: "081804", wantStep: 37037036, wantOK: true},
+		{name: "previous_step", code: mustCode(t, 37037035), wantStep: 37037035, wantOK: true},
+		{name: "next_step", code: mustCode(t, 37037037), wantStep: 37037037, wantOK: true},
+		{name: "too_old", code: mustCode(t, 37037034), wantOK: false},
+		{name: "too_new", code: mustCode(t, 37037038), wantOK: false},
+		{name: "wrong_length", code: "81804", wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			step, ok, err := totp.Validate(secret, tt.code, now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantStep, step)
+		})
+	}
+}
+
+func TestURI(t *testing.T) {
+	t.Parallel()
+
+	got := totp.URI("Harmattan", "user1@dummy.invalid", "JBSWY3DPEHPK3PXP")
+
+	want := "otpauth://totp/Harmattan:user1@dummy.invalid?algorithm=SHA1&digits=6&issuer=Harmattan&period=30&secret=JBSWY3DPEHPK3PXP"
+	assert.Equal(t, want, got)
+}
+
+func mustCode(t *testing.T, step int64) string {
+	t.Helper()
+
+	code, err := totp.Code(secret, step)
+	require.NoError(t, err)
+	return code
+}
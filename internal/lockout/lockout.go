@@ -0,0 +1,111 @@
+package lockout
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/clock"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+// Store はサインインの失敗の記録を保存する
+type Store interface {
+	GetSignInAttempt(ctx context.Context, subject string) (*domain.SignInAttempt, error)
+	RecordSignInFailure(ctx context.Context, subject string, failedAt time.Time, resetAfter time.Duration) (*domain.SignInAttempt, error)
+	DeleteSignInAttempt(ctx context.Context, subject string) error
+}
+
+// Policy は連続した失敗回数に応じたサインインの制限方法である
+type Policy struct {
+	FreeAttempts int
+	// BaseDelay は失敗するたびに2倍になり、MaxDelay で頭打ちになる
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+	ResetAfter time.Duration
+}
+
+var (
+	EmailPolicy = Policy{FreeAttempts: 5, BaseDelay: 1 * time.Second, MaxDelay: 15 * time.Minute, ResetAfter: 1 * time.Hour}
+	// IPPolicy は同じIPアドレスを複数のユーザで共有する場合を考慮し、EmailPolicy より多くの失敗を許容する
+	IPPolicy = Policy{FreeAttempts: 20, BaseDelay: 1 * time.Second, MaxDelay: 15 * time.Minute, ResetAfter: 1 * time.Hour}
+)
+
+// Delay は最後の失敗から次の試行まで待たせる時間を返す
+func (p Policy) Delay(a *domain.SignInAttempt) time.Duration {
+	if a.Failures < p.FreeAttempts {
+		return 0
+	}
+	d := p.BaseDelay
+	for range a.Failures - p.FreeAttempts {
+		if d >= p.MaxDelay {
+			break
+		}
+		d *= 2
+	}
+	return min(d, p.MaxDelay)
+}
+
+// RetryAfter は now の時点から制限が解除されるまでの時間を返す
+func (p Policy) RetryAfter(a *domain.SignInAttempt, now time.Time) time.Duration {
+	if !now.Before(a.LastFailedAt.Add(p.ResetAfter)) {
+		return 0
+	}
+	return max(a.LastFailedAt.Add(p.Delay(a)).Sub(now), 0)
+}
+
+func NewGuard(store Store, emailPolicy, ipPolicy Policy) *Guard {
+	return &Guard{store: store, emailPolicy: emailPolicy, ipPolicy: ipPolicy}
+}
+
+// Guard はメールアドレスとIPアドレスごとにサインインの失敗を記録して制限する
+type Guard struct {
+	store       Store
+	emailPolicy Policy
+	ipPolicy    Policy
+}
+
+// Check はサインインの制限が解除されるまでの時間を返す
+func (g *Guard) Check(ctx context.Context, email, ip string) (time.Duration, error) {
+	now := clock.Now(ctx)
+	var retryAfter time.Duration
+	for subject, p := range g.subjects(email, ip) {
+		a, err := g.store.GetSignInAttempt(ctx, subject)
+		if err != nil {
+			return 0, errtrace.Wrap(err)
+		}
+		retryAfter = max(retryAfter, p.RetryAfter(a, now))
+	}
+	return retryAfter, nil
+}
+
+func (g *Guard) RecordFailure(ctx context.Context, email, ip string) error {
+	now := clock.Now(ctx)
+	for subject, p := range g.subjects(email, ip) {
+		if _, err := g.store.RecordSignInFailure(ctx, subject, now, p.ResetAfter); err != nil {
+			return errtrace.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// RecordSuccess はメールアドレスの失敗の記録のみを削除し、攻撃者が自身のアカウントでIPアドレスの記録を消せないようにする
+func (g *Guard) RecordSuccess(ctx context.Context, email string) error {
+	if err := g.store.DeleteSignInAttempt(ctx, emailSubject(email)); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func (g *Guard) subjects(email, ip string) map[string]Policy {
+	subjects := map[string]Policy{emailSubject(email): g.emailPolicy}
+	if ip != "" {
+		subjects["ip:"+ip] = g.ipPolicy
+	}
+	return subjects
+}
+
+func emailSubject(email string) string {
+	return "email:" + strings.ToLower(email)
+}
@@ -0,0 +1,123 @@
+package lockout_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/clock"
+	"github.com/minguu42/harmattan/internal/lockout"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var policy = lockout.Policy{FreeAttempts: 3, BaseDelay: 1 * time.Second, MaxDelay: 10 * time.Second, ResetAfter: 1 * time.Minute}
+
+func TestPolicy_Delay(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		failures int
+		want     time.Duration
+	}{
+		{name: "no_failure", failures: 0, want: 0},
+		{name: "below_free_attempts", failures: 2, want: 0},
+		{name: "free_attempts", failures: 3, want: 1 * time.Second},
+		{name: "doubled", failures: 4, want: 2 * time.Second},
+		{name: "doubled_twice", failures: 5, want: 4 * time.Second},
+		{name: "capped", failures: 7, want: 10 * time.Second},
+		{name: "many_failures", failures: 1000, want: 10 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, policy.Delay(&domain.SignInAttempt{Failures: tt.failures}))
+		})
+	}
+}
+
+func TestPolicy_RetryAfter(t *testing.T) {
+	t.Parallel()
+
+	lastFailedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		failures int
+		now      time.Time
+		want     time.Duration
+	}{
+		{name: "below_free_attempts", failures: 2, now: lastFailedAt, want: 0},
+		{name: "delayed", failures: 5, now: lastFailedAt.Add(1 * time.Second), want: 3 * time.Second},
+		{name: "delay_elapsed", failures: 5, now: lastFailedAt.Add(4 * time.Second), want: 0},
+		{name: "locked_out", failures: 10, now: lastFailedAt.Add(5 * time.Second), want: 5 * time.Second},
+		{name: "reset", failures: 10, now: lastFailedAt.Add(1 * time.Minute), want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			a := &domain.SignInAttempt{Failures: tt.failures, LastFailedAt: lastFailedAt}
+			assert.Equal(t, tt.want, policy.RetryAfter(a, tt.now))
+		})
+	}
+}
+
+func TestGuard(t *testing.T) {
+	t.Parallel()
+
+	ipPolicy := lockout.Policy{FreeAttempts: 5, BaseDelay: 1 * time.Second, MaxDelay: 10 * time.Second, ResetAfter: 1 * time.Minute}
+	g := lockout.NewGuard(lockout.NewMemoryStore(), policy, ipPolicy)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := clock.WithFixedNow(t.Context(), now)
+
+	for range 3 {
+		require.NoError(t, g.RecordFailure(ctx, "User1@dummy.invalid", "192.0.2.1"))
+	}
+	retryAfter, err := g.Check(ctx, "user1@dummy.invalid", "192.0.2.2")
+	require.NoError(t, err)
+	assert.Equal(t, 1*time.Second, retryAfter, "メールアドレスの大文字と小文字は区別しない")
+
+	for range 2 {
+		require.NoError(t, g.RecordFailure(ctx, "user2@dummy.invalid", "192.0.2.1"))
+	}
+	retryAfter, err = g.Check(ctx, "user3@dummy.invalid", "192.0.2.1")
+	require.NoError(t, err)
+	assert.Equal(t, 1*time.Second, retryAfter, "異なるメールアドレスでも同じIPアドレスからの失敗は合算する")
+
+	require.NoError(t, g.RecordSuccess(ctx, "user1@dummy.invalid"))
+	retryAfter, err = g.Check(ctx, "user1@dummy.invalid", "")
+	require.NoError(t, err)
+	assert.Zero(t, retryAfter, "サインインに成功するとメールアドレスの記録を削除する")
+	retryAfter, err = g.Check(ctx, "user1@dummy.invalid", "192.0.2.1")
+	require.NoError(t, err)
+	assert.Equal(t, 1*time.Second, retryAfter, "サインインに成功してもIPアドレスの記録は削除しない")
+
+	retryAfter, err = g.Check(clock.WithFixedNow(t.Context(), now.Add(1*time.Second)), "user3@dummy.invalid", "192.0.2.1")
+	require.NoError(t, err)
+	assert.Zero(t, retryAfter)
+}
+
+func TestMemoryStore_RecordSignInFailure(t *testing.T) {
+	t.Parallel()
+
+	s := lockout.NewMemoryStore()
+	failedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	got, err := s.RecordSignInFailure(t.Context(), "email:user1@dummy.invalid", failedAt, 1*time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, &domain.SignInAttempt{Subject: "email:user1@dummy.invalid", Failures: 1, LastFailedAt: failedAt}, got)
+
+	got, err = s.RecordSignInFailure(t.Context(), "email:user1@dummy.invalid", failedAt.Add(59*time.Second), 1*time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, &domain.SignInAttempt{Subject: "email:user1@dummy.invalid", Failures: 2, LastFailedAt: failedAt.Add(59 * time.Second)}, got)
+
+	got, err = s.RecordSignInFailure(t.Context(), "email:user1@dummy.invalid", failedAt.Add(119*time.Second), 1*time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, &domain.SignInAttempt{Subject: "email:user1@dummy.invalid", Failures: 1, LastFailedAt: failedAt.Add(119 * time.Second)}, got)
+
+	got, err = s.GetSignInAttempt(t.Context(), "email:user2@dummy.invalid")
+	require.NoError(t, err)
+	assert.Equal(t, &domain.SignInAttempt{Subject: "email:user2@dummy.invalid"}, got)
+}
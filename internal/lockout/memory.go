@@ -0,0 +1,70 @@
+package lockout
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+)
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{attempts: make(map[string]memoryAttempt)}
+}
+
+// MemoryStore はサインインの失敗の記録をメモリ上に保持し、APIサーバ間では共有しない
+type MemoryStore struct {
+	mu       sync.Mutex
+	attempts map[string]memoryAttempt
+	sweptAt  time.Time
+}
+
+type memoryAttempt struct {
+	attempt    domain.SignInAttempt
+	resetAfter time.Duration
+}
+
+func (s *MemoryStore) GetSignInAttempt(_ context.Context, subject string) (*domain.SignInAttempt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if a, ok := s.attempts[subject]; ok {
+		return new(a.attempt), nil
+	}
+	return &domain.SignInAttempt{Subject: subject}, nil
+}
+
+func (s *MemoryStore) RecordSignInFailure(_ context.Context, subject string, failedAt time.Time, resetAfter time.Duration) (*domain.SignInAttempt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sweep(failedAt)
+
+	a, ok := s.attempts[subject]
+	if !ok || !failedAt.Before(a.attempt.LastFailedAt.Add(resetAfter)) {
+		a.attempt = domain.SignInAttempt{Subject: subject}
+	}
+	a.attempt.Failures++
+	a.attempt.LastFailedAt = failedAt
+	a.resetAfter = resetAfter
+	s.attempts[subject] = a
+	return new(a.attempt), nil
+}
+
+func (s *MemoryStore) DeleteSignInAttempt(_ context.Context, subject string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.attempts, subject)
+	return nil
+}
+
+// sweep は失敗回数を0に戻す時間が経過した記録を1分に1回まで削除する
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Before(s.sweptAt.Add(1 * time.Minute)) {
+		return
+	}
+	for subject, a := range s.attempts {
+		if !now.Before(a.attempt.LastFailedAt.Add(a.resetAfter)) {
+			delete(s.attempts, subject)
+		}
+	}
+	s.sweptAt = now
+}
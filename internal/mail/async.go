@@ -0,0 +1,57 @@
+package mail
+
+import (
+	"context"
+	"errors"
+
+	"github.com/minguu42/harmattan/internal/atel"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+func NewAsyncMailer(m Mailer, queueSize int) *AsyncMailer {
+	am := &AsyncMailer{
+		mailer: m,
+		queue:  make(chan asyncMessage, queueSize),
+		done:   make(chan struct{}),
+	}
+	go am.run()
+	return am
+}
+
+// AsyncMailer は送信待ちの列にメールを追加してすぐに返り、バックグラウンドで1通ずつ送信する
+// 送信に失敗したメールはログに記録して破棄する
+type AsyncMailer struct {
+	mailer Mailer
+	queue  chan asyncMessage
+	done   chan struct{}
+}
+
+type asyncMessage struct {
+	ctx context.Context
+	msg *Message
+}
+
+// Send は送信待ちの列がいっぱいの場合にエラーを返す
+func (m *AsyncMailer) Send(ctx context.Context, msg *Message) error {
+	select {
+	case m.queue <- asyncMessage{ctx: context.WithoutCancel(ctx), msg: msg}:
+		return nil
+	default:
+		return errtrace.Wrap(errors.New("mail queue is full"))
+	}
+}
+
+// Close は送信待ちのメールをすべて送信してから返る
+func (m *AsyncMailer) Close() {
+	close(m.queue)
+	<-m.done
+}
+
+func (m *AsyncMailer) run() {
+	defer close(m.done)
+	for am := range m.queue {
+		if err := m.mailer.Send(am.ctx, am.msg); err != nil {
+			atel.ErrorLog(am.ctx, "Failed to send mail", err)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/minguu42/harmattan/internal/lib/clock"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+	"github.com/minguu42/harmattan/internal/lib/idgen"
+)
+
+func NewFileMailer(dir, from string) (*FileMailer, error) {
+	if dir == "" {
+		return nil, errtrace.Wrap(errors.New("mail directory is required"))
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return &FileMailer{dir: dir, from: from}, nil
+}
+
+// FileMailer はメールを送信せずにディレクトリに1通ずつ.emlファイルとして書き出す
+// ローカル環境でメールの内容を確認するために使う
+type FileMailer struct {
+	dir  string
+	from string
+}
+
+func (m *FileMailer) Send(ctx context.Context, msg *Message) error {
+	path := filepath.Join(m.dir, idgen.ULID(ctx)+".eml")
+	if err := os.WriteFile(path, msg.build(m.from, clock.Now(ctx)), 0o644); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
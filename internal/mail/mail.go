@@ -0,0 +1,46 @@
+// Package mail はユーザへのメールの送信を扱う
+package mail
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"mime"
+	"time"
+)
+
+// Message は送信するメールを表す
+// 本文はプレーンテキストのみに対応する
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer はメールを送信する
+// 本番環境では SMTPMailer を、ローカル環境やテストでは FileMailer や MemoryMailer を使う
+type Mailer interface {
+	Send(ctx context.Context, m *Message) error
+}
+
+// build は差出人を from としてメッセージをRFC 5322形式に変換する
+// 件名と本文には日本語を含められるように、件名はMIMEエンコードし、本文はBase64でエンコードする
+func (m *Message) build(from string, date time.Time) []byte {
+	var b bytes.Buffer
+	b.WriteString("From: " + from + "\r\n")
+	b.WriteString("To: " + m.To + "\r\n")
+	b.WriteString("Subject: " + mime.BEncoding.Encode("UTF-8", m.Subject) + "\r\n")
+	b.WriteString("Date: " + date.Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: base64\r\n")
+	b.WriteString("\r\n")
+
+	body := base64.StdEncoding.EncodeToString([]byte(m.Body))
+	for len(body) > 76 {
+		b.WriteString(body[:76] + "\r\n")
+		body = body[76:]
+	}
+	b.WriteString(body + "\r\n")
+	return b.Bytes()
+}
@@ -0,0 +1,73 @@
+package mail_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/lib/clock"
+	"github.com/minguu42/harmattan/internal/lib/idgen"
+	"github.com/minguu42/harmattan/internal/mail"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileMailer_Send(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "mail")
+	m, err := mail.NewFileMailer(dir, "noreply@dummy.invalid")
+	require.NoError(t, err)
+
+	ctx := clock.WithFixedNow(t.Context(), time.Date(2025, 1, 1, 0, 0, 0, 0, time.FixedZone("JST", 9*60*60)))
+	ctx = idgen.WithFixedULID(ctx, "MAIL-000000000000000000001")
+	require.NoError(t, m.Send(ctx, &mail.Message{
+		To:      "user1@dummy.invalid",
+		Subject: "パスワードの再設定",
+		Body:    "以下のURLからパスワードを再設定してください。\nhttps://dummy.invalid/password-reset?token=xxx\n",
+	}))
+
+	got, err := os.ReadFile(filepath.Join(dir, "MAIL-000000000000000000001.eml"))
+	require.NoError(t, err)
+	want := "From: noreply@dummy.invalid\r\n" +
+		"To: user1@dummy.invalid\r\n" +
+		"Subject: =?UTF-8?b?44OR44K544Ov44O844OJ44Gu5YaN6Kit5a6a?=\r\n" +
+		"Date: Wed, 01 Jan 2025 00:00:00 +0900\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: text/plain; charset=UTF-8\r\n" +
+		"Content-Transfer-Encoding: base64\r\n" +
+		"\r\n" +
+		"5Lul5LiL44GuVVJM44GL44KJ44OR44K544Ov44O844OJ44KS5YaN6Kit5a6a44GX44Gm44GP44Gg\r\n" +
+		"44GV44GE44CCCmh0dHBzOi8vZHVtbXkuaW52YWxpZC9wYXNzd29yZC1yZXNldD90b2tlbj14eHgK\r\n"
+	assert.Equal(t, want, string(got))
+}
+
+func TestMemoryMailer(t *testing.T) {
+	t.Parallel()
+
+	m := mail.NewMemoryMailer()
+	require.NoError(t, m.Send(t.Context(), &mail.Message{To: "user1@dummy.invalid", Subject: "件名1", Body: "本文1"}))
+	require.NoError(t, m.Send(t.Context(), &mail.Message{To: "user2@dummy.invalid", Subject: "件名2", Body: "本文2"}))
+	assert.Equal(t, []mail.Message{
+		{To: "user1@dummy.invalid", Subject: "件名1", Body: "本文1"},
+		{To: "user2@dummy.invalid", Subject: "件名2", Body: "本文2"},
+	}, m.Messages())
+
+	m.Reset()
+	assert.Empty(t, m.Messages())
+}
+
+func TestAsyncMailer(t *testing.T) {
+	t.Parallel()
+
+	mm := mail.NewMemoryMailer()
+	m := mail.NewAsyncMailer(mm, 2)
+	require.NoError(t, m.Send(t.Context(), &mail.Message{To: "user1@dummy.invalid", Subject: "件名1", Body: "本文1"}))
+	require.NoError(t, m.Send(t.Context(), &mail.Message{To: "user2@dummy.invalid", Subject: "件名2", Body: "本文2"}))
+	m.Close()
+	assert.Equal(t, []mail.Message{
+		{To: "user1@dummy.invalid", Subject: "件名1", Body: "本文1"},
+		{To: "user2@dummy.invalid", Subject: "件名2", Body: "本文2"},
+	}, mm.Messages())
+}
@@ -0,0 +1,109 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/lib/clock"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	// Timeout は1通のメールの送信にかける時間の上限であり、SMTPサーバへの接続から送信の完了までに適用する
+	Timeout time.Duration
+}
+
+func NewSMTPMailer(conf *SMTPConfig) (*SMTPMailer, error) {
+	if conf.Host == "" {
+		return nil, errtrace.Wrap(errors.New("smtp host is required"))
+	}
+	if conf.From == "" {
+		return nil, errtrace.Wrap(errors.New("mail sender address is required"))
+	}
+	if conf.Timeout <= 0 {
+		return nil, errtrace.Wrap(errors.New("smtp timeout must be positive"))
+	}
+
+	var auth smtp.Auth
+	if conf.Username != "" {
+		auth = smtp.PlainAuth("", conf.Username, conf.Password, conf.Host)
+	}
+	return &SMTPMailer{
+		host:    conf.Host,
+		addr:    net.JoinHostPort(conf.Host, strconv.Itoa(conf.Port)),
+		auth:    auth,
+		from:    conf.From,
+		timeout: conf.Timeout,
+	}, nil
+}
+
+// SMTPMailer はSMTPサーバを経由してメールを送信する
+// サーバがSTARTTLSに対応している場合は暗号化して送信する
+type SMTPMailer struct {
+	host    string
+	addr    string
+	auth    smtp.Auth
+	from    string
+	timeout time.Duration
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, msg *Message) error {
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", m.addr)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	defer conn.Close()
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return errtrace.Wrap(err)
+	}
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	c, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	defer c.Close()
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: m.host}); err != nil {
+			return errtrace.Wrap(err)
+		}
+	}
+	if m.auth != nil {
+		if err := c.Auth(m.auth); err != nil {
+			return errtrace.Wrap(err)
+		}
+	}
+	if err := c.Mail(m.from); err != nil {
+		return errtrace.Wrap(err)
+	}
+	if err := c.Rcpt(msg.To); err != nil {
+		return errtrace.Wrap(err)
+	}
+	w, err := c.Data()
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	if _, err := w.Write(msg.build(m.from, clock.Now(ctx))); err != nil {
+		return errtrace.Wrap(err)
+	}
+	if err := w.Close(); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return errtrace.Wrap(c.Quit())
+}
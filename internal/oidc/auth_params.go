@@ -0,0 +1,43 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+// AuthParams は1回の認可リクエストに使う乱数の組である
+// State はCSRFの防止に、Nonce はIDトークンの再送の防止に、CodeVerifier はPKCEに使う
+type AuthParams struct {
+	State        string
+	Nonce        string
+	CodeVerifier string
+}
+
+type authParamsKey struct{}
+
+// NewAuthParams は認可リクエストごとに新しい乱数の組を返す
+func NewAuthParams(ctx context.Context) (AuthParams, error) {
+	if testing.Testing() {
+		if v, ok := ctx.Value(authParamsKey{}).(AuthParams); ok {
+			return v, nil
+		}
+	}
+
+	var params AuthParams
+	for _, p := range []*string{&params.State, &params.Nonce, &params.CodeVerifier} {
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			return AuthParams{}, errtrace.Wrap(err)
+		}
+		*p = base64.RawURLEncoding.EncodeToString(b)
+	}
+	return params, nil
+}
+
+func WithFixedAuthParams(ctx context.Context, params AuthParams) context.Context {
+	return context.WithValue(ctx, authParamsKey{}, params)
+}
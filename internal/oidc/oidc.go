@@ -0,0 +1,272 @@
+// Package oidc はOpenID Connectの認可コードフローで外部のIDプロバイダと連携する
+// 認可コードの横取りを防ぐため、認可リクエストには必ずPKCE(S256)を使う
+package oidc
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/minguu42/harmattan/internal/atel"
+	"github.com/minguu42/harmattan/internal/lib/clock"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+var (
+	// ErrInvalidGrant は認可コードが無効か、コード検証子が認可リクエストと一致しないことを表す
+	ErrInvalidGrant = errors.New("invalid grant")
+	// ErrInvalidIDToken はIDプロバイダが返したIDトークンの署名やクレームが正しくないことを表す
+	ErrInvalidIDToken = errors.New("invalid id token")
+)
+
+type Config struct {
+	Issuer   string
+	ClientID string
+	// ClientSecret が空の場合は、パブリッククライアントとしてPKCEのみでトークンエンドポイントに認証する
+	ClientSecret string
+	RedirectURL  string
+	// HTTPClient が nil の場合は http.DefaultClient を使う
+	HTTPClient *http.Client
+}
+
+// Provider は外部のIDプロバイダであり、ディスカバリで取得したエンドポイントと署名の検証に使う公開鍵を保持する
+type Provider struct {
+	conf                  Config
+	client                *http.Client
+	authorizationEndpoint string
+	tokenEndpoint         string
+	jwksURI               string
+
+	mu   sync.Mutex
+	keys map[string]any
+}
+
+// NewProvider はIDプロバイダのディスカバリドキュメントを取得して Provider を返す
+func NewProvider(ctx context.Context, conf *Config) (*Provider, error) {
+	if conf.Issuer == "" || conf.ClientID == "" || conf.RedirectURL == "" {
+		return nil, errtrace.Wrap(errors.New("issuer, client id and redirect url are required"))
+	}
+	client := conf.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	var doc struct {
+		Issuer                string `json:"issuer"`
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+		JWKSURI               string `json:"jwks_uri"`
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(conf.Issuer, "/")+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if err := doJSON(client, req, &doc); err != nil {
+		return nil, errtrace.Wrap(fmt.Errorf("failed to get discovery document: %w", err))
+	}
+	// 他のIDプロバイダになりすましたディスカバリドキュメントを受け付けないように、発行者が設定と完全に一致することを確認する
+	if doc.Issuer != conf.Issuer {
+		return nil, errtrace.Wrap(fmt.Errorf("issuer mismatch: expected %q, got %q", conf.Issuer, doc.Issuer))
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JWKSURI == "" {
+		return nil, errtrace.Wrap(errors.New("discovery document lacks required endpoints"))
+	}
+	return &Provider{
+		conf:                  *conf,
+		client:                client,
+		authorizationEndpoint: doc.AuthorizationEndpoint,
+		tokenEndpoint:         doc.TokenEndpoint,
+		jwksURI:               doc.JWKSURI,
+	}, nil
+}
+
+// Issuer はIDプロバイダの発行者識別子を返す
+func (p *Provider) Issuer() string {
+	return p.conf.Issuer
+}
+
+// AuthorizationURL はユーザをIDプロバイダの認可エンドポイントに遷移させるURLを返す
+func (p *Provider) AuthorizationURL(params AuthParams) string {
+	q := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.conf.ClientID},
+		"redirect_uri":          {p.conf.RedirectURL},
+		"scope":                 {"openid email"},
+		"state":                 {params.State},
+		"nonce":                 {params.Nonce},
+		"code_challenge":        {CodeChallenge(params.CodeVerifier)},
+		"code_challenge_method": {"S256"},
+	}
+	sep := "?"
+	if strings.Contains(p.authorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return p.authorizationEndpoint + sep + q.Encode()
+}
+
+// Exchange は認可コードをトークンエンドポイントでIDトークンと交換し、IDトークンを返す
+// 認可コードが無効な場合やコード検証子が一致しない場合は ErrInvalidGrant を返す
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier string) (string, error) {
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.conf.RedirectURL},
+		"code_verifier": {codeVerifier},
+	}
+	if p.conf.ClientSecret == "" {
+		form.Set("client_id", p.conf.ClientID)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.tokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", errtrace.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if p.conf.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.conf.ClientID), url.QueryEscape(p.conf.ClientSecret))
+	}
+
+	var resp struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := doJSON(p.client, req, &resp); err != nil {
+		if se, ok := errors.AsType[*statusError](err); ok && (se.code == http.StatusBadRequest || se.code == http.StatusUnauthorized) {
+			return "", errtrace.Wrap(ErrInvalidGrant)
+		}
+		return "", errtrace.Wrap(fmt.Errorf("failed to exchange authorization code: %w", err))
+	}
+	if resp.IDToken == "" {
+		return "", errtrace.Wrap(ErrInvalidIDToken)
+	}
+	return resp.IDToken, nil
+}
+
+// Claims はIDトークンから取り出したユーザの情報である
+type Claims struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+}
+
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce         string `json:"nonce"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+}
+
+// VerifyIDToken はIDトークンの署名・発行者・対象者・有効期限と、認可リクエストで指定した nonce を検証してクレームを返す
+func (p *Provider) VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (*Claims, error) {
+	var c idTokenClaims
+	if _, err := jwt.ParseWithClaims(rawIDToken, &c, func(t *jwt.Token) (any, error) {
+		return p.verificationKey(ctx, t)
+	},
+		jwt.WithValidMethods([]string{"RS256", "ES256", "EdDSA"}),
+		jwt.WithIssuer(p.conf.Issuer),
+		jwt.WithAudience(p.conf.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithTimeFunc(func() time.Time { return clock.Now(ctx) }),
+	); err != nil {
+		return nil, errtrace.Wrap(errors.Join(ErrInvalidIDToken, err))
+	}
+	if c.Subject == "" {
+		return nil, errtrace.Wrap(errors.Join(ErrInvalidIDToken, errors.New("sub claim is empty")))
+	}
+	if subtle.ConstantTimeCompare([]byte(c.Nonce), []byte(nonce)) != 1 {
+		return nil, errtrace.Wrap(errors.Join(ErrInvalidIDToken, errors.New("nonce mismatch")))
+	}
+	return &Claims{Subject: c.Subject, Email: c.Email, EmailVerified: c.EmailVerified}, nil
+}
+
+// verificationKey はIDトークンのヘッダの kid に対応する公開鍵を返す
+// IDプロバイダが鍵をローテーションした場合に備えて、知らない kid のときは公開鍵の一覧を取得し直す
+func (p *Provider) verificationKey(ctx context.Context, t *jwt.Token) (any, error) {
+	kid, _ := t.Header["kid"].(string)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := lookupKey(p.keys, kid); ok {
+		return key, nil
+	}
+	keys, err := p.fetchKeys(ctx)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	p.keys = keys
+	if key, ok := lookupKey(p.keys, kid); ok {
+		return key, nil
+	}
+	return nil, errtrace.Wrap(fmt.Errorf("key %q is not found", kid))
+}
+
+// lookupKey は kid に対応する鍵を返す。kid が空の場合は鍵が1つだけのときに限りその鍵を返す
+func lookupKey(keys map[string]any, kid string) (any, bool) {
+	if kid == "" && len(keys) == 1 {
+		for _, k := range keys {
+			return k, true
+		}
+	}
+	k, ok := keys[kid]
+	return k, ok
+}
+
+func (p *Provider) fetchKeys(ctx context.Context) (map[string]any, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.jwksURI, nil)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	var set jwkSet
+	if err := doJSON(p.client, req, &set); err != nil {
+		return nil, errtrace.Wrap(fmt.Errorf("failed to get jwks: %w", err))
+	}
+	return set.publicKeys(), nil
+}
+
+type statusError struct {
+	code int
+	body string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("unexpected status %d: %s", e.code, e.body)
+}
+
+// doJSON はリクエストを送り、ステータスコードが200であればレスポンスボディを v にデコードする
+func doJSON(client *http.Client, req *http.Request, v any) error {
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	defer atel.Capture(req.Context(), "Failed to close response body")(resp.Body.Close)
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errtrace.Wrap(&statusError{code: resp.StatusCode, body: string(body)})
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// CodeChallenge はコード検証子からS256方式のコードチャレンジを求める
+func CodeChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,194 @@
+package oidc_test
+
+import (
+	"maps"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/minguu42/harmattan/internal/lib/clock"
+	"github.com/minguu42/harmattan/internal/oidc"
+	"github.com/minguu42/harmattan/internal/oidc/oidctest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	clientID     = "harmattan"
+	clientSecret = "client-secret"
+	redirectURL  = "http://localhost:5173/oidc/callback"
+	codeVerifier = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	nonce        = "NONCE-0000000000000000000000000000000000001"
+)
+
+var now = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func newProvider(t *testing.T) (*oidctest.Provider, *oidc.Provider) {
+	t.Helper()
+
+	stub, err := oidctest.NewProvider(clientID, clientSecret)
+	require.NoError(t, err)
+	t.Cleanup(stub.Close)
+	stub.Now = func() time.Time { return now }
+
+	p, err := oidc.NewProvider(t.Context(), &oidc.Config{
+		Issuer:       stub.URL,
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		RedirectURL:  redirectURL,
+	})
+	require.NoError(t, err)
+	return stub, p
+}
+
+func TestNewProvider(t *testing.T) {
+	t.Parallel()
+
+	stub, err := oidctest.NewProvider(clientID, clientSecret)
+	require.NoError(t, err)
+	t.Cleanup(stub.Close)
+
+	tests := []struct {
+		name    string
+		conf    oidc.Config
+		wantErr bool
+	}{
+		{
+			name: "ok",
+			conf: oidc.Config{Issuer: stub.URL, ClientID: clientID, RedirectURL: redirectURL},
+		},
+		{
+			name:    "issuer_mismatch",
+			conf:    oidc.Config{Issuer: stub.URL + "/", ClientID: clientID, RedirectURL: redirectURL},
+			wantErr: true,
+		},
+		{
+			name:    "missing_client_id",
+			conf:    oidc.Config{Issuer: stub.URL, RedirectURL: redirectURL},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := oidc.NewProvider(t.Context(), &tt.conf)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestProvider_AuthorizationURL(t *testing.T) {
+	t.Parallel()
+
+	stub, p := newProvider(t)
+
+	got, err := url.Parse(p.AuthorizationURL(oidc.AuthParams{State: "STATE", Nonce: nonce, CodeVerifier: codeVerifier}))
+	require.NoError(t, err)
+	assert.Equal(t, stub.AuthorizationEndpoint, got.Scheme+"://"+got.Host+got.Path)
+	assert.Equal(t, url.Values{
+		"response_type":         {"code"},
+		"client_id":             {clientID},
+		"redirect_uri":          {redirectURL},
+		"scope":                 {"openid email"},
+		"state":                 {"STATE"},
+		"nonce":                 {nonce},
+		"code_challenge":        {"E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"},
+		"code_challenge_method": {"S256"},
+	}, got.Query())
+}
+
+func TestProvider_Exchange(t *testing.T) {
+	t.Parallel()
+
+	stub, p := newProvider(t)
+	stub.AddGrant("CODE", oidctest.Grant{
+		CodeChallenge: oidc.CodeChallenge(codeVerifier),
+		Nonce:         nonce,
+		Subject:       "external-user-1",
+		Email:         "user1@dummy.invalid",
+		EmailVerified: true,
+	})
+	ctx := clock.WithFixedNow(t.Context(), now)
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+
+		idToken, err := p.Exchange(ctx, "CODE", codeVerifier)
+		require.NoError(t, err)
+		got, err := p.VerifyIDToken(ctx, idToken, nonce)
+		require.NoError(t, err)
+		assert.Equal(t, &oidc.Claims{Subject: "external-user-1", Email: "user1@dummy.invalid", EmailVerified: true}, got)
+	})
+	t.Run("unknown_code", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := p.Exchange(ctx, "UNKNOWN", codeVerifier)
+		assert.ErrorIs(t, err, oidc.ErrInvalidGrant)
+	})
+	t.Run("code_verifier_mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := p.Exchange(ctx, "CODE", "another-code-verifier-0000000000000000000000")
+		assert.ErrorIs(t, err, oidc.ErrInvalidGrant)
+	})
+	t.Run("nonce_mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		idToken, err := p.Exchange(ctx, "CODE", codeVerifier)
+		require.NoError(t, err)
+		_, err = p.VerifyIDToken(ctx, idToken, "another-nonce")
+		assert.ErrorIs(t, err, oidc.ErrInvalidIDToken)
+	})
+}
+
+func TestProvider_VerifyIDToken(t *testing.T) {
+	t.Parallel()
+
+	stub, p := newProvider(t)
+	ctx := clock.WithFixedNow(t.Context(), now)
+	claims := func(override jwt.MapClaims) jwt.MapClaims {
+		c := jwt.MapClaims{
+			"iss":   stub.URL,
+			"sub":   "external-user-1",
+			"aud":   clientID,
+			"exp":   now.Add(time.Hour).Unix(),
+			"iat":   now.Unix(),
+			"nonce": nonce,
+		}
+		maps.Copy(c, override)
+		return c
+	}
+
+	tests := []struct {
+		name    string
+		claims  jwt.MapClaims
+		wantErr bool
+	}{
+		{name: "ok", claims: claims(nil)},
+		{name: "expired", claims: claims(jwt.MapClaims{"exp": now.Add(-time.Second).Unix()}), wantErr: true},
+		{name: "issuer_mismatch", claims: claims(jwt.MapClaims{"iss": "https://idp.dummy.invalid"}), wantErr: true},
+		{name: "audience_mismatch", claims: claims(jwt.MapClaims{"aud": "another-client"}), wantErr: true},
+		{name: "empty_subject", claims: claims(jwt.MapClaims{"sub": ""}), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			idToken, err := stub.IDToken(tt.claims)
+			require.NoError(t, err)
+
+			_, err = p.VerifyIDToken(ctx, idToken, nonce)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, oidc.ErrInvalidIDToken)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
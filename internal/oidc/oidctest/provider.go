@@ -0,0 +1,183 @@
+// Package oidctest はテストで使うOpenID ConnectのIDプロバイダのスタブを提供する
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+	"github.com/minguu42/harmattan/internal/oidc"
+)
+
+// keyID はスタブがIDトークンの署名に使う鍵の kid である
+const keyID = "stub-key"
+
+// Grant はユーザがIDプロバイダで認可した結果であり、トークンエンドポイントで認可コードと交換する内容を表す
+type Grant struct {
+	// CodeChallenge と Nonce は認可リクエストで指定された値であり、トークンの交換時に検証・IDトークンに埋め込む
+	CodeChallenge string
+	Nonce         string
+	Subject       string
+	Email         string
+	EmailVerified bool
+}
+
+// Provider はディスカバリ・トークン・JWKSのエンドポイントを持つIDプロバイダのスタブである
+// テストを繰り返し実行できるように、認可コードは交換しても使用済みにしない
+type Provider struct {
+	// URL はスタブの発行者識別子である
+	URL          string
+	ClientID     string
+	ClientSecret string
+	// AuthorizationEndpoint はディスカバリドキュメントで返す認可エンドポイントであり、テストの期待値を固定するために変更できる
+	AuthorizationEndpoint string
+	// Now はIDトークンの発行日時を返す
+	Now func() time.Time
+
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	mu     sync.Mutex
+	grants map[string]Grant
+}
+
+// NewProvider はスタブのHTTPサーバを起動して Provider を返す
+// 設定を変更する場合は、ディスカバリドキュメントを取得させる前に変更する
+func NewProvider(clientID, clientSecret string) (*Provider, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	p := &Provider{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Now:          time.Now,
+		key:          key,
+		grants:       make(map[string]Grant),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", p.discovery)
+	mux.HandleFunc("POST /token", p.token)
+	mux.HandleFunc("GET /jwks", p.jwks)
+	p.server = httptest.NewServer(mux)
+	p.URL = p.server.URL
+	p.AuthorizationEndpoint = p.server.URL + "/authorize"
+	return p, nil
+}
+
+func (p *Provider) Close() {
+	p.server.Close()
+}
+
+// AddGrant は code と交換できる認可結果を登録する
+func (p *Provider) AddGrant(code string, g Grant) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.grants[code] = g
+}
+
+// IDToken はスタブの鍵で署名したIDトークンを返す
+func (p *Provider) IDToken(claims jwt.MapClaims) (string, error) {
+	t := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	t.Header["kid"] = keyID
+	s, err := t.SignedString(p.key)
+	if err != nil {
+		return "", errtrace.Wrap(err)
+	}
+	return s, nil
+}
+
+func (p *Provider) discovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                p.URL,
+		"authorization_endpoint":                p.AuthorizationEndpoint,
+		"token_endpoint":                        p.URL + "/token",
+		"jwks_uri":                              p.URL + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+func (p *Provider) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+	if !p.authenticateClient(r) {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+	if r.PostForm.Get("grant_type") != "authorization_code" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+		return
+	}
+
+	p.mu.Lock()
+	g, ok := p.grants[r.PostForm.Get("code")]
+	p.mu.Unlock()
+	if !ok || oidc.CodeChallenge(r.PostForm.Get("code_verifier")) != g.CodeChallenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	now := p.Now()
+	idToken, err := p.IDToken(jwt.MapClaims{
+		"iss":            p.URL,
+		"sub":            g.Subject,
+		"aud":            p.ClientID,
+		"exp":            now.Add(time.Hour).Unix(),
+		"iat":            now.Unix(),
+		"nonce":          g.Nonce,
+		"email":          g.Email,
+		"email_verified": g.EmailVerified,
+	})
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": "stub-access-token",
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     idToken,
+	})
+}
+
+func (p *Provider) authenticateClient(r *http.Request) bool {
+	if p.ClientSecret == "" {
+		return r.PostForm.Get("client_id") == p.ClientID
+	}
+	id, secret, ok := r.BasicAuth()
+	return ok && id == p.ClientID && subtle.ConstantTimeCompare([]byte(secret), []byte(p.ClientSecret)) == 1
+}
+
+func (p *Provider) jwks(w http.ResponseWriter, _ *http.Request) {
+	pub := p.key.PublicKey
+	writeJSON(w, http.StatusOK, map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": keyID,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
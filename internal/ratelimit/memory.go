@@ -0,0 +1,53 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+)
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: make(map[string]memoryBucket)}
+}
+
+// MemoryStore はトークンバケットをメモリ上に保持し、APIサーバ間では共有しない
+type MemoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]memoryBucket
+	sweptAt time.Time
+}
+
+type memoryBucket struct {
+	bucket domain.RateLimitBucket
+	fullAt time.Time
+}
+
+func (s *MemoryStore) TakeRateLimitToken(_ context.Context, key string, limit domain.RateLimit, now time.Time) (*domain.RateLimitBucket, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sweep(now)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b.bucket = domain.RateLimitBucket{Key: key}
+	}
+	allowed := b.bucket.Take(limit, now)
+	b.fullAt = b.bucket.FullAt(limit)
+	s.buckets[key] = b
+	return new(b.bucket), allowed, nil
+}
+
+// sweep は満杯になったバケットを1分に1回まで削除する
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Before(s.sweptAt.Add(1 * time.Minute)) {
+		return
+	}
+	for key, b := range s.buckets {
+		if !now.Before(b.fullAt) {
+			delete(s.buckets, key)
+		}
+	}
+	s.sweptAt = now
+}
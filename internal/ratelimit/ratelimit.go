@@ -0,0 +1,94 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/clock"
+	"github.com/minguu42/harmattan/internal/lib/errtrace"
+)
+
+// Store はトークンバケットを保存する
+type Store interface {
+	TakeRateLimitToken(ctx context.Context, key string, limit domain.RateLimit, now time.Time) (*domain.RateLimitBucket, bool, error)
+}
+
+// ParseLimit は"<回数>/<期間>"の形式の文字列を解析する
+func ParseLimit(s string) (domain.RateLimit, error) {
+	requests, period, ok := strings.Cut(s, "/")
+	if !ok {
+		return domain.RateLimit{}, errtrace.Wrap(fmt.Errorf("rate limit must be in the form <requests>/<period>: %q", s))
+	}
+	n, err := strconv.Atoi(requests)
+	if err != nil {
+		return domain.RateLimit{}, errtrace.Wrap(err)
+	}
+	d, err := time.ParseDuration(period)
+	if err != nil {
+		return domain.RateLimit{}, errtrace.Wrap(err)
+	}
+	if n <= 0 || d <= 0 {
+		return domain.RateLimit{}, errtrace.Wrap(fmt.Errorf("rate limit must be positive: %q", s))
+	}
+	return domain.RateLimit{Requests: n, Period: d}, nil
+}
+
+// ParseOperationLimits は"<OperationID>=<回数>/<期間>"の形式の文字列を解析する
+func ParseOperationLimits(ss []string) (map[string]domain.RateLimit, error) {
+	limits := make(map[string]domain.RateLimit, len(ss))
+	for _, s := range ss {
+		operationID, limit, ok := strings.Cut(s, "=")
+		if !ok || operationID == "" {
+			return nil, errtrace.Wrap(fmt.Errorf("operation rate limit must be in the form <OperationID>=<requests>/<period>: %q", s))
+		}
+		l, err := ParseLimit(limit)
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		limits[operationID] = l
+	}
+	return limits, nil
+}
+
+func NewLimiter(store Store, defaultLimit domain.RateLimit, operationLimits map[string]domain.RateLimit) *Limiter {
+	return &Limiter{store: store, defaultLimit: defaultLimit, operationLimits: operationLimits}
+}
+
+// Limiter はオペレーションと主体ごとのリクエスト数をトークンバケットで制限する
+type Limiter struct {
+	store           Store
+	defaultLimit    domain.RateLimit
+	operationLimits map[string]domain.RateLimit
+}
+
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	RetryAfter time.Duration
+}
+
+// Allow は subject による operationID のリクエストを許可するかを判定する
+func (l *Limiter) Allow(ctx context.Context, operationID, subject string) (*Result, error) {
+	limit := l.limit(operationID)
+	b, ok, err := l.store.TakeRateLimitToken(ctx, operationID+":"+subject, limit, clock.Now(ctx))
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	r := &Result{Allowed: ok, Limit: limit.Requests, Remaining: b.Remaining()}
+	if !ok {
+		r.RetryAfter = b.RetryAfter(limit)
+	}
+	return r, nil
+}
+
+func (l *Limiter) limit(operationID string) domain.RateLimit {
+	if limit, ok := l.operationLimits[operationID]; ok {
+		return limit
+	}
+	return l.defaultLimit
+}
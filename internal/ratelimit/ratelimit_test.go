@@ -0,0 +1,97 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minguu42/harmattan/internal/domain"
+	"github.com/minguu42/harmattan/internal/lib/clock"
+	"github.com/minguu42/harmattan/internal/ratelimit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		s       string
+		want    domain.RateLimit
+		wantErr bool
+	}{
+		{name: "ok", s: "60/1m", want: domain.RateLimit{Requests: 60, Period: 1 * time.Minute}},
+		{name: "no_period", s: "60", wantErr: true},
+		{name: "invalid_requests", s: "x/1m", wantErr: true},
+		{name: "invalid_period", s: "60/1x", wantErr: true},
+		{name: "zero_requests", s: "0/1m", wantErr: true},
+		{name: "zero_period", s: "60/0s", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ratelimit.ParseLimit(tt.s)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseOperationLimits(t *testing.T) {
+	t.Parallel()
+
+	got, err := ratelimit.ParseOperationLimits([]string{"SignIn=10/1m", "SignUp=5/1h"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]domain.RateLimit{
+		"SignIn": {Requests: 10, Period: 1 * time.Minute},
+		"SignUp": {Requests: 5, Period: 1 * time.Hour},
+	}, got)
+
+	_, err = ratelimit.ParseOperationLimits([]string{"10/1m"})
+	assert.Error(t, err)
+	_, err = ratelimit.ParseOperationLimits([]string{"SignIn=10"})
+	assert.Error(t, err)
+}
+
+func TestLimiter(t *testing.T) {
+	t.Parallel()
+
+	l := ratelimit.NewLimiter(ratelimit.NewMemoryStore(),
+		domain.RateLimit{Requests: 3, Period: 3 * time.Second},
+		map[string]domain.RateLimit{"SignIn": {Requests: 1, Period: 10 * time.Second}},
+	)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := clock.WithFixedNow(t.Context(), now)
+
+	for i := range 3 {
+		got, err := l.Allow(ctx, "ListTasks", "user:user01")
+		require.NoError(t, err)
+		assert.Equal(t, &ratelimit.Result{Allowed: true, Limit: 3, Remaining: 2 - i}, got)
+	}
+	got, err := l.Allow(ctx, "ListTasks", "user:user01")
+	require.NoError(t, err)
+	assert.Equal(t, &ratelimit.Result{Allowed: false, Limit: 3, Remaining: 0, RetryAfter: 1 * time.Second}, got)
+
+	got, err = l.Allow(ctx, "ListProjects", "user:user01")
+	require.NoError(t, err)
+	assert.True(t, got.Allowed, "オペレーションごとに制限する")
+	got, err = l.Allow(ctx, "ListTasks", "user:user02")
+	require.NoError(t, err)
+	assert.True(t, got.Allowed, "主体ごとに制限する")
+
+	got, err = l.Allow(clock.WithFixedNow(t.Context(), now.Add(1*time.Second)), "ListTasks", "user:user01")
+	require.NoError(t, err)
+	assert.Equal(t, &ratelimit.Result{Allowed: true, Limit: 3, Remaining: 0}, got, "時間の経過に応じてトークンを補充する")
+
+	got, err = l.Allow(ctx, "SignIn", "ip:192.0.2.1")
+	require.NoError(t, err)
+	assert.Equal(t, &ratelimit.Result{Allowed: true, Limit: 1, Remaining: 0}, got)
+	got, err = l.Allow(ctx, "SignIn", "ip:192.0.2.1")
+	require.NoError(t, err)
+	assert.Equal(t, &ratelimit.Result{Allowed: false, Limit: 1, Remaining: 0, RetryAfter: 10 * time.Second}, got, "オペレーションごとの上限を優先する")
+}